@@ -26,6 +26,7 @@ import (
 	databasev1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	dnsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
 	keyvaultv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	managedidentityv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/managedidentity/v1alpha1"
 	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
 	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	azurev1alpha1 "github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
@@ -44,6 +45,7 @@ func init() {
 		databasev1alpha3.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
 		keyvaultv1alpha1.SchemeBuilder.AddToScheme,
+		managedidentityv1alpha1.SchemeBuilder.AddToScheme,
 		networkv1alpha3.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
 		dnsv1alpha1.SchemeBuilder.AddToScheme,
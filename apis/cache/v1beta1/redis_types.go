@@ -76,8 +76,10 @@ type RedisParameters struct {
 	SKU SKU `json:"sku"`
 
 	// Location in which to create this resource.
+	// Defaults to the defaultLocation of the ProviderConfig.
 	// +immutable
-	Location string `json:"location"`
+	// +optional
+	Location string `json:"location,omitempty"`
 
 	// SubnetID specifies the full resource ID of a subnet in a virtual network
 	// to deploy the Redis cache in. Example format:
@@ -23,10 +23,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// RedisID extracts the resource ID of a Redis.
+func RedisID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Redis)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this Redis.
 func (mg *Redis) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
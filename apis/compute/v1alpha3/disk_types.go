@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DiskSource is the source a disk is created from. At most one source may be
+// set; a disk without a source is created empty.
+type DiskSource struct {
+	// SnapshotID is the ID of the snapshot the disk is copied from.
+	// +optional
+	SnapshotID *string `json:"snapshotID,omitempty"`
+
+	// SnapshotIDRef - A reference to a Snapshot to retrieve its ID
+	// +optional
+	SnapshotIDRef *xpv1.Reference `json:"snapshotIDRef,omitempty"`
+
+	// SnapshotIDSelector - Select a reference to a Snapshot to retrieve its
+	// ID
+	// +optional
+	SnapshotIDSelector *xpv1.Selector `json:"snapshotIDSelector,omitempty"`
+
+	// ImageID is the ID of the platform image version or shared image
+	// gallery image version the disk is created from.
+	// +optional
+	ImageID *string `json:"imageID,omitempty"`
+
+	// BlobURI is the URI of a VHD blob that is imported as the disk.
+	// +optional
+	BlobURI *string `json:"blobURI,omitempty"`
+
+	// StorageAccountID is the ID of the storage account that contains the
+	// blob. Required when importing a blob from another subscription.
+	// +optional
+	StorageAccountID *string `json:"storageAccountID,omitempty"`
+}
+
+// DiskParameters define the desired state of an Azure managed disk.
+type DiskParameters struct {
+	// ResourceGroupName is the name of the resource group of the disk.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location of the disk. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SKU is the storage account type of the disk. Defaults to Standard_LRS.
+	// The disk must be detached or its virtual machine deallocated to change
+	// it.
+	// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS;Premium_ZRS;StandardSSD_ZRS
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// DiskSizeGB is the size of the disk. Required for empty disks; defaults
+	// to the size of the source otherwise. Disks can only be grown, and most
+	// disks can be grown while they are attached to a running virtual
+	// machine.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DiskSizeGB *int `json:"diskSizeGB,omitempty"`
+
+	// Zone the disk is deployed to.
+	// +immutable
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// Source the disk is created from.
+	// +immutable
+	// +optional
+	Source *DiskSource `json:"source,omitempty"`
+
+	// DiskEncryptionSetID is the ID of the disk encryption set used to
+	// encrypt the disk with customer-managed keys. Disks are encrypted with
+	// platform-managed keys if it is omitted.
+	// +optional
+	DiskEncryptionSetID *string `json:"diskEncryptionSetID,omitempty"`
+
+	// Tags of the disk.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A DiskSpec defines the desired state of a Disk.
+type DiskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	DiskParameters    `json:",inline"`
+}
+
+// A DiskStatus represents the observed state of a Disk.
+type DiskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State is the provisioning state of the disk.
+	State string `json:"state,omitempty"`
+
+	// DiskState is the attachment state of the disk, e.g. Unattached or
+	// Attached.
+	DiskState string `json:"diskState,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// UniqueID is the unique ID of the disk.
+	UniqueID string `json:"uniqueID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Disk is a managed resource that represents an Azure managed disk.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".spec.diskSizeGB"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.diskState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type Disk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiskSpec   `json:"spec"`
+	Status DiskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DiskList contains a list of Disk.
+type DiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Disk `json:"items"`
+}
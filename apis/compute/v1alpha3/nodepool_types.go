@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Node pool modes.
+const (
+	NodePoolModeSystem = "System"
+	NodePoolModeUser   = "User"
+)
+
+// AKSNodePoolAutoscaling configures the cluster autoscaler of a node pool.
+type AKSNodePoolAutoscaling struct {
+	// MinCount is the minimum number of nodes in the node pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	MinCount int `json:"minCount"`
+
+	// MaxCount is the maximum number of nodes in the node pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	MaxCount int `json:"maxCount"`
+}
+
+// AKSNodePoolParameters define the desired state of an Azure Kubernetes Engine
+// node pool.
+type AKSNodePoolParameters struct {
+	// ResourceGroupName is the name of the resource group of the cluster the
+	// node pool belongs to.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// AKSClusterName is the name of the cluster the node pool belongs to.
+	// +immutable
+	AKSClusterName string `json:"aksClusterName,omitempty"`
+
+	// AKSClusterNameRef - A reference to an AKSCluster to retrieve its name
+	// +immutable
+	AKSClusterNameRef *xpv1.Reference `json:"aksClusterNameRef,omitempty"`
+
+	// AKSClusterNameSelector - Select a reference to an AKSCluster to
+	// retrieve its name
+	// +immutable
+	AKSClusterNameSelector *xpv1.Selector `json:"aksClusterNameSelector,omitempty"`
+
+	// VMSize is the name of the VM size of the nodes, e.g., Standard_B2s,
+	// Standard_NC6s_v3, etc.
+	// +immutable
+	VMSize string `json:"vmSize"`
+
+	// Count is the number of nodes in the node pool. It is ignored while
+	// autoscaling is enabled. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// Autoscaling enables the cluster autoscaler for the node pool.
+	// +optional
+	Autoscaling *AKSNodePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// Mode of the node pool. Every cluster needs at least one System node
+	// pool. Defaults to User.
+	// +kubebuilder:validation:Enum=System;User
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+
+	// NodeTaints are added to the nodes of the node pool, e.g.,
+	// sku=gpu:NoSchedule.
+	// +optional
+	NodeTaints []string `json:"nodeTaints,omitempty"`
+
+	// NodeLabels are added to the nodes of the node pool.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// AvailabilityZones the nodes of the node pool are spread across.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// OSType of the nodes. Defaults to Linux.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +immutable
+	// +optional
+	OSType *string `json:"osType,omitempty"`
+
+	// MaxPods is the maximum number of pods that can run on a node.
+	// +immutable
+	// +optional
+	MaxPods *int `json:"maxPods,omitempty"`
+
+	// VnetSubnetID is the subnet the nodes of the node pool are deployed to.
+	// Defaults to the subnet of the cluster.
+	// +immutable
+	// +optional
+	VnetSubnetID string `json:"vnetSubnetID,omitempty"`
+
+	// VnetSubnetIDRef - A reference to a Subnet to retrieve its ID
+	// +immutable
+	// +optional
+	VnetSubnetIDRef *xpv1.Reference `json:"vnetSubnetIDRef,omitempty"`
+
+	// VnetSubnetIDSelector - Select a reference to a Subnet to retrieve
+	// its ID
+	// +immutable
+	// +optional
+	VnetSubnetIDSelector *xpv1.Selector `json:"vnetSubnetIDSelector,omitempty"`
+
+	// Tags of the virtual machine scale set of the node pool.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AKSNodePoolSpec defines the desired state of an AKSNodePool.
+type AKSNodePoolSpec struct {
+	xpv1.ResourceSpec     `json:",inline"`
+	AKSNodePoolParameters `json:",inline"`
+}
+
+// An AKSNodePoolStatus represents the observed state of an AKSNodePool.
+type AKSNodePoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State is the current state of the node pool.
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// OrchestratorVersion is the Kubernetes version of the node pool.
+	OrchestratorVersion string `json:"orchestratorVersion,omitempty"`
+
+	// NodeImageVersion is the version of the node image of the node pool.
+	NodeImageVersion string `json:"nodeImageVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AKSNodePool is a managed resource that represents a node pool of an Azure
+// Kubernetes Engine cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.aksClusterName"
+// +kubebuilder:printcolumn:name="VM-SIZE",type="string",JSONPath=".spec.vmSize"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type AKSNodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AKSNodePoolSpec   `json:"spec"`
+	Status AKSNodePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AKSNodePoolList contains a list of AKSNodePool.
+type AKSNodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AKSNodePool `json:"items"`
+}
@@ -23,11 +23,35 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	managedidentityv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/managedidentity/v1alpha1"
 	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// DiskID extracts the resource ID of a Disk.
+func DiskID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Disk)
+		if !ok {
+			return ""
+		}
+		return d.Status.ProviderID
+	}
+}
+
+// SnapshotID extracts the resource ID of a Snapshot.
+func SnapshotID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Snapshot)
+		if !ok {
+			return ""
+		}
+		return s.Status.ProviderID
+	}
+}
+
 // ResolveReferences of this AKSCluster.
 func (mg *AKSCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -60,5 +84,235 @@ func (mg *AKSCluster) ResolveReferences(ctx context.Context, c client.Reader) er
 	mg.Spec.VnetSubnetID = rsp.ResolvedValue
 	mg.Spec.VnetSubnetIDRef = rsp.ResolvedReference
 
+	if mg.Spec.Identity == nil {
+		return nil
+	}
+
+	// Resolve spec.identity.userAssignedIdentityID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.Identity.UserAssignedIdentityID,
+		Reference:    mg.Spec.Identity.UserAssignedIdentityIDRef,
+		Selector:     mg.Spec.Identity.UserAssignedIdentityIDSelector,
+		To:           reference.To{Managed: &managedidentityv1alpha1.UserAssignedIdentity{}, List: &managedidentityv1alpha1.UserAssignedIdentityList{}},
+		Extract:      managedidentityv1alpha1.UserAssignedIdentityID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.identity.userAssignedIdentityID")
+	}
+	mg.Spec.Identity.UserAssignedIdentityID = rsp.ResolvedValue
+	mg.Spec.Identity.UserAssignedIdentityIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AKSNodePool.
+func (mg *AKSNodePool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ResourceGroupName,
+		Reference:    mg.Spec.ResourceGroupNameRef,
+		Selector:     mg.Spec.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.resourceGroupName")
+	}
+	mg.Spec.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.aksClusterName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.AKSClusterName,
+		Reference:    mg.Spec.AKSClusterNameRef,
+		Selector:     mg.Spec.AKSClusterNameSelector,
+		To:           reference.To{Managed: &AKSCluster{}, List: &AKSClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.aksClusterName")
+	}
+	mg.Spec.AKSClusterName = rsp.ResolvedValue
+	mg.Spec.AKSClusterNameRef = rsp.ResolvedReference
+
+	// Resolve spec.vnetSubnetID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.VnetSubnetID,
+		Reference:    mg.Spec.VnetSubnetIDRef,
+		Selector:     mg.Spec.VnetSubnetIDSelector,
+		To:           reference.To{Managed: &networkv1alpha3.Subnet{}, List: &networkv1alpha3.SubnetList{}},
+		Extract:      networkv1alpha3.SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.vnetSubnetID")
+	}
+	mg.Spec.VnetSubnetID = rsp.ResolvedValue
+	mg.Spec.VnetSubnetIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VirtualMachine.
+func (mg *VirtualMachine) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ResourceGroupName,
+		Reference:    mg.Spec.ResourceGroupNameRef,
+		Selector:     mg.Spec.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.resourceGroupName")
+	}
+	mg.Spec.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.networkInterfaceIDs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.NetworkInterfaceIDs,
+		References:    mg.Spec.NetworkInterfaceIDRefs,
+		Selector:      mg.Spec.NetworkInterfaceIDSelector,
+		To:            reference.To{Managed: &networkv1alpha3.NetworkInterface{}, List: &networkv1alpha3.NetworkInterfaceList{}},
+		Extract:       networkv1alpha3.NetworkInterfaceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.networkInterfaceIDs")
+	}
+	mg.Spec.NetworkInterfaceIDs = mrsp.ResolvedValues
+	mg.Spec.NetworkInterfaceIDRefs = mrsp.ResolvedReferences
+
+	return errors.Wrap(resolveIdentity(ctx, r, mg.Spec.Identity), "spec.identity.userAssignedIdentityID")
+}
+
+// ResolveReferences of this VirtualMachineScaleSet.
+func (mg *VirtualMachineScaleSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ResourceGroupName,
+		Reference:    mg.Spec.ResourceGroupNameRef,
+		Selector:     mg.Spec.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.resourceGroupName")
+	}
+	mg.Spec.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.subnetID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.SubnetID,
+		Reference:    mg.Spec.SubnetIDRef,
+		Selector:     mg.Spec.SubnetIDSelector,
+		To:           reference.To{Managed: &networkv1alpha3.Subnet{}, List: &networkv1alpha3.SubnetList{}},
+		Extract:      networkv1alpha3.SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.subnetID")
+	}
+	mg.Spec.SubnetID = rsp.ResolvedValue
+	mg.Spec.SubnetIDRef = rsp.ResolvedReference
+
+	return errors.Wrap(resolveIdentity(ctx, r, mg.Spec.Identity), "spec.identity.userAssignedIdentityID")
+}
+
+// ResolveReferences of this Disk.
+func (mg *Disk) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ResourceGroupName,
+		Reference:    mg.Spec.ResourceGroupNameRef,
+		Selector:     mg.Spec.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.resourceGroupName")
+	}
+	mg.Spec.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ResourceGroupNameRef = rsp.ResolvedReference
+
+	if mg.Spec.Source == nil {
+		return nil
+	}
+
+	// Resolve spec.source.snapshotID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.Source.SnapshotID),
+		Reference:    mg.Spec.Source.SnapshotIDRef,
+		Selector:     mg.Spec.Source.SnapshotIDSelector,
+		To:           reference.To{Managed: &Snapshot{}, List: &SnapshotList{}},
+		Extract:      SnapshotID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.source.snapshotID")
+	}
+	mg.Spec.Source.SnapshotID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.Source.SnapshotIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Snapshot.
+func (mg *Snapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ResourceGroupName,
+		Reference:    mg.Spec.ResourceGroupNameRef,
+		Selector:     mg.Spec.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.resourceGroupName")
+	}
+	mg.Spec.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.source.diskID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.Source.DiskID),
+		Reference:    mg.Spec.Source.DiskIDRef,
+		Selector:     mg.Spec.Source.DiskIDSelector,
+		To:           reference.To{Managed: &Disk{}, List: &DiskList{}},
+		Extract:      DiskID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.source.diskID")
+	}
+	mg.Spec.Source.DiskID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.Source.DiskIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+func resolveIdentity(ctx context.Context, r *reference.APIResolver, id *VirtualMachineIdentity) error {
+	if id == nil {
+		return nil
+	}
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: id.UserAssignedIdentityID,
+		Reference:    id.UserAssignedIdentityIDRef,
+		Selector:     id.UserAssignedIdentityIDSelector,
+		To:           reference.To{Managed: &managedidentityv1alpha1.UserAssignedIdentity{}, List: &managedidentityv1alpha1.UserAssignedIdentityList{}},
+		Extract:      managedidentityv1alpha1.UserAssignedIdentityID(),
+	})
+	if err != nil {
+		return err
+	}
+	id.UserAssignedIdentityID = rsp.ResolvedValue
+	id.UserAssignedIdentityIDRef = rsp.ResolvedReference
 	return nil
 }
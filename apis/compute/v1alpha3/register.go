@@ -45,6 +45,51 @@ var (
 	AKSClusterGroupVersionKind = SchemeGroupVersion.WithKind(AKSClusterKind)
 )
 
+// AKSNodePool type metadata.
+var (
+	AKSNodePoolKind             = reflect.TypeOf(AKSNodePool{}).Name()
+	AKSNodePoolGroupKind        = schema.GroupKind{Group: Group, Kind: AKSNodePoolKind}.String()
+	AKSNodePoolKindAPIVersion   = AKSNodePoolKind + "." + SchemeGroupVersion.String()
+	AKSNodePoolGroupVersionKind = SchemeGroupVersion.WithKind(AKSNodePoolKind)
+)
+
+// VirtualMachine type metadata.
+var (
+	VirtualMachineKind             = reflect.TypeOf(VirtualMachine{}).Name()
+	VirtualMachineGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualMachineKind}.String()
+	VirtualMachineKindAPIVersion   = VirtualMachineKind + "." + SchemeGroupVersion.String()
+	VirtualMachineGroupVersionKind = SchemeGroupVersion.WithKind(VirtualMachineKind)
+)
+
+// VirtualMachineScaleSet type metadata.
+var (
+	VirtualMachineScaleSetKind             = reflect.TypeOf(VirtualMachineScaleSet{}).Name()
+	VirtualMachineScaleSetGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualMachineScaleSetKind}.String()
+	VirtualMachineScaleSetKindAPIVersion   = VirtualMachineScaleSetKind + "." + SchemeGroupVersion.String()
+	VirtualMachineScaleSetGroupVersionKind = SchemeGroupVersion.WithKind(VirtualMachineScaleSetKind)
+)
+
+// Disk type metadata.
+var (
+	DiskKind             = reflect.TypeOf(Disk{}).Name()
+	DiskGroupKind        = schema.GroupKind{Group: Group, Kind: DiskKind}.String()
+	DiskKindAPIVersion   = DiskKind + "." + SchemeGroupVersion.String()
+	DiskGroupVersionKind = SchemeGroupVersion.WithKind(DiskKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&AKSCluster{}, &AKSClusterList{})
+	SchemeBuilder.Register(&AKSNodePool{}, &AKSNodePoolList{})
+	SchemeBuilder.Register(&VirtualMachine{}, &VirtualMachineList{})
+	SchemeBuilder.Register(&VirtualMachineScaleSet{}, &VirtualMachineScaleSetList{})
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
 }
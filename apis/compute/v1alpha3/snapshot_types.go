@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SnapshotSource is the source a snapshot is taken from. Exactly one source
+// must be set.
+type SnapshotSource struct {
+	// DiskID is the ID of the managed disk the snapshot is taken from.
+	// +optional
+	DiskID *string `json:"diskID,omitempty"`
+
+	// DiskIDRef - A reference to a Disk to retrieve its ID
+	// +optional
+	DiskIDRef *xpv1.Reference `json:"diskIDRef,omitempty"`
+
+	// DiskIDSelector - Select a reference to a Disk to retrieve its ID
+	// +optional
+	DiskIDSelector *xpv1.Selector `json:"diskIDSelector,omitempty"`
+
+	// BlobURI is the URI of a VHD blob that is imported as the snapshot.
+	// +optional
+	BlobURI *string `json:"blobURI,omitempty"`
+
+	// StorageAccountID is the ID of the storage account that contains the
+	// blob. Required when importing a blob from another subscription.
+	// +optional
+	StorageAccountID *string `json:"storageAccountID,omitempty"`
+}
+
+// SnapshotParameters define the desired state of an Azure disk snapshot.
+type SnapshotParameters struct {
+	// ResourceGroupName is the name of the resource group of the snapshot.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location of the snapshot. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SKU is the storage account type of the snapshot. Defaults to
+	// Standard_LRS.
+	// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;Standard_ZRS
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// Incremental snapshots only store the changes since the previous
+	// snapshot of the same disk.
+	// +immutable
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// Source the snapshot is taken from.
+	// +immutable
+	Source SnapshotSource `json:"source"`
+
+	// DiskEncryptionSetID is the ID of the disk encryption set used to
+	// encrypt the snapshot with customer-managed keys. Snapshots are
+	// encrypted with platform-managed keys if it is omitted.
+	// +optional
+	DiskEncryptionSetID *string `json:"diskEncryptionSetID,omitempty"`
+
+	// Tags of the snapshot.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec  `json:",inline"`
+	SnapshotParameters `json:",inline"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State is the provisioning state of the snapshot.
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// UniqueID is the unique ID of the snapshot.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// DiskSizeGB is the size of the snapshotted disk.
+	DiskSizeGB int `json:"diskSizeGB,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents an Azure disk snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.diskSizeGB"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot.
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
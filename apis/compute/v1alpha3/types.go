@@ -27,6 +27,169 @@ const (
 	DefaultNodeCount = 1
 )
 
+// Types of AKS cluster identity.
+const (
+	IdentityTypeSystemAssigned = "SystemAssigned"
+	IdentityTypeUserAssigned   = "UserAssigned"
+)
+
+// AKSClusterIdentity is the managed identity of an AKS cluster.
+type AKSClusterIdentity struct {
+	// Type of the managed identity. A SystemAssigned identity is created and
+	// deleted along with the cluster.
+	// +kubebuilder:validation:Enum=SystemAssigned;UserAssigned
+	Type string `json:"type"`
+
+	// UserAssignedIdentityID is the resource ID of the user-assigned
+	// identity of the cluster. Required when type is UserAssigned.
+	// +optional
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
+
+	// UserAssignedIdentityIDRef - A reference to a UserAssignedIdentity to
+	// retrieve its ID
+	// +optional
+	UserAssignedIdentityIDRef *xpv1.Reference `json:"userAssignedIdentityIDRef,omitempty"`
+
+	// UserAssignedIdentityIDSelector - Select a reference to a
+	// UserAssignedIdentity to retrieve its ID
+	// +optional
+	UserAssignedIdentityIDSelector *xpv1.Selector `json:"userAssignedIdentityIDSelector,omitempty"`
+}
+
+// AKSClusterCredentialRotation configures rotation of the service principal
+// secret of an AKS cluster.
+type AKSClusterCredentialRotation struct {
+	// Interval after which the secret is rotated, e.g. 2160h.
+	Interval metav1.Duration `json:"interval"`
+
+	// GracePeriod for which previous secrets remain valid after the cluster
+	// finished switching to the rotated secret. Defaults to 1h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// AKSClusterCredentialStatus is the observed state of the service principal
+// secret of an AKS cluster.
+type AKSClusterCredentialStatus struct {
+	// KeyID of the current secret.
+	KeyID string `json:"keyID,omitempty"`
+
+	// CreatedAt is the time the current secret was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// Types of AKS cluster kubeconfig.
+const (
+	KubeconfigTypeAdmin = "Admin"
+	KubeconfigTypeUser  = "User"
+)
+
+// Formats of AKS cluster user kubeconfig.
+const (
+	KubeconfigFormatAzure = "azure"
+	KubeconfigFormatExec  = "exec"
+)
+
+// An AKSClusterKubeconfig is a kubeconfig that is published to the connection
+// secret of an AKS cluster.
+type AKSClusterKubeconfig struct {
+	// Type of the kubeconfig. Admin kubeconfigs authenticate as
+	// cluster-admin using a client certificate. User kubeconfigs of clusters
+	// that are integrated with Azure AD authenticate users with Azure AD.
+	// Clusters that are not integrated with Azure AD issue the same
+	// credentials for both types.
+	// +kubebuilder:validation:Enum=Admin;User
+	Type string `json:"type"`
+
+	// Key of the connection detail the kubeconfig is published under.
+	Key string `json:"key"`
+
+	// Format of a User kubeconfig of a cluster that is integrated with Azure
+	// AD. The azure format uses the deprecated azure auth provider of
+	// kubectl, while the exec format uses kubelogin. Defaults to azure.
+	// +kubebuilder:validation:Enum=azure;exec
+	// +optional
+	Format *string `json:"format,omitempty"`
+
+	// LoginMode is the kubelogin login mode of a kubeconfig in the exec
+	// format. Defaults to devicecode.
+	// +kubebuilder:validation:Enum=devicecode;interactive;spn;ropc;msi;azurecli;workloadidentity
+	// +optional
+	LoginMode *string `json:"loginMode,omitempty"`
+}
+
+// AKSClusterAADProfile configures Azure AD integration of an AKS cluster.
+// Azure AD integration cannot be disabled once it is enabled.
+type AKSClusterAADProfile struct {
+	// AdminGroupObjectIDs are the object IDs of the Azure AD groups whose
+	// members are administrators of the cluster.
+	// +optional
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// EnableAzureRBAC determines whether Azure RBAC is used to authorize
+	// access to the Kubernetes API.
+	// +optional
+	EnableAzureRBAC *bool `json:"enableAzureRBAC,omitempty"`
+
+	// TenantID is the Azure AD tenant used for authentication. Defaults to
+	// the tenant of the subscription.
+	// +optional
+	TenantID *string `json:"tenantID,omitempty"`
+}
+
+// AKSClusterMonitoringAddOn configures the monitoring add-on of an AKS
+// cluster, which sends container logs and metrics to Azure Monitor.
+type AKSClusterMonitoringAddOn struct {
+	// Enabled determines whether the add-on is enabled.
+	Enabled bool `json:"enabled"`
+
+	// LogAnalyticsWorkspaceResourceID is the resource ID of the Log
+	// Analytics workspace that logs and metrics are sent to.
+	// +optional
+	LogAnalyticsWorkspaceResourceID string `json:"logAnalyticsWorkspaceResourceID,omitempty"`
+}
+
+// AKSClusterAzurePolicyAddOn configures the Azure Policy add-on of an AKS
+// cluster.
+type AKSClusterAzurePolicyAddOn struct {
+	// Enabled determines whether the add-on is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// AKSClusterIngressAddOn configures the Application Gateway ingress
+// controller add-on of an AKS cluster.
+type AKSClusterIngressAddOn struct {
+	// Enabled determines whether the add-on is enabled.
+	Enabled bool `json:"enabled"`
+
+	// ApplicationGatewayID is the resource ID of an existing Application
+	// Gateway to use. A new Application Gateway is created if it is not
+	// set.
+	// +optional
+	ApplicationGatewayID string `json:"applicationGatewayID,omitempty"`
+
+	// SubnetCIDR is the subnet in which a new Application Gateway is
+	// created.
+	// +optional
+	SubnetCIDR string `json:"subnetCIDR,omitempty"`
+}
+
+// AKSClusterAddOns configures the add-ons of an AKS cluster. Add-ons that are
+// omitted are left as they are.
+type AKSClusterAddOns struct {
+	// Monitoring configures the monitoring add-on.
+	// +optional
+	Monitoring *AKSClusterMonitoringAddOn `json:"monitoring,omitempty"`
+
+	// AzurePolicy configures the Azure Policy add-on.
+	// +optional
+	AzurePolicy *AKSClusterAzurePolicyAddOn `json:"azurePolicy,omitempty"`
+
+	// Ingress configures the Application Gateway ingress controller add-on.
+	// +optional
+	Ingress *AKSClusterIngressAddOn `json:"ingress,omitempty"`
+}
+
 // AKSClusterParameters define the desired state of an Azure Kubernetes Engine
 // cluster.
 type AKSClusterParameters struct {
@@ -42,10 +205,14 @@ type AKSClusterParameters struct {
 	// retrieve its name
 	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
 
-	// Location is the Azure location that the cluster will be created in
-	Location string `json:"location"`
+	// Location is the Azure location that the cluster will be created in.
+	// Defaults to the defaultLocation of the ProviderConfig.
+	// +optional
+	Location string `json:"location,omitempty"`
 
-	// Version is the Kubernetes version that will be deployed to the cluster
+	// Version is the Kubernetes version that will be deployed to the cluster.
+	// Clusters are upgraded in place when it changes, one minor version at a
+	// time.
 	Version string `json:"version"`
 
 	// VnetSubnetID is the subnet to which the cluster will be deployed.
@@ -59,8 +226,8 @@ type AKSClusterParameters struct {
 	// its ID
 	VnetSubnetIDSelector *xpv1.Selector `json:"vnetSubnetIDSelector,omitempty"`
 
-	// NodeCount is the number of nodes that the cluster will initially be
-	// created with.  This can be scaled over time and defaults to 1.
+	// NodeCount is the number of nodes in the cluster's agent pool. The
+	// cluster is scaled when it changes. Defaults to 1.
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=0
 	// +optional
@@ -81,6 +248,74 @@ type AKSClusterParameters struct {
 	// cluster.
 	// +optional
 	DisableRBAC bool `json:"disableRBAC,omitempty"`
+
+	// Tags of the cluster.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Identity is the managed identity of the cluster. Clusters with an
+	// identity do not use an Azure AD application and service principal.
+	// +immutable
+	// +optional
+	Identity *AKSClusterIdentity `json:"identity,omitempty"`
+
+	// NetworkPolicy is the network policy implementation of the cluster.
+	// +kubebuilder:validation:Enum=azure;calico
+	// +immutable
+	// +optional
+	NetworkPolicy *string `json:"networkPolicy,omitempty"`
+
+	// OutboundType is the outbound routing method of the cluster. The
+	// userDefinedRouting type requires a vnetSubnetID whose route table
+	// routes egress traffic.
+	// +kubebuilder:validation:Enum=loadBalancer;userDefinedRouting;managedNATGateway;userAssignedNATGateway
+	// +immutable
+	// +optional
+	OutboundType *string `json:"outboundType,omitempty"`
+
+	// PrivateCluster determines whether the Kubernetes API of the cluster is
+	// only reachable from within its virtual network.
+	// +immutable
+	// +optional
+	PrivateCluster *bool `json:"privateCluster,omitempty"`
+
+	// AuthorizedIPRanges are the IP ranges in CIDR notation that are allowed
+	// to access the Kubernetes API. Not supported by private clusters.
+	// +optional
+	AuthorizedIPRanges []string `json:"authorizedIPRanges,omitempty"`
+
+	// AADProfile configures Azure AD integration of the cluster.
+	// +optional
+	AADProfile *AKSClusterAADProfile `json:"aadProfile,omitempty"`
+
+	// AvailabilityZones of the cluster's agent pool, e.g. 1, 2 and 3.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// AutoUpgradeChannel is the channel the cluster is automatically
+	// upgraded with. Clusters with a channel other than none may be running
+	// a newer Kubernetes version than the one specified.
+	// +kubebuilder:validation:Enum=rapid;stable;patch;node-image;none
+	// +optional
+	AutoUpgradeChannel *string `json:"autoUpgradeChannel,omitempty"`
+
+	// AddOns configures the add-ons of the cluster.
+	// +optional
+	AddOns *AKSClusterAddOns `json:"addOns,omitempty"`
+
+	// Kubeconfigs that are published to the connection secret of the
+	// cluster. The endpoint, CA, client certificate and client key
+	// connection details are taken from the first kubeconfig. Defaults to an
+	// Admin kubeconfig published under the kubeconfig key.
+	// +optional
+	Kubeconfigs []AKSClusterKubeconfig `json:"kubeconfigs,omitempty"`
+
+	// CredentialRotation configures rotation of the service principal
+	// secret of the cluster. It has no effect on clusters with a managed
+	// identity.
+	// +optional
+	CredentialRotation *AKSClusterCredentialRotation `json:"credentialRotation,omitempty"`
 }
 
 // An AKSClusterSpec defines the desired state of a AKSCluster.
@@ -102,6 +337,23 @@ type AKSClusterStatus struct {
 
 	// Endpoint is the endpoint where the cluster can be reached
 	Endpoint string `json:"endpoint,omitempty"`
+
+	// IdentityPrincipalID is the principal ID of the managed identity of
+	// the cluster.
+	IdentityPrincipalID string `json:"identityPrincipalID,omitempty"`
+
+	// NetworkContributorPrincipalID is the principal ID that was granted the
+	// Network Contributor role on the subnet of the cluster.
+	NetworkContributorPrincipalID string `json:"networkContributorPrincipalID,omitempty"`
+
+	// Credential is the service principal secret of a cluster whose secret
+	// is rotated.
+	Credential *AKSClusterCredentialStatus `json:"credential,omitempty"`
+
+	// ServicePrincipalKeyID is the key ID of the service principal secret
+	// the cluster was last reset to use. Superseded secrets are only removed
+	// once the cluster uses the newest secret.
+	ServicePrincipalKeyID string `json:"servicePrincipalKeyID,omitempty"`
 }
 
 // +kubebuilder:object:root=true
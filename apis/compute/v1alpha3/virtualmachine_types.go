@@ -0,0 +1,262 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ImageReference is the image a virtual machine is created from. Either ID or
+// Publisher, Offer, SKU and Version must be set.
+type ImageReference struct {
+	// ID of a custom or shared gallery image.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Publisher of a marketplace image, e.g. Canonical.
+	// +optional
+	Publisher *string `json:"publisher,omitempty"`
+
+	// Offer of a marketplace image, e.g. 0001-com-ubuntu-server-focal.
+	// +optional
+	Offer *string `json:"offer,omitempty"`
+
+	// SKU of a marketplace image, e.g. 20_04-lts-gen2.
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// Version of a marketplace image. Defaults to latest.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// OSDisk is the operating system disk of a virtual machine.
+type OSDisk struct {
+	// StorageAccountType of the disk. Defaults to the storage account type
+	// of the image.
+	// +kubebuilder:validation:Enum=Standard_LRS;StandardSSD_LRS;Premium_LRS;StandardSSD_ZRS;Premium_ZRS
+	// +optional
+	StorageAccountType *string `json:"storageAccountType,omitempty"`
+
+	// DiskSizeGB is the size of the disk. Defaults to the size of the image.
+	// +optional
+	DiskSizeGB *int `json:"diskSizeGB,omitempty"`
+
+	// Caching of the disk. Defaults to ReadWrite.
+	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+}
+
+// DataDisk is an empty managed data disk that is attached to a virtual
+// machine.
+type DataDisk struct {
+	// Lun of the disk. It must be unique for the virtual machine.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=63
+	Lun int `json:"lun"`
+
+	// DiskSizeGB is the size of the disk.
+	// +kubebuilder:validation:Minimum=1
+	DiskSizeGB int `json:"diskSizeGB"`
+
+	// StorageAccountType of the disk. Defaults to Standard_LRS.
+	// +kubebuilder:validation:Enum=Standard_LRS;StandardSSD_LRS;Premium_LRS;StandardSSD_ZRS;Premium_ZRS;UltraSSD_LRS
+	// +optional
+	StorageAccountType *string `json:"storageAccountType,omitempty"`
+
+	// Caching of the disk. Defaults to None.
+	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+}
+
+// SSHPublicKey is an SSH public key that is authorized to log in as the admin
+// user of a virtual machine.
+type SSHPublicKey struct {
+	// SecretRef references the key of a Secret that contains the public
+	// key, in OpenSSH format.
+	SecretRef xpv1.SecretKeySelector `json:"secretRef"`
+}
+
+// VirtualMachineIdentity is the managed identity of a virtual machine.
+type VirtualMachineIdentity struct {
+	// Type of the managed identity.
+	// +kubebuilder:validation:Enum=SystemAssigned;UserAssigned
+	Type string `json:"type"`
+
+	// UserAssignedIdentityID is the resource ID of the user-assigned
+	// identity. Required if the type is UserAssigned.
+	// +optional
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
+
+	// UserAssignedIdentityIDRef - A reference to a UserAssignedIdentity to
+	// retrieve its ID
+	// +optional
+	UserAssignedIdentityIDRef *xpv1.Reference `json:"userAssignedIdentityIDRef,omitempty"`
+
+	// UserAssignedIdentityIDSelector - Select a reference to a
+	// UserAssignedIdentity to retrieve its ID
+	// +optional
+	UserAssignedIdentityIDSelector *xpv1.Selector `json:"userAssignedIdentityIDSelector,omitempty"`
+}
+
+// VirtualMachineParameters define the desired state of an Azure virtual
+// machine. Only Linux virtual machines are supported.
+type VirtualMachineParameters struct {
+	// ResourceGroupName is the name of the resource group of the virtual
+	// machine.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location of the virtual machine. Defaults to the defaultLocation of
+	// the ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Size of the virtual machine, e.g. Standard_B2s. The virtual machine is
+	// resized when it changes.
+	Size string `json:"size"`
+
+	// Image the virtual machine is created from.
+	// +immutable
+	Image ImageReference `json:"image"`
+
+	// OSDisk configures the operating system disk of the virtual machine.
+	// +immutable
+	// +optional
+	OSDisk *OSDisk `json:"osDisk,omitempty"`
+
+	// DataDisks are attached to the virtual machine. Disks are attached and
+	// detached when they change.
+	// +optional
+	DataDisks []DataDisk `json:"dataDisks,omitempty"`
+
+	// NetworkInterfaceIDs are the resource IDs of the network interfaces of
+	// the virtual machine. The first network interface is the primary one.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	NetworkInterfaceIDs []string `json:"networkInterfaceIDs,omitempty"`
+
+	// NetworkInterfaceIDRefs are references to NetworkInterfaces to retrieve
+	// their IDs. The first network interface is the primary one.
+	// +immutable
+	// +optional
+	NetworkInterfaceIDRefs []xpv1.Reference `json:"networkInterfaceIDRefs,omitempty"`
+
+	// NetworkInterfaceIDSelector selects references to NetworkInterfaces to
+	// retrieve their IDs.
+	// +optional
+	NetworkInterfaceIDSelector *xpv1.Selector `json:"networkInterfaceIDSelector,omitempty"`
+
+	// AdminUsername is the name of the admin user of the virtual machine.
+	// +immutable
+	AdminUsername string `json:"adminUsername"`
+
+	// SSHPublicKeys are authorized to log in as the admin user. Password
+	// authentication is disabled.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	SSHPublicKeys []SSHPublicKey `json:"sshPublicKeys"`
+
+	// CustomDataSecretRef references the key of a Secret that contains
+	// custom data, e.g. a cloud-init configuration, that is passed to the
+	// virtual machine when it is created.
+	// +immutable
+	// +optional
+	CustomDataSecretRef *xpv1.SecretKeySelector `json:"customDataSecretRef,omitempty"`
+
+	// Identity is the managed identity of the virtual machine.
+	// +optional
+	Identity *VirtualMachineIdentity `json:"identity,omitempty"`
+
+	// Zones the virtual machine is deployed to.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Tags of the virtual machine.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A VirtualMachineSpec defines the desired state of a VirtualMachine.
+type VirtualMachineSpec struct {
+	xpv1.ResourceSpec        `json:",inline"`
+	VirtualMachineParameters `json:",inline"`
+}
+
+// A VirtualMachineStatus represents the observed state of a VirtualMachine.
+type VirtualMachineStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State is the provisioning state of the virtual machine.
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// VMID is the unique ID of the virtual machine.
+	VMID string `json:"vmID,omitempty"`
+
+	// IdentityPrincipalID is the principal ID of the system-assigned
+	// identity of the virtual machine.
+	IdentityPrincipalID string `json:"identityPrincipalID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualMachine is a managed resource that represents an Azure virtual
+// machine.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".spec.size"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type VirtualMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSpec   `json:"spec"`
+	Status VirtualMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineList contains a list of VirtualMachine.
+type VirtualMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachine `json:"items"`
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Upgrade modes of a virtual machine scale set.
+const (
+	UpgradeModeManual    = "Manual"
+	UpgradeModeAutomatic = "Automatic"
+)
+
+// VirtualMachineScaleSetParameters define the desired state of an Azure
+// virtual machine scale set. Only Linux virtual machines are supported.
+type VirtualMachineScaleSetParameters struct {
+	// ResourceGroupName is the name of the resource group of the scale set.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location of the scale set. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Size of the virtual machines of the scale set, e.g. Standard_B2s.
+	// Existing virtual machines are only resized when they are upgraded to
+	// the latest model of the scale set.
+	Size string `json:"size"`
+
+	// Capacity is the number of virtual machines in the scale set. Defaults
+	// to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Capacity *int `json:"capacity,omitempty"`
+
+	// UpgradeMode determines how changes to the model of the scale set are
+	// applied to its virtual machines. Defaults to Manual.
+	// +kubebuilder:validation:Enum=Manual;Automatic
+	// +optional
+	UpgradeMode *string `json:"upgradeMode,omitempty"`
+
+	// Image the virtual machines are created from.
+	// +immutable
+	Image ImageReference `json:"image"`
+
+	// OSDisk configures the operating system disks of the virtual machines.
+	// +immutable
+	// +optional
+	OSDisk *OSDisk `json:"osDisk,omitempty"`
+
+	// DataDisks are attached to each virtual machine.
+	// +immutable
+	// +optional
+	DataDisks []DataDisk `json:"dataDisks,omitempty"`
+
+	// SubnetID is the subnet the network interfaces of the virtual machines
+	// are deployed to.
+	// +immutable
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Select a reference to a Subnet to retrieve its ID
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// ComputerNamePrefix is the prefix of the host names of the virtual
+	// machines. Defaults to the name of the scale set.
+	// +immutable
+	// +optional
+	ComputerNamePrefix *string `json:"computerNamePrefix,omitempty"`
+
+	// AdminUsername is the name of the admin user of the virtual machines.
+	// +immutable
+	AdminUsername string `json:"adminUsername"`
+
+	// SSHPublicKeys are authorized to log in as the admin user. Password
+	// authentication is disabled.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	SSHPublicKeys []SSHPublicKey `json:"sshPublicKeys"`
+
+	// CustomDataSecretRef references the key of a Secret that contains
+	// custom data, e.g. a cloud-init configuration, that is passed to the
+	// virtual machines when they are created.
+	// +immutable
+	// +optional
+	CustomDataSecretRef *xpv1.SecretKeySelector `json:"customDataSecretRef,omitempty"`
+
+	// Identity is the managed identity of the virtual machines.
+	// +optional
+	Identity *VirtualMachineIdentity `json:"identity,omitempty"`
+
+	// Zones the virtual machines are spread across.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Tags of the scale set.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A VirtualMachineScaleSetSpec defines the desired state of a
+// VirtualMachineScaleSet.
+type VirtualMachineScaleSetSpec struct {
+	xpv1.ResourceSpec                `json:",inline"`
+	VirtualMachineScaleSetParameters `json:",inline"`
+}
+
+// A VirtualMachineScaleSetStatus represents the observed state of a
+// VirtualMachineScaleSet.
+type VirtualMachineScaleSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+
+	// State is the provisioning state of the scale set.
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// UniqueID is the unique ID of the scale set.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// IdentityPrincipalID is the principal ID of the system-assigned
+	// identity of the scale set.
+	IdentityPrincipalID string `json:"identityPrincipalID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualMachineScaleSet is a managed resource that represents an Azure
+// virtual machine scale set.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".spec.size"
+// +kubebuilder:printcolumn:name="CAPACITY",type="integer",JSONPath=".spec.capacity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type VirtualMachineScaleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineScaleSetSpec   `json:"spec"`
+	Status VirtualMachineScaleSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineScaleSetList contains a list of VirtualMachineScaleSet.
+type VirtualMachineScaleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineScaleSet `json:"items"`
+}
@@ -23,12 +23,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// CosmosDBAccountID extracts the resource ID of a CosmosDBAccount.
+func CosmosDBAccountID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*CosmosDBAccount)
+		if !ok || a.Status.AtProvider == nil {
+			return ""
+		}
+		return a.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this MySQLServerVirtualNetworkRule.
 func (mg *MySQLServerVirtualNetworkRule) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -23,10 +23,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// PostgreSQLServerID extracts the resource ID of a PostgreSQLServer.
+func PostgreSQLServerID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*PostgreSQLServer)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this MySQLServer.
 func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
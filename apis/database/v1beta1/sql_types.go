@@ -150,8 +150,10 @@ type SQLServerParameters struct {
 	SKU SKU `json:"sku"`
 
 	// Location specifies the location of this SQLServer.
+	// Defaults to the defaultLocation of the ProviderConfig.
 	// +immutable
-	Location string `json:"location"`
+	// +optional
+	Location string `json:"location,omitempty"`
 
 	// AdministratorLogin - The administrator's login name of a server. Can only be specified when the server is being created (and is required for creation).
 	// +immutable
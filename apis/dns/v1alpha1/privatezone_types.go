@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PrivateZoneParameters define the desired state of an Azure Private DNS Zone.
+// Private DNS zones are global, so unlike a Zone they have no location.
+type PrivateZoneParameters struct {
+	// ResourceGroupName specifies the name of the resource group that should
+	// contain this Private DNS Zone.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - A selector for a ResourceGroup object to
+	// retrieve its name
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]*string `json:"tags,omitempty"`
+}
+
+// PrivateZoneObservation define the actual state of an Azure Private DNS Zone.
+type PrivateZoneObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Etag - The etag of the zone.
+	Etag string `json:"etag,omitempty"`
+
+	// ProvisioningState - The provisioning state of the zone.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// MaxNumberOfRecordSets - The maximum number of record sets that can be created in this zone.
+	MaxNumberOfRecordSets int `json:"maxNumberOfRecordSets,omitempty"`
+
+	// NumberOfRecordSets - The current number of record sets in this zone.
+	NumberOfRecordSets int `json:"numberOfRecordSets,omitempty"`
+
+	// NumberOfVirtualNetworkLinks - The current number of virtual networks
+	// linked to this zone.
+	NumberOfVirtualNetworkLinks int `json:"numberOfVirtualNetworkLinks,omitempty"`
+}
+
+// A PrivateZoneSpec defines the desired state of a PrivateZone.
+type PrivateZoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PrivateZoneParameters `json:"forProvider"`
+}
+
+// A PrivateZoneStatus represents the observed state of a PrivateZone.
+type PrivateZoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PrivateZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PrivateZone is a managed resource that represents an Azure Private DNS
+// Zone, such as privatelink.blob.core.windows.net for private endpoints.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PrivateZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrivateZoneSpec   `json:"spec"`
+	Status PrivateZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrivateZoneList contains a list of PrivateZone.
+type PrivateZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrivateZone `json:"items"`
+}
@@ -53,7 +53,16 @@ var (
 	RecordSetGroupVersionKind = SchemeGroupVersion.WithKind(RecordSetKind)
 )
 
+// PrivateZone type metadata.
+var (
+	PrivateZoneKind             = reflect.TypeOf(PrivateZone{}).Name()
+	PrivateZoneGroupKind        = schema.GroupKind{Group: Group, Kind: PrivateZoneKind}.String()
+	PrivateZoneKindAPIVersion   = PrivateZoneKind + "." + SchemeGroupVersion.String()
+	PrivateZoneGroupVersionKind = SchemeGroupVersion.WithKind(PrivateZoneKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Zone{}, &ZoneList{})
 	SchemeBuilder.Register(&RecordSet{}, &RecordSetList{})
+	SchemeBuilder.Register(&PrivateZone{}, &PrivateZoneList{})
 }
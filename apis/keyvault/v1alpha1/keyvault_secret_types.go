@@ -38,7 +38,9 @@ type KeyVaultSecretAttributesParameters struct {
 // KeyVaultSecretParameters defines the desired state of an Azure Key Vault Secret.
 // https://docs.microsoft.com/en-us/rest/api/keyvault/#secret-operations
 type KeyVaultSecretParameters struct {
-	// VaultBaseURL - The vault name, for example https://myvault.vault.azure.net.
+	// VaultBaseURL - The vault URL, for example https://myvault.vault.azure.net,
+	// or the vault name, for example myvault. The URL of a vault specified by
+	// name is derived from the Key Vault DNS suffix of the environment.
 	VaultBaseURL string `json:"vaultBaseUrl"`
 
 	// Name - The name of the secret
@@ -0,0 +1,397 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A FrontendIPConfiguration is an IP address a load balancer receives traffic
+// on. It is public if it has a public IP address, and internal if it is
+// allocated from a subnet.
+type FrontendIPConfiguration struct {
+	// Name of the frontend IP configuration, unique within the load
+	// balancer. Rules refer to the frontend IP configuration by its name.
+	Name string `json:"name"`
+
+	// PublicIPAddressID - The full resource ID of the public IP address of a
+	// public frontend.
+	// +optional
+	PublicIPAddressID *string `json:"publicIPAddressID,omitempty"`
+
+	// PublicIPAddressIDRef - A reference to a PublicIPAddress to retrieve
+	// its ID.
+	// +optional
+	PublicIPAddressIDRef *xpv1.Reference `json:"publicIPAddressIDRef,omitempty"`
+
+	// PublicIPAddressIDSelector - Select a reference to a PublicIPAddress to
+	// retrieve its ID.
+	// +optional
+	PublicIPAddressIDSelector *xpv1.Selector `json:"publicIPAddressIDSelector,omitempty"`
+
+	// SubnetID - The full resource ID of the subnet the private IP address
+	// of an internal frontend is allocated from.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Select a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// PrivateIPAllocationMethod - The private IP address allocation method of
+	// an internal frontend. Possible values include: 'Static', 'Dynamic'
+	// +kubebuilder:validation:Enum=Static;Dynamic
+	// +optional
+	PrivateIPAllocationMethod *string `json:"privateIPAllocationMethod,omitempty"`
+
+	// PrivateIPAddress - The private IP address of an internal frontend whose
+	// allocation method is Static.
+	// +optional
+	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+
+	// Zones - The availability zones the private IP address of an internal
+	// frontend is allocated in.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
+// A BackendAddressPool is a set of IP addresses a load balancer distributes
+// traffic to. Network interfaces join it through their IP configurations.
+type BackendAddressPool struct {
+	// Name of the backend address pool, unique within the load balancer.
+	// Rules refer to the backend address pool by its name.
+	Name string `json:"name"`
+}
+
+// A Probe is a health probe that determines which backends receive traffic.
+type Probe struct {
+	// Name of the probe, unique within the load balancer. Load balancing
+	// rules refer to the probe by its name.
+	Name string `json:"name"`
+
+	// Protocol of the probe. Https requires the Standard SKU. Possible values
+	// include: 'Http', 'Tcp', 'Https'
+	// +kubebuilder:validation:Enum=Http;Tcp;Https
+	Protocol string `json:"protocol"`
+
+	// Port the probe connects to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// IntervalInSeconds - The interval between probes. Defaults to 15.
+	// +optional
+	IntervalInSeconds *int32 `json:"intervalInSeconds,omitempty"`
+
+	// NumberOfProbes - The number of failed probes after which a backend
+	// stops receiving traffic. Defaults to 2.
+	// +optional
+	NumberOfProbes *int32 `json:"numberOfProbes,omitempty"`
+
+	// RequestPath - The URI path requested by Http and Https probes.
+	// +optional
+	RequestPath *string `json:"requestPath,omitempty"`
+}
+
+// A LoadBalancingRule distributes traffic received on a frontend port to the
+// backends of a backend address pool.
+type LoadBalancingRule struct {
+	// Name of the load balancing rule, unique within the load balancer.
+	Name string `json:"name"`
+
+	// FrontendIPConfigurationName - The name of the frontend IP configuration
+	// traffic is received on.
+	FrontendIPConfigurationName string `json:"frontendIPConfigurationName"`
+
+	// BackendAddressPoolName - The name of the backend address pool traffic
+	// is distributed to.
+	// +optional
+	BackendAddressPoolName *string `json:"backendAddressPoolName,omitempty"`
+
+	// ProbeName - The name of the probe that determines which backends
+	// receive traffic.
+	// +optional
+	ProbeName *string `json:"probeName,omitempty"`
+
+	// Protocol of the rule. Possible values include: 'Udp', 'Tcp', 'All'
+	// +kubebuilder:validation:Enum=Udp;Tcp;All
+	Protocol string `json:"protocol"`
+
+	// FrontendPort - The port traffic is received on. 0 means any port, and
+	// requires the All protocol.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65534
+	FrontendPort int32 `json:"frontendPort"`
+
+	// BackendPort - The port traffic is sent to. Defaults to the
+	// FrontendPort.
+	// +optional
+	BackendPort *int32 `json:"backendPort,omitempty"`
+
+	// LoadDistribution - How traffic is distributed across backends.
+	// Possible values include: 'Default', 'SourceIP', 'SourceIPProtocol'
+	// +kubebuilder:validation:Enum=Default;SourceIP;SourceIPProtocol
+	// +optional
+	LoadDistribution *string `json:"loadDistribution,omitempty"`
+
+	// IdleTimeoutInMinutes - The idle timeout of TCP connections, between 4
+	// and 30 minutes.
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+
+	// EnableFloatingIP - Whether the backends receive traffic addressed to
+	// the frontend IP address, e.g. for SQL AlwaysOn availability groups.
+	// +optional
+	EnableFloatingIP *bool `json:"enableFloatingIP,omitempty"`
+
+	// EnableTCPReset - Whether TCP resets are sent when connections time out.
+	// +optional
+	EnableTCPReset *bool `json:"enableTCPReset,omitempty"`
+
+	// DisableOutboundSNAT - Whether the backends use the frontend IP address
+	// for outbound connections, or only outbound rules do.
+	// +optional
+	DisableOutboundSNAT *bool `json:"disableOutboundSNAT,omitempty"`
+}
+
+// An InboundNATRule forwards traffic received on a frontend port to a single
+// backend. Network interfaces join it through their IP configurations.
+type InboundNATRule struct {
+	// Name of the inbound NAT rule, unique within the load balancer.
+	Name string `json:"name"`
+
+	// FrontendIPConfigurationName - The name of the frontend IP configuration
+	// traffic is received on.
+	FrontendIPConfigurationName string `json:"frontendIPConfigurationName"`
+
+	// Protocol of the rule. Possible values include: 'Udp', 'Tcp', 'All'
+	// +kubebuilder:validation:Enum=Udp;Tcp;All
+	Protocol string `json:"protocol"`
+
+	// FrontendPort - The port traffic is received on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65534
+	FrontendPort int32 `json:"frontendPort"`
+
+	// BackendPort - The port traffic is sent to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BackendPort int32 `json:"backendPort"`
+
+	// IdleTimeoutInMinutes - The idle timeout of TCP connections, between 4
+	// and 30 minutes.
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+
+	// EnableFloatingIP - Whether the backend receives traffic addressed to
+	// the frontend IP address.
+	// +optional
+	EnableFloatingIP *bool `json:"enableFloatingIP,omitempty"`
+
+	// EnableTCPReset - Whether TCP resets are sent when connections time out.
+	// +optional
+	EnableTCPReset *bool `json:"enableTCPReset,omitempty"`
+}
+
+// An OutboundRule translates the source address of outbound connections of
+// the backends of a backend address pool. It requires the Standard SKU.
+type OutboundRule struct {
+	// Name of the outbound rule, unique within the load balancer.
+	Name string `json:"name"`
+
+	// FrontendIPConfigurationNames - The names of the frontend IP
+	// configurations whose IP addresses are used for outbound connections.
+	// +kubebuilder:validation:MinItems=1
+	FrontendIPConfigurationNames []string `json:"frontendIPConfigurationNames"`
+
+	// BackendAddressPoolName - The name of the backend address pool whose
+	// outbound connections are translated.
+	BackendAddressPoolName string `json:"backendAddressPoolName"`
+
+	// Protocol of the rule. Possible values include: 'Tcp', 'Udp', 'All'
+	// +kubebuilder:validation:Enum=Tcp;Udp;All
+	Protocol string `json:"protocol"`
+
+	// AllocatedOutboundPorts - The number of SNAT ports allocated to each
+	// backend. Azure allocates them automatically when omitted.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+
+	// IdleTimeoutInMinutes - The idle timeout of outbound connections,
+	// between 4 and 120 minutes.
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+
+	// EnableTCPReset - Whether TCP resets are sent when connections time out.
+	// +optional
+	EnableTCPReset *bool `json:"enableTCPReset,omitempty"`
+}
+
+// LoadBalancerParameters define the desired state of an Azure load balancer.
+type LoadBalancerParameters struct {
+	// ResourceGroupName - Name of the load balancer's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the load balancer's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the load balancer's
+	// resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SKU of the load balancer. Its public IP addresses must have the same
+	// SKU. Defaults to Basic.
+	// +immutable
+	// +optional
+	SKU *SKU `json:"sku,omitempty"`
+
+	// FrontendIPConfigurations - The IP addresses the load balancer receives
+	// traffic on.
+	// +kubebuilder:validation:MinItems=1
+	FrontendIPConfigurations []FrontendIPConfiguration `json:"frontendIPConfigurations"`
+
+	// BackendAddressPools - The pools the load balancer distributes traffic
+	// to.
+	// +optional
+	BackendAddressPools []BackendAddressPool `json:"backendAddressPools,omitempty"`
+
+	// Probes - The health probes of the load balancer.
+	// +optional
+	Probes []Probe `json:"probes,omitempty"`
+
+	// LoadBalancingRules - The load balancing rules of the load balancer.
+	// +optional
+	LoadBalancingRules []LoadBalancingRule `json:"loadBalancingRules,omitempty"`
+
+	// InboundNATRules - The inbound NAT rules of the load balancer.
+	// +optional
+	InboundNATRules []InboundNATRule `json:"inboundNATRules,omitempty"`
+
+	// OutboundRules - The outbound rules of the load balancer.
+	// +optional
+	OutboundRules []OutboundRule `json:"outboundRules,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSubResource is an observed element of a load balancer, e.g. a
+// backend address pool.
+type LoadBalancerSubResource struct {
+	// Name of the element.
+	Name string `json:"name,omitempty"`
+
+	// ID of the element.
+	ID string `json:"id,omitempty"`
+}
+
+// A FrontendIPConfigurationObservation represents the observed state of a
+// frontend IP configuration.
+type FrontendIPConfigurationObservation struct {
+	// Name of the frontend IP configuration.
+	Name string `json:"name,omitempty"`
+
+	// ID of the frontend IP configuration.
+	ID string `json:"id,omitempty"`
+
+	// PrivateIPAddress - The private IP address of an internal frontend.
+	PrivateIPAddress string `json:"privateIPAddress,omitempty"`
+}
+
+// A LoadBalancerObservation represents the observed state of a LoadBalancer.
+type LoadBalancerObservation struct {
+	// State - The provisioning state of this LoadBalancer.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this LoadBalancer.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this LoadBalancer.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// FrontendIPConfigurations - The frontend IP configurations of this
+	// LoadBalancer.
+	FrontendIPConfigurations []FrontendIPConfigurationObservation `json:"frontendIPConfigurations,omitempty"`
+
+	// BackendAddressPools - The backend address pools of this LoadBalancer.
+	BackendAddressPools []LoadBalancerSubResource `json:"backendAddressPools,omitempty"`
+
+	// InboundNATRules - The inbound NAT rules of this LoadBalancer.
+	InboundNATRules []LoadBalancerSubResource `json:"inboundNATRules,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoadBalancerParameters `json:"forProvider"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an Azure load balancer,
+// including its frontend IP configurations, backend address pools, probes and
+// rules.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SKU",type="string",JSONPath=".spec.forProvider.sku.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancer items
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NATGatewayParameters define the desired state of an Azure NAT gateway.
+type NATGatewayParameters struct {
+	// ResourceGroupName - Name of the NAT gateway's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the NAT gateway's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the NAT gateway's
+	// resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// IdleTimeoutInMinutes - The idle timeout of outbound connections.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+
+	// PublicIPAddressIDs - The full resource IDs of the public IP addresses
+	// outbound connections are translated to. They must have the Standard
+	// SKU.
+	// +optional
+	PublicIPAddressIDs []string `json:"publicIPAddressIDs,omitempty"`
+
+	// PublicIPAddressIDRefs - References to PublicIPAddresses to retrieve
+	// their IDs.
+	// +optional
+	PublicIPAddressIDRefs []xpv1.Reference `json:"publicIPAddressIDRefs,omitempty"`
+
+	// PublicIPAddressIDSelector - Select references to PublicIPAddresses to
+	// retrieve their IDs.
+	// +optional
+	PublicIPAddressIDSelector *xpv1.Selector `json:"publicIPAddressIDSelector,omitempty"`
+
+	// PublicIPPrefixIDs - The full resource IDs of the public IP prefixes
+	// outbound connections are translated to.
+	// +optional
+	PublicIPPrefixIDs []string `json:"publicIPPrefixIDs,omitempty"`
+
+	// PublicIPPrefixIDRefs - References to PublicIPPrefixes to retrieve their
+	// IDs.
+	// +optional
+	PublicIPPrefixIDRefs []xpv1.Reference `json:"publicIPPrefixIDRefs,omitempty"`
+
+	// PublicIPPrefixIDSelector - Select references to PublicIPPrefixes to
+	// retrieve their IDs.
+	// +optional
+	PublicIPPrefixIDSelector *xpv1.Selector `json:"publicIPPrefixIDSelector,omitempty"`
+
+	// Zones - The availability zone of the NAT gateway. At most one zone may
+	// be specified.
+	// +immutable
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A NATGatewayObservation represents the observed state of a NATGateway.
+type NATGatewayObservation struct {
+	// State - The provisioning state of this NATGateway.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this NATGateway.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this NATGateway.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// SubnetIDs - The IDs of the subnets associated with this NATGateway.
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+}
+
+// A NATGatewaySpec defines the desired state of a NATGateway.
+type NATGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NATGatewayParameters `json:"forProvider"`
+}
+
+// A NATGatewayStatus represents the observed state of a NATGateway.
+type NATGatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NATGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NATGateway is a managed resource that represents an Azure NAT gateway,
+// which translates the outbound connections of the subnets associated with it
+// to its public IP addresses. Subnets are associated with a NAT gateway
+// through their natGatewayID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type NATGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NATGatewaySpec   `json:"spec"`
+	Status NATGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NATGatewayList contains a list of NATGateway items
+type NATGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NATGateway `json:"items"`
+}
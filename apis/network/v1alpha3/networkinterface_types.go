@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A NetworkInterfaceIPConfiguration is an IP address of a network interface.
+type NetworkInterfaceIPConfiguration struct {
+	// Name of the IP configuration, unique within the network interface.
+	Name string `json:"name"`
+
+	// Primary - Whether this is the primary IP configuration of the network
+	// interface. A network interface with several IP configurations must
+	// have exactly one primary IP configuration.
+	// +optional
+	Primary *bool `json:"primary,omitempty"`
+
+	// SubnetID - The full resource ID of the subnet the private IP address
+	// is allocated from.
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Select a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// PrivateIPAllocationMethod - The private IP address allocation method.
+	// Possible values include: 'Static', 'Dynamic'
+	// +kubebuilder:validation:Enum=Static;Dynamic
+	// +optional
+	PrivateIPAllocationMethod *string `json:"privateIPAllocationMethod,omitempty"`
+
+	// PrivateIPAddress - The private IP address of an IP configuration whose
+	// allocation method is Static.
+	// +optional
+	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+
+	// PrivateIPAddressVersion - The version of the private IP address.
+	// Possible values include: 'IPv4', 'IPv6'
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	PrivateIPAddressVersion *string `json:"privateIPAddressVersion,omitempty"`
+
+	// PublicIPAddressID - The full resource ID of the public IP address
+	// associated with the IP configuration.
+	// +optional
+	PublicIPAddressID *string `json:"publicIPAddressID,omitempty"`
+
+	// PublicIPAddressIDRef - A reference to a PublicIPAddress to retrieve
+	// its ID.
+	// +optional
+	PublicIPAddressIDRef *xpv1.Reference `json:"publicIPAddressIDRef,omitempty"`
+
+	// PublicIPAddressIDSelector - Select a reference to a PublicIPAddress to
+	// retrieve its ID.
+	// +optional
+	PublicIPAddressIDSelector *xpv1.Selector `json:"publicIPAddressIDSelector,omitempty"`
+
+	// LoadBalancerBackendAddressPoolIDs - The full resource IDs of the load
+	// balancer backend address pools the IP configuration belongs to, as
+	// observed in the status of a LoadBalancer.
+	// +optional
+	LoadBalancerBackendAddressPoolIDs []string `json:"loadBalancerBackendAddressPoolIDs,omitempty"`
+
+	// LoadBalancerInboundNATRuleIDs - The full resource IDs of the load
+	// balancer inbound NAT rules that forward traffic to the IP
+	// configuration, as observed in the status of a LoadBalancer.
+	// +optional
+	LoadBalancerInboundNATRuleIDs []string `json:"loadBalancerInboundNATRuleIDs,omitempty"`
+}
+
+// NetworkInterfaceParameters define the desired state of an Azure network
+// interface.
+type NetworkInterfaceParameters struct {
+	// ResourceGroupName - Name of the network interface's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the network interface's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the network
+	// interface's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. It must be the location of the virtual
+	// network of its subnets. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// IPConfigurations - The IP addresses of the network interface.
+	// +kubebuilder:validation:MinItems=1
+	IPConfigurations []NetworkInterfaceIPConfiguration `json:"ipConfigurations"`
+
+	// EnableAcceleratedNetworking - Whether the network interface uses
+	// accelerated networking. Only some virtual machine sizes support it.
+	// +optional
+	EnableAcceleratedNetworking *bool `json:"enableAcceleratedNetworking,omitempty"`
+
+	// EnableIPForwarding - Whether the network interface accepts traffic
+	// that is not addressed to it, e.g. for network virtual appliances.
+	// +optional
+	EnableIPForwarding *bool `json:"enableIPForwarding,omitempty"`
+
+	// NetworkSecurityGroupID - The full resource ID of the network security
+	// group associated with the network interface.
+	// +optional
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupID,omitempty"`
+
+	// NetworkSecurityGroupIDRef - A reference to a SecurityGroup to retrieve
+	// its ID.
+	// +optional
+	NetworkSecurityGroupIDRef *xpv1.Reference `json:"networkSecurityGroupIDRef,omitempty"`
+
+	// NetworkSecurityGroupIDSelector - Select a reference to a SecurityGroup
+	// to retrieve its ID.
+	// +optional
+	NetworkSecurityGroupIDSelector *xpv1.Selector `json:"networkSecurityGroupIDSelector,omitempty"`
+
+	// DNSServers - The IP addresses of the DNS servers of the network
+	// interface, in order of preference. The DNS servers of the virtual
+	// network are used when it is empty.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A NetworkInterfaceObservation represents the observed state of a
+// NetworkInterface.
+type NetworkInterfaceObservation struct {
+	// State - The provisioning state of this NetworkInterface.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this NetworkInterface.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this NetworkInterface.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// MACAddress - The MAC address of this NetworkInterface. It is assigned
+	// once the network interface is attached to a running virtual machine.
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// PrivateIPAddress - The private IP address of the primary IP
+	// configuration.
+	PrivateIPAddress string `json:"privateIPAddress,omitempty"`
+
+	// PrivateIPAddresses - The private IP addresses of all IP configurations.
+	PrivateIPAddresses []string `json:"privateIPAddresses,omitempty"`
+
+	// VirtualMachineID - The ID of the virtual machine the network interface
+	// is attached to.
+	VirtualMachineID string `json:"virtualMachineID,omitempty"`
+}
+
+// A NetworkInterfaceSpec defines the desired state of a NetworkInterface.
+type NetworkInterfaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkInterfaceParameters `json:"forProvider"`
+}
+
+// A NetworkInterfaceStatus represents the observed state of a
+// NetworkInterface.
+type NetworkInterfaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkInterfaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkInterface is a managed resource that represents an Azure network
+// interface, which connects a virtual machine to subnets.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.privateIPAddress"
+// +kubebuilder:printcolumn:name="MAC",type="string",JSONPath=".status.atProvider.macAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type NetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkInterfaceSpec   `json:"spec"`
+	Status NetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkInterfaceList contains a list of NetworkInterface items
+type NetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkInterface `json:"items"`
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PrivateEndpointParameters define the desired state of an Azure private
+// endpoint.
+type PrivateEndpointParameters struct {
+	// ResourceGroupName - Name of the private endpoint's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the private endpoint's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the private
+	// endpoint's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. It must be the location of the subnet's
+	// virtual network. Defaults to the defaultLocation of the ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// SubnetID - The full resource ID of the subnet the private IP address
+	// of the endpoint is allocated from.
+	// +immutable
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Select a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// PrivateLinkResourceID - The full resource ID of the resource the
+	// endpoint connects to, e.g. a storage account, a PostgreSQL server, a
+	// Redis cache, a CosmosDB account or a Key Vault. Key Vaults can only be
+	// specified by ID.
+	// +immutable
+	PrivateLinkResourceID string `json:"privateLinkResourceID,omitempty"`
+
+	// StorageAccountRef - A reference to a storage Account to retrieve its
+	// ID as the PrivateLinkResourceID.
+	// +immutable
+	// +optional
+	StorageAccountRef *xpv1.Reference `json:"storageAccountRef,omitempty"`
+
+	// StorageAccountSelector - Select a reference to a storage Account to
+	// retrieve its ID as the PrivateLinkResourceID.
+	// +optional
+	StorageAccountSelector *xpv1.Selector `json:"storageAccountSelector,omitempty"`
+
+	// PostgreSQLServerRef - A reference to a PostgreSQLServer to retrieve
+	// its ID as the PrivateLinkResourceID.
+	// +immutable
+	// +optional
+	PostgreSQLServerRef *xpv1.Reference `json:"postgreSQLServerRef,omitempty"`
+
+	// PostgreSQLServerSelector - Select a reference to a PostgreSQLServer to
+	// retrieve its ID as the PrivateLinkResourceID.
+	// +optional
+	PostgreSQLServerSelector *xpv1.Selector `json:"postgreSQLServerSelector,omitempty"`
+
+	// RedisRef - A reference to a Redis to retrieve its ID as the
+	// PrivateLinkResourceID.
+	// +immutable
+	// +optional
+	RedisRef *xpv1.Reference `json:"redisRef,omitempty"`
+
+	// RedisSelector - Select a reference to a Redis to retrieve its ID as
+	// the PrivateLinkResourceID.
+	// +optional
+	RedisSelector *xpv1.Selector `json:"redisSelector,omitempty"`
+
+	// CosmosDBAccountRef - A reference to a CosmosDBAccount to retrieve its
+	// ID as the PrivateLinkResourceID.
+	// +immutable
+	// +optional
+	CosmosDBAccountRef *xpv1.Reference `json:"cosmosDBAccountRef,omitempty"`
+
+	// CosmosDBAccountSelector - Select a reference to a CosmosDBAccount to
+	// retrieve its ID as the PrivateLinkResourceID.
+	// +optional
+	CosmosDBAccountSelector *xpv1.Selector `json:"cosmosDBAccountSelector,omitempty"`
+
+	// GroupIDs - The sub-resources of the private link resource the endpoint
+	// connects to, e.g. blob for a storage account, postgresqlServer,
+	// redisCache, Sql for a CosmosDB account or vault.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	GroupIDs []string `json:"groupIDs"`
+
+	// PrivateDNSZoneID - The full resource ID of a private DNS zone, e.g.
+	// privatelink.blob.core.windows.net, in which an A record of the endpoint
+	// is registered. No record is registered when it is omitted.
+	// +optional
+	PrivateDNSZoneID *string `json:"privateDNSZoneID,omitempty"`
+
+	// PrivateDNSZoneIDRef - A reference to a PrivateZone to retrieve its ID.
+	// +optional
+	PrivateDNSZoneIDRef *xpv1.Reference `json:"privateDNSZoneIDRef,omitempty"`
+
+	// PrivateDNSZoneIDSelector - Select a reference to a PrivateZone to
+	// retrieve its ID.
+	// +optional
+	PrivateDNSZoneIDSelector *xpv1.Selector `json:"privateDNSZoneIDSelector,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A PrivateEndpointDNSConfig is a DNS record that resolves to the private
+// endpoint.
+type PrivateEndpointDNSConfig struct {
+	// FQDN - The fully qualified domain name of the record.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// IPAddresses - The private IP addresses the record resolves to.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// A PrivateEndpointObservation represents the observed state of a
+// PrivateEndpoint.
+type PrivateEndpointObservation struct {
+	// State - The provisioning state of this PrivateEndpoint.
+	State string `json:"state,omitempty"`
+
+	// ConnectionState - The state of the connection to the private link
+	// resource, e.g. Pending until the owner of the resource approves it,
+	// and Approved afterwards.
+	ConnectionState string `json:"connectionState,omitempty"`
+
+	// PrivateIPAddress - The private IP address assigned to the endpoint.
+	PrivateIPAddress string `json:"privateIPAddress,omitempty"`
+
+	// FQDN - The fully qualified domain name that resolves to the private IP
+	// address of the endpoint.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// DNSConfigs - All DNS records that resolve to the endpoint. Resources
+	// with several sub-resources, e.g. CosmosDB accounts, have several.
+	DNSConfigs []PrivateEndpointDNSConfig `json:"dnsConfigs,omitempty"`
+
+	// NetworkInterfaceIDs - The IDs of the network interfaces Azure created
+	// for the endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIDs,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this PrivateEndpoint.
+	ID string `json:"id,omitempty"`
+}
+
+// A PrivateEndpointSpec defines the desired state of a PrivateEndpoint.
+type PrivateEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PrivateEndpointParameters `json:"forProvider"`
+}
+
+// A PrivateEndpointStatus represents the observed state of a PrivateEndpoint.
+type PrivateEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PrivateEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PrivateEndpoint is a managed resource that represents an Azure private
+// endpoint, which connects a PaaS resource to a subnet through a private IP
+// address. When a private DNS zone is specified, the endpoint is registered in
+// it through a DNS zone group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.privateIPAddress"
+// +kubebuilder:printcolumn:name="FQDN",type="string",JSONPath=".status.atProvider.fqdn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PrivateEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrivateEndpointSpec   `json:"spec"`
+	Status PrivateEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrivateEndpointList contains a list of PrivateEndpoint items
+type PrivateEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrivateEndpoint `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PublicIPPrefixParameters define the desired state of an Azure public IP
+// prefix.
+type PublicIPPrefixParameters struct {
+	// ResourceGroupName - Name of the public IP prefix's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the public IP prefix's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the public IP
+	// prefix's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// PrefixLength - The length of the prefix, e.g. 28 for 16 IPv4
+	// addresses.
+	// +immutable
+	// +kubebuilder:validation:Minimum=21
+	// +kubebuilder:validation:Maximum=127
+	PrefixLength int32 `json:"prefixLength"`
+
+	// PublicIPAddressVersion - The version of the IP addresses of the
+	// prefix. Possible values include: 'IPv4', 'IPv6'
+	// +immutable
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	PublicIPAddressVersion *string `json:"publicIPAddressVersion,omitempty"`
+
+	// IPTags - The IP tags of the prefix.
+	// +immutable
+	// +optional
+	IPTags []IPTag `json:"ipTags,omitempty"`
+
+	// Zones - The availability zones the IP addresses of the prefix are
+	// allocated in.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A PublicIPPrefixObservation represents the observed state of a
+// PublicIPPrefix.
+type PublicIPPrefixObservation struct {
+	// State - The provisioning state of this PublicIPPrefix.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this PublicIPPrefix.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this PublicIPPrefix.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// IPPrefix - The allocated prefix in CIDR notation.
+	IPPrefix string `json:"ipPrefix,omitempty"`
+
+	// PublicIPAddressIDs - The IDs of the public IP addresses allocated from
+	// the prefix.
+	PublicIPAddressIDs []string `json:"publicIPAddressIDs,omitempty"`
+}
+
+// A PublicIPPrefixSpec defines the desired state of a PublicIPPrefix.
+type PublicIPPrefixSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PublicIPPrefixParameters `json:"forProvider"`
+}
+
+// A PublicIPPrefixStatus represents the observed state of a PublicIPPrefix.
+type PublicIPPrefixStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PublicIPPrefixObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicIPPrefix is a managed resource that represents an Azure public IP
+// prefix, a contiguous range of public IP addresses that PublicIPAddresses
+// and NATGateways can be allocated from.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PREFIX",type="string",JSONPath=".status.atProvider.ipPrefix"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PublicIPPrefix struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicIPPrefixSpec   `json:"spec"`
+	Status PublicIPPrefixStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicIPPrefixList contains a list of PublicIPPrefix items
+type PublicIPPrefixList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicIPPrefix `json:"items"`
+}
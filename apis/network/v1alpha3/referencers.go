@@ -25,6 +25,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	cachev1beta1 "github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
+	databasev1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
@@ -40,6 +44,90 @@ func SubnetID() reference.ExtractValueFn {
 	}
 }
 
+// VirtualNetworkID extracts status.id from the supplied managed resource,
+// which must be a VirtualNetwork.
+func VirtualNetworkID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		v, ok := mg.(*VirtualNetwork)
+		if !ok {
+			return ""
+		}
+		return v.Status.ID
+	}
+}
+
+// SecurityGroupID extracts status.atProvider.id from the supplied managed
+// resource, which must be a SecurityGroup.
+func SecurityGroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*SecurityGroup)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.ID
+	}
+}
+
+// RouteTableID extracts status.atProvider.id from the supplied managed
+// resource, which must be a RouteTable.
+func RouteTableID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*RouteTable)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ID
+	}
+}
+
+// PublicIPAddressID extracts status.atProvider.id from the supplied managed
+// resource, which must be a PublicIPAddress.
+func PublicIPAddressID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*PublicIPAddress)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.ID
+	}
+}
+
+// NetworkInterfaceID extracts status.atProvider.id from the supplied managed
+// resource, which must be a NetworkInterface.
+func NetworkInterfaceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*NetworkInterface)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.ID
+	}
+}
+
+// PublicIPPrefixID extracts status.atProvider.id from the supplied managed
+// resource, which must be a PublicIPPrefix.
+func PublicIPPrefixID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*PublicIPPrefix)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.ID
+	}
+}
+
+// NATGatewayID extracts status.atProvider.id from the supplied managed
+// resource, which must be a NATGateway.
+func NATGatewayID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*NATGateway)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this VirtualNetwork
 func (mg *VirtualNetwork) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -93,6 +181,48 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.VirtualNetworkName = rsp.ResolvedValue
 	mg.Spec.VirtualNetworkNameRef = rsp.ResolvedReference
 
+	// Resolve spec.properties.networkSecurityGroupID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.NetworkSecurityGroupID),
+		Reference:    mg.Spec.NetworkSecurityGroupIDRef,
+		Selector:     mg.Spec.NetworkSecurityGroupIDSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      SecurityGroupID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.properties.networkSecurityGroupID")
+	}
+	mg.Spec.NetworkSecurityGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.NetworkSecurityGroupIDRef = rsp.ResolvedReference
+
+	// Resolve spec.properties.routeTableID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.RouteTableID),
+		Reference:    mg.Spec.RouteTableIDRef,
+		Selector:     mg.Spec.RouteTableIDSelector,
+		To:           reference.To{Managed: &RouteTable{}, List: &RouteTableList{}},
+		Extract:      RouteTableID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.properties.routeTableID")
+	}
+	mg.Spec.RouteTableID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.RouteTableIDRef = rsp.ResolvedReference
+
+	// Resolve spec.properties.natGatewayID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.NATGatewayID),
+		Reference:    mg.Spec.NATGatewayIDRef,
+		Selector:     mg.Spec.NATGatewayIDSelector,
+		To:           reference.To{Managed: &NATGateway{}, List: &NATGatewayList{}},
+		Extract:      NATGatewayID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.properties.natGatewayID")
+	}
+	mg.Spec.NATGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.NATGatewayIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -114,5 +244,464 @@ func (mg *PublicIPAddress) ResolveReferences(ctx context.Context, c client.Reade
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.publicIPPrefixID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PublicIPPrefixID),
+		Reference:    mg.Spec.ForProvider.PublicIPPrefixIDRef,
+		Selector:     mg.Spec.ForProvider.PublicIPPrefixIDSelector,
+		To:           reference.To{Managed: &PublicIPPrefix{}, List: &PublicIPPrefixList{}},
+		Extract:      PublicIPPrefixID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.publicIPPrefixID")
+	}
+	mg.Spec.ForProvider.PublicIPPrefixID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PublicIPPrefixIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this SecurityGroup
+func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this SecurityRule
+func (mg *SecurityRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.securityGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SecurityGroupName,
+		Reference:    mg.Spec.ForProvider.SecurityGroupNameRef,
+		Selector:     mg.Spec.ForProvider.SecurityGroupNameSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityGroupName")
+	}
+	mg.Spec.ForProvider.SecurityGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.SecurityGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RouteTable
+func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Route
+func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.routeTableName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RouteTableName,
+		Reference:    mg.Spec.ForProvider.RouteTableNameRef,
+		Selector:     mg.Spec.ForProvider.RouteTableNameSelector,
+		To:           reference.To{Managed: &RouteTable{}, List: &RouteTableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.routeTableName")
+	}
+	mg.Spec.ForProvider.RouteTableName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RouteTableNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VirtualNetworkPeering
+func (mg *VirtualNetworkPeering) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.virtualNetworkName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.VirtualNetworkName,
+		Reference:    mg.Spec.ForProvider.VirtualNetworkNameRef,
+		Selector:     mg.Spec.ForProvider.VirtualNetworkNameSelector,
+		To:           reference.To{Managed: &VirtualNetwork{}, List: &VirtualNetworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.virtualNetworkName")
+	}
+	mg.Spec.ForProvider.VirtualNetworkName = rsp.ResolvedValue
+	mg.Spec.ForProvider.VirtualNetworkNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.remoteVirtualNetworkID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RemoteVirtualNetworkID,
+		Reference:    mg.Spec.ForProvider.RemoteVirtualNetworkIDRef,
+		Selector:     mg.Spec.ForProvider.RemoteVirtualNetworkIDSelector,
+		To:           reference.To{Managed: &VirtualNetwork{}, List: &VirtualNetworkList{}},
+		Extract:      VirtualNetworkID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.remoteVirtualNetworkID")
+	}
+	mg.Spec.ForProvider.RemoteVirtualNetworkID = rsp.ResolvedValue
+	mg.Spec.ForProvider.RemoteVirtualNetworkIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PrivateEndpoint.
+func (mg *PrivateEndpoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SubnetID,
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetID")
+	}
+	mg.Spec.ForProvider.SubnetID = rsp.ResolvedValue
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.privateLinkResourceID from whichever kind of
+	// private link resource is referenced. Once resolved, the remaining
+	// references are no-ops.
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkResourceID,
+		Reference:    mg.Spec.ForProvider.StorageAccountRef,
+		Selector:     mg.Spec.ForProvider.StorageAccountSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Account{}, List: &storagev1alpha3.AccountList{}},
+		Extract:      storagev1alpha3.AccountID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.privateLinkResourceID")
+	}
+	mg.Spec.ForProvider.PrivateLinkResourceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.StorageAccountRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkResourceID,
+		Reference:    mg.Spec.ForProvider.PostgreSQLServerRef,
+		Selector:     mg.Spec.ForProvider.PostgreSQLServerSelector,
+		To:           reference.To{Managed: &databasev1beta1.PostgreSQLServer{}, List: &databasev1beta1.PostgreSQLServerList{}},
+		Extract:      databasev1beta1.PostgreSQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.privateLinkResourceID")
+	}
+	mg.Spec.ForProvider.PrivateLinkResourceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.PostgreSQLServerRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkResourceID,
+		Reference:    mg.Spec.ForProvider.RedisRef,
+		Selector:     mg.Spec.ForProvider.RedisSelector,
+		To:           reference.To{Managed: &cachev1beta1.Redis{}, List: &cachev1beta1.RedisList{}},
+		Extract:      cachev1beta1.RedisID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.privateLinkResourceID")
+	}
+	mg.Spec.ForProvider.PrivateLinkResourceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.RedisRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.privateDNSZoneID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PrivateDNSZoneID),
+		Reference:    mg.Spec.ForProvider.PrivateDNSZoneIDRef,
+		Selector:     mg.Spec.ForProvider.PrivateDNSZoneIDSelector,
+		To:           reference.To{Managed: &dnsv1alpha1.PrivateZone{}, List: &dnsv1alpha1.PrivateZoneList{}},
+		Extract:      dnsv1alpha1.PrivateZoneID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.privateDNSZoneID")
+	}
+	mg.Spec.ForProvider.PrivateDNSZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PrivateDNSZoneIDRef = rsp.ResolvedReference
+
+	// spec.forProvider.cosmosDBAccountRef is resolved by the PrivateEndpoint
+	// controller, because the database API group imports this one.
+
+	return nil
+}
+
+// ResolveReferences of this LoadBalancer.
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.FrontendIPConfigurations {
+		fic := &mg.Spec.ForProvider.FrontendIPConfigurations[i]
+
+		// Resolve spec.forProvider.frontendIPConfigurations[i].publicIPAddressID
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(fic.PublicIPAddressID),
+			Reference:    fic.PublicIPAddressIDRef,
+			Selector:     fic.PublicIPAddressIDSelector,
+			To:           reference.To{Managed: &PublicIPAddress{}, List: &PublicIPAddressList{}},
+			Extract:      PublicIPAddressID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.frontendIPConfigurations[%d].publicIPAddressID", i)
+		}
+		fic.PublicIPAddressID = reference.ToPtrValue(rsp.ResolvedValue)
+		fic.PublicIPAddressIDRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.frontendIPConfigurations[i].subnetID
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(fic.SubnetID),
+			Reference:    fic.SubnetIDRef,
+			Selector:     fic.SubnetIDSelector,
+			To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+			Extract:      SubnetID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.frontendIPConfigurations[%d].subnetID", i)
+		}
+		fic.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+		fic.SubnetIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this NetworkInterface.
+func (mg *NetworkInterface) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.networkSecurityGroupID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkSecurityGroupID),
+		Reference:    mg.Spec.ForProvider.NetworkSecurityGroupIDRef,
+		Selector:     mg.Spec.ForProvider.NetworkSecurityGroupIDSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      SecurityGroupID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.networkSecurityGroupID")
+	}
+	mg.Spec.ForProvider.NetworkSecurityGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkSecurityGroupIDRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.IPConfigurations {
+		ic := &mg.Spec.ForProvider.IPConfigurations[i]
+
+		// Resolve spec.forProvider.ipConfigurations[i].subnetID
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: ic.SubnetID,
+			Reference:    ic.SubnetIDRef,
+			Selector:     ic.SubnetIDSelector,
+			To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+			Extract:      SubnetID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.ipConfigurations[%d].subnetID", i)
+		}
+		ic.SubnetID = rsp.ResolvedValue
+		ic.SubnetIDRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.ipConfigurations[i].publicIPAddressID
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(ic.PublicIPAddressID),
+			Reference:    ic.PublicIPAddressIDRef,
+			Selector:     ic.PublicIPAddressIDSelector,
+			To:           reference.To{Managed: &PublicIPAddress{}, List: &PublicIPAddressList{}},
+			Extract:      PublicIPAddressID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.ipConfigurations[%d].publicIPAddressID", i)
+		}
+		ic.PublicIPAddressID = reference.ToPtrValue(rsp.ResolvedValue)
+		ic.PublicIPAddressIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this PublicIPPrefix.
+func (mg *PublicIPPrefix) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this NATGateway.
+func (mg *NATGateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.publicIPAddressIDs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PublicIPAddressIDs,
+		References:    mg.Spec.ForProvider.PublicIPAddressIDRefs,
+		Selector:      mg.Spec.ForProvider.PublicIPAddressIDSelector,
+		To:            reference.To{Managed: &PublicIPAddress{}, List: &PublicIPAddressList{}},
+		Extract:       PublicIPAddressID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.publicIPAddressIDs")
+	}
+	mg.Spec.ForProvider.PublicIPAddressIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PublicIPAddressIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.publicIPPrefixIDs
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PublicIPPrefixIDs,
+		References:    mg.Spec.ForProvider.PublicIPPrefixIDRefs,
+		Selector:      mg.Spec.ForProvider.PublicIPPrefixIDSelector,
+		To:            reference.To{Managed: &PublicIPPrefix{}, List: &PublicIPPrefixList{}},
+		Extract:       PublicIPPrefixID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.publicIPPrefixIDs")
+	}
+	mg.Spec.ForProvider.PublicIPPrefixIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PublicIPPrefixIDRefs = mrsp.ResolvedReferences
+
 	return nil
 }
@@ -61,8 +61,98 @@ var (
 	PublicIPAddressGroupVersionKind = SchemeGroupVersion.WithKind(PublicIPAddressKind)
 )
 
+// SecurityGroup type metadata.
+var (
+	SecurityGroupKind             = reflect.TypeOf(SecurityGroup{}).Name()
+	SecurityGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupKind}.String()
+	SecurityGroupKindAPIVersion   = SecurityGroupKind + "." + SchemeGroupVersion.String()
+	SecurityGroupGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupKind)
+)
+
+// SecurityRule type metadata.
+var (
+	SecurityRuleKind             = reflect.TypeOf(SecurityRule{}).Name()
+	SecurityRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityRuleKind}.String()
+	SecurityRuleKindAPIVersion   = SecurityRuleKind + "." + SchemeGroupVersion.String()
+	SecurityRuleGroupVersionKind = SchemeGroupVersion.WithKind(SecurityRuleKind)
+)
+
+// RouteTable type metadata.
+var (
+	RouteTableKind             = reflect.TypeOf(RouteTable{}).Name()
+	RouteTableGroupKind        = schema.GroupKind{Group: Group, Kind: RouteTableKind}.String()
+	RouteTableKindAPIVersion   = RouteTableKind + "." + SchemeGroupVersion.String()
+	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
+)
+
+// Route type metadata.
+var (
+	RouteKind             = reflect.TypeOf(Route{}).Name()
+	RouteGroupKind        = schema.GroupKind{Group: Group, Kind: RouteKind}.String()
+	RouteKindAPIVersion   = RouteKind + "." + SchemeGroupVersion.String()
+	RouteGroupVersionKind = SchemeGroupVersion.WithKind(RouteKind)
+)
+
+// VirtualNetworkPeering type metadata.
+var (
+	VirtualNetworkPeeringKind             = reflect.TypeOf(VirtualNetworkPeering{}).Name()
+	VirtualNetworkPeeringGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualNetworkPeeringKind}.String()
+	VirtualNetworkPeeringKindAPIVersion   = VirtualNetworkPeeringKind + "." + SchemeGroupVersion.String()
+	VirtualNetworkPeeringGroupVersionKind = SchemeGroupVersion.WithKind(VirtualNetworkPeeringKind)
+)
+
+// PrivateEndpoint type metadata.
+var (
+	PrivateEndpointKind             = reflect.TypeOf(PrivateEndpoint{}).Name()
+	PrivateEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: PrivateEndpointKind}.String()
+	PrivateEndpointKindAPIVersion   = PrivateEndpointKind + "." + SchemeGroupVersion.String()
+	PrivateEndpointGroupVersionKind = SchemeGroupVersion.WithKind(PrivateEndpointKind)
+)
+
+// LoadBalancer type metadata.
+var (
+	LoadBalancerKind             = reflect.TypeOf(LoadBalancer{}).Name()
+	LoadBalancerGroupKind        = schema.GroupKind{Group: Group, Kind: LoadBalancerKind}.String()
+	LoadBalancerKindAPIVersion   = LoadBalancerKind + "." + SchemeGroupVersion.String()
+	LoadBalancerGroupVersionKind = SchemeGroupVersion.WithKind(LoadBalancerKind)
+)
+
+// NetworkInterface type metadata.
+var (
+	NetworkInterfaceKind             = reflect.TypeOf(NetworkInterface{}).Name()
+	NetworkInterfaceGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkInterfaceKind}.String()
+	NetworkInterfaceKindAPIVersion   = NetworkInterfaceKind + "." + SchemeGroupVersion.String()
+	NetworkInterfaceGroupVersionKind = SchemeGroupVersion.WithKind(NetworkInterfaceKind)
+)
+
+// PublicIPPrefix type metadata.
+var (
+	PublicIPPrefixKind             = reflect.TypeOf(PublicIPPrefix{}).Name()
+	PublicIPPrefixGroupKind        = schema.GroupKind{Group: Group, Kind: PublicIPPrefixKind}.String()
+	PublicIPPrefixKindAPIVersion   = PublicIPPrefixKind + "." + SchemeGroupVersion.String()
+	PublicIPPrefixGroupVersionKind = SchemeGroupVersion.WithKind(PublicIPPrefixKind)
+)
+
+// NATGateway type metadata.
+var (
+	NATGatewayKind             = reflect.TypeOf(NATGateway{}).Name()
+	NATGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: NATGatewayKind}.String()
+	NATGatewayKindAPIVersion   = NATGatewayKind + "." + SchemeGroupVersion.String()
+	NATGatewayGroupVersionKind = SchemeGroupVersion.WithKind(NATGatewayKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VirtualNetwork{}, &VirtualNetworkList{})
 	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
 	SchemeBuilder.Register(&PublicIPAddress{}, &PublicIPAddressList{})
+	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
+	SchemeBuilder.Register(&SecurityRule{}, &SecurityRuleList{})
+	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&Route{}, &RouteList{})
+	SchemeBuilder.Register(&VirtualNetworkPeering{}, &VirtualNetworkPeeringList{})
+	SchemeBuilder.Register(&PrivateEndpoint{}, &PrivateEndpointList{})
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
+	SchemeBuilder.Register(&NetworkInterface{}, &NetworkInterfaceList{})
+	SchemeBuilder.Register(&PublicIPPrefix{}, &PublicIPPrefixList{})
+	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
 }
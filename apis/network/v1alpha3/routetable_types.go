@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RouteTableParameters define the desired state of an Azure route table. Its
+// routes are managed by Route resources.
+type RouteTableParameters struct {
+	// ResourceGroupName - Name of the route table's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the route table's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the route table's
+	// resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// DisableBGPRoutePropagation - Whether routes learned by BGP, e.g. from a
+	// virtual network gateway, are not propagated to the subnets associated
+	// with the route table.
+	// +optional
+	DisableBGPRoutePropagation *bool `json:"disableBgpRoutePropagation,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A RouteTableObservation represents the observed state of a RouteTable.
+type RouteTableObservation struct {
+	// State of this RouteTable.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this RouteTable.
+	ID string `json:"id,omitempty"`
+
+	// SubnetIDs - IDs of the subnets associated with this RouteTable.
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+}
+
+// A RouteTableSpec defines the desired state of a RouteTable.
+type RouteTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouteTableParameters `json:"forProvider"`
+}
+
+// A RouteTableStatus represents the observed state of a RouteTable.
+type RouteTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouteTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RouteTable is a managed resource that represents an Azure route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type RouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteTableSpec   `json:"spec"`
+	Status RouteTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteTableList contains a list of RouteTable items
+type RouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteTable `json:"items"`
+}
+
+// RouteParameters define the desired state of a route of an Azure route
+// table.
+type RouteParameters struct {
+	// ResourceGroupName - Name of the route's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the route's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the route's resource
+	// group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// RouteTableName - Name of the route table the route belongs to.
+	// +immutable
+	RouteTableName string `json:"routeTableName,omitempty"`
+
+	// RouteTableNameRef - A reference to the route table the route belongs
+	// to.
+	// +immutable
+	// +optional
+	RouteTableNameRef *xpv1.Reference `json:"routeTableNameRef,omitempty"`
+
+	// RouteTableNameSelector - Select a reference to the route table the
+	// route belongs to.
+	// +optional
+	RouteTableNameSelector *xpv1.Selector `json:"routeTableNameSelector,omitempty"`
+
+	// AddressPrefix - The destination CIDR the route applies to, or a service
+	// tag such as AzureCloud.
+	AddressPrefix string `json:"addressPrefix"`
+
+	// NextHopType - The type of hop matching traffic is sent to.
+	// +kubebuilder:validation:Enum=VirtualNetworkGateway;VnetLocal;Internet;VirtualAppliance;None
+	NextHopType string `json:"nextHopType"`
+
+	// NextHopIPAddress - The IP address matching traffic is forwarded to,
+	// e.g. that of a firewall. Only allowed when the next hop type is
+	// VirtualAppliance.
+	// +optional
+	NextHopIPAddress *string `json:"nextHopIPAddress,omitempty"`
+}
+
+// A RouteObservation represents the observed state of a Route.
+type RouteObservation struct {
+	// State of this Route.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this Route.
+	ID string `json:"id,omitempty"`
+}
+
+// A RouteSpec defines the desired state of a Route.
+type RouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouteParameters `json:"forProvider"`
+}
+
+// A RouteStatus represents the observed state of a Route.
+type RouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Route is a managed resource that represents a route of an Azure route
+// table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PREFIX",type="string",JSONPath=".spec.forProvider.addressPrefix"
+// +kubebuilder:printcolumn:name="NEXT-HOP",type="string",JSONPath=".spec.forProvider.nextHopType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteSpec   `json:"spec"`
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList contains a list of Route items
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Route `json:"items"`
+}
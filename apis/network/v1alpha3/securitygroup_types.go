@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SecurityGroupParameters define the desired state of an Azure network
+// security group. Its rules are managed by SecurityRule resources.
+type SecurityGroupParameters struct {
+	// ResourceGroupName - Name of the network security group's resource
+	// group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the network security group's
+	// resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the network security
+	// group's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
+	// +immutable
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SecurityGroupObservation represents the observed state of a
+// SecurityGroup.
+type SecurityGroupObservation struct {
+	// State of this SecurityGroup.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this SecurityGroup.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this SecurityGroup.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+}
+
+// A SecurityGroupSpec defines the desired state of a SecurityGroup.
+type SecurityGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityGroupParameters `json:"forProvider"`
+}
+
+// A SecurityGroupStatus represents the observed state of a SecurityGroup.
+type SecurityGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroup is a managed resource that represents an Azure network
+// security group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupSpec   `json:"spec"`
+	Status SecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroup items
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
+
+// SecurityRuleParameters define the desired state of a rule of an Azure
+// network security group.
+type SecurityRuleParameters struct {
+	// ResourceGroupName - Name of the security rule's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the security rule's resource
+	// group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the security rule's
+	// resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// SecurityGroupName - Name of the network security group the rule
+	// belongs to.
+	// +immutable
+	SecurityGroupName string `json:"securityGroupName,omitempty"`
+
+	// SecurityGroupNameRef - A reference to the network security group the
+	// rule belongs to.
+	// +immutable
+	// +optional
+	SecurityGroupNameRef *xpv1.Reference `json:"securityGroupNameRef,omitempty"`
+
+	// SecurityGroupNameSelector - Select a reference to the network security
+	// group the rule belongs to.
+	// +optional
+	SecurityGroupNameSelector *xpv1.Selector `json:"securityGroupNameSelector,omitempty"`
+
+	// Description - A description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Priority - Rules are processed in priority order; the lower the
+	// number, the higher the priority. Must be unique within the network
+	// security group.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=4096
+	Priority int32 `json:"priority"`
+
+	// Direction - Whether the rule applies to inbound or outbound traffic.
+	// +kubebuilder:validation:Enum=Inbound;Outbound
+	Direction string `json:"direction"`
+
+	// Access - Whether matching traffic is allowed or denied.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Access string `json:"access"`
+
+	// Protocol - The network protocol the rule applies to.
+	// +kubebuilder:validation:Enum=Tcp;Udp;Icmp;Esp;*
+	Protocol string `json:"protocol"`
+
+	// SourcePortRanges - Source ports or port ranges, e.g. 80 or 1024-65535.
+	// An asterisk matches all ports.
+	// +kubebuilder:validation:MinItems=1
+	SourcePortRanges []string `json:"sourcePortRanges"`
+
+	// DestinationPortRanges - Destination ports or port ranges, e.g. 80 or
+	// 1024-65535. An asterisk matches all ports.
+	// +kubebuilder:validation:MinItems=1
+	DestinationPortRanges []string `json:"destinationPortRanges"`
+
+	// SourceAddressPrefixes - Source CIDR ranges or IP addresses, or a single
+	// service tag such as VirtualNetwork, AzureLoadBalancer or Internet. An
+	// asterisk matches all addresses. Either these or source application
+	// security groups must be set.
+	// +optional
+	SourceAddressPrefixes []string `json:"sourceAddressPrefixes,omitempty"`
+
+	// DestinationAddressPrefixes - Destination CIDR ranges or IP addresses,
+	// or a single service tag such as VirtualNetwork, AzureLoadBalancer or
+	// Internet. An asterisk matches all addresses. Either these or
+	// destination application security groups must be set.
+	// +optional
+	DestinationAddressPrefixes []string `json:"destinationAddressPrefixes,omitempty"`
+
+	// SourceApplicationSecurityGroupIDs - IDs of the application security
+	// groups the source addresses belong to.
+	// +optional
+	SourceApplicationSecurityGroupIDs []string `json:"sourceApplicationSecurityGroupIDs,omitempty"`
+
+	// DestinationApplicationSecurityGroupIDs - IDs of the application
+	// security groups the destination addresses belong to.
+	// +optional
+	DestinationApplicationSecurityGroupIDs []string `json:"destinationApplicationSecurityGroupIDs,omitempty"`
+}
+
+// A SecurityRuleObservation represents the observed state of a SecurityRule.
+type SecurityRuleObservation struct {
+	// State of this SecurityRule.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this SecurityRule.
+	ID string `json:"id,omitempty"`
+}
+
+// A SecurityRuleSpec defines the desired state of a SecurityRule.
+type SecurityRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityRuleParameters `json:"forProvider"`
+}
+
+// A SecurityRuleStatus represents the observed state of a SecurityRule.
+type SecurityRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityRule is a managed resource that represents a rule of an Azure
+// network security group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="DIRECTION",type="string",JSONPath=".spec.forProvider.direction"
+// +kubebuilder:printcolumn:name="ACCESS",type="string",JSONPath=".spec.forProvider.access"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type SecurityRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityRuleSpec   `json:"spec"`
+	Status SecurityRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityRuleList contains a list of SecurityRule items
+type SecurityRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityRule `json:"items"`
+}
@@ -68,7 +68,9 @@ type VirtualNetworkSpec struct {
 	VirtualNetworkPropertiesFormat `json:"properties"`
 
 	// Location - Resource location.
-	Location string `json:"location"`
+	// Defaults to the defaultLocation of the ProviderConfig.
+	// +optional
+	Location string `json:"location,omitempty"`
 
 	// Tags - Resource tags.
 	// +optional
@@ -150,6 +152,52 @@ type SubnetPropertiesFormat struct {
 
 	// ServiceEndpoints - An array of service endpoints.
 	ServiceEndpoints []ServiceEndpointPropertiesFormat `json:"serviceEndpoints,omitempty"`
+
+	// NetworkSecurityGroupID - The ID of the network security group
+	// associated with the subnet. A network security group associated
+	// outside of Crossplane is left in place when this is not set.
+	// +optional
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupID,omitempty"`
+
+	// NetworkSecurityGroupIDRef - A reference to a SecurityGroup to retrieve
+	// its ID.
+	// +optional
+	NetworkSecurityGroupIDRef *xpv1.Reference `json:"networkSecurityGroupIDRef,omitempty"`
+
+	// NetworkSecurityGroupIDSelector - Select a reference to a SecurityGroup
+	// to retrieve its ID.
+	// +optional
+	NetworkSecurityGroupIDSelector *xpv1.Selector `json:"networkSecurityGroupIDSelector,omitempty"`
+
+	// RouteTableID - The ID of the route table associated with the subnet. A
+	// route table associated outside of Crossplane, for example by AKS, is
+	// left in place when this is not set.
+	// +optional
+	RouteTableID *string `json:"routeTableID,omitempty"`
+
+	// RouteTableIDRef - A reference to a RouteTable to retrieve its ID.
+	// +optional
+	RouteTableIDRef *xpv1.Reference `json:"routeTableIDRef,omitempty"`
+
+	// RouteTableIDSelector - Select a reference to a RouteTable to retrieve
+	// its ID.
+	// +optional
+	RouteTableIDSelector *xpv1.Selector `json:"routeTableIDSelector,omitempty"`
+
+	// NATGatewayID - The ID of the NAT gateway associated with the subnet. A
+	// NAT gateway associated outside of Crossplane is left in place when this
+	// is not set.
+	// +optional
+	NATGatewayID *string `json:"natGatewayID,omitempty"`
+
+	// NATGatewayIDRef - A reference to a NATGateway to retrieve its ID.
+	// +optional
+	NATGatewayIDRef *xpv1.Reference `json:"natGatewayIDRef,omitempty"`
+
+	// NATGatewayIDSelector - Select a reference to a NATGateway to retrieve
+	// its ID.
+	// +optional
+	NATGatewayIDSelector *xpv1.Selector `json:"natGatewayIDSelector,omitempty"`
 }
 
 // A SubnetSpec defines the desired state of a Subnet.
@@ -290,10 +338,12 @@ type PublicIPAddressProperties struct {
 	// +immutable
 	PublicIPAddressVersion string `json:"version"`
 
-	// Location - Resource location.
+	// Location - Resource location. Defaults to the defaultLocation of the
+	// ProviderConfig.
 	// +kubebuilder:validation:MinLength:=1
 	// +immutable
-	Location string `json:"location"`
+	// +optional
+	Location string `json:"location,omitempty"`
 
 	// SKU of PublicIPAddress
 	// +optional
@@ -303,6 +353,15 @@ type PublicIPAddressProperties struct {
 	// +optional
 	PublicIPPrefixID *string `json:"publicIPPrefixID,omitempty"`
 
+	// PublicIPPrefixIDRef - A reference to a PublicIPPrefix to retrieve its ID.
+	// +optional
+	PublicIPPrefixIDRef *xpv1.Reference `json:"publicIPPrefixIDRef,omitempty"`
+
+	// PublicIPPrefixIDSelector - Select a reference to a PublicIPPrefix to
+	// retrieve its ID.
+	// +optional
+	PublicIPPrefixIDSelector *xpv1.Selector `json:"publicIPPrefixIDSelector,omitempty"`
+
 	// PublicIPAddressDNSSettings - The FQDN of the DNS record associated with the public IP address.
 	// +optional
 	PublicIPAddressDNSSettings *PublicIPAddressDNSSettings `json:"dnsSettings,omitempty"`
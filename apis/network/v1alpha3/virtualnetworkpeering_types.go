@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VirtualNetworkPeeringParameters define the desired state of an Azure
+// virtual network peering.
+type VirtualNetworkPeeringParameters struct {
+	// ResourceGroupName - Name of the local virtual network's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the local virtual network's
+	// resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the local virtual
+	// network's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// VirtualNetworkName - Name of the local virtual network the peering
+	// belongs to.
+	// +immutable
+	VirtualNetworkName string `json:"virtualNetworkName,omitempty"`
+
+	// VirtualNetworkNameRef - A reference to the local virtual network the
+	// peering belongs to.
+	// +immutable
+	// +optional
+	VirtualNetworkNameRef *xpv1.Reference `json:"virtualNetworkNameRef,omitempty"`
+
+	// VirtualNetworkNameSelector - Select a reference to the local virtual
+	// network the peering belongs to.
+	// +optional
+	VirtualNetworkNameSelector *xpv1.Selector `json:"virtualNetworkNameSelector,omitempty"`
+
+	// RemoteVirtualNetworkID - The full resource ID of the remote virtual
+	// network, which may be in another subscription.
+	// +immutable
+	RemoteVirtualNetworkID string `json:"remoteVirtualNetworkID,omitempty"`
+
+	// RemoteVirtualNetworkIDRef - A reference to a VirtualNetwork to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	RemoteVirtualNetworkIDRef *xpv1.Reference `json:"remoteVirtualNetworkIDRef,omitempty"`
+
+	// RemoteVirtualNetworkIDSelector - Select a reference to a
+	// VirtualNetwork to retrieve its ID.
+	// +optional
+	RemoteVirtualNetworkIDSelector *xpv1.Selector `json:"remoteVirtualNetworkIDSelector,omitempty"`
+
+	// AllowVirtualNetworkAccess - Whether virtual machines in the local
+	// virtual network can access those in the remote virtual network.
+	// +optional
+	AllowVirtualNetworkAccess *bool `json:"allowVirtualNetworkAccess,omitempty"`
+
+	// AllowForwardedTraffic - Whether traffic forwarded by virtual machines
+	// in the remote virtual network, e.g. by a firewall, is allowed into the
+	// local virtual network.
+	// +optional
+	AllowForwardedTraffic *bool `json:"allowForwardedTraffic,omitempty"`
+
+	// AllowGatewayTransit - Whether the remote virtual network can use the
+	// gateway of the local virtual network.
+	// +optional
+	AllowGatewayTransit *bool `json:"allowGatewayTransit,omitempty"`
+
+	// UseRemoteGateways - Whether the local virtual network uses the gateway
+	// of the remote virtual network. The remote peering must allow gateway
+	// transit, and the local virtual network must not have a gateway.
+	// +optional
+	UseRemoteGateways *bool `json:"useRemoteGateways,omitempty"`
+}
+
+// A VirtualNetworkPeeringObservation represents the observed state of a
+// VirtualNetworkPeering.
+type VirtualNetworkPeeringObservation struct {
+	// State - The provisioning state of this VirtualNetworkPeering.
+	State string `json:"state,omitempty"`
+
+	// PeeringState - The state of the peering. It is Initiated until the
+	// remote virtual network is peered back, and Connected afterwards.
+	PeeringState string `json:"peeringState,omitempty"`
+
+	// PeeringSyncLevel - Whether the address spaces known to both sides of
+	// the peering are in sync, e.g. FullyInSync or LocalNotInSync.
+	PeeringSyncLevel string `json:"peeringSyncLevel,omitempty"`
+
+	// RemoteAddressPrefixes - The address prefixes of the remote virtual
+	// network that are reachable through the peering.
+	RemoteAddressPrefixes []string `json:"remoteAddressPrefixes,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this VirtualNetworkPeering.
+	ID string `json:"id,omitempty"`
+}
+
+// A VirtualNetworkPeeringSpec defines the desired state of a
+// VirtualNetworkPeering.
+type VirtualNetworkPeeringSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VirtualNetworkPeeringParameters `json:"forProvider"`
+}
+
+// A VirtualNetworkPeeringStatus represents the observed state of a
+// VirtualNetworkPeering.
+type VirtualNetworkPeeringStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VirtualNetworkPeeringObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualNetworkPeering is a managed resource that represents the peering of
+// an Azure virtual network with a remote virtual network. A peering must be
+// created on both virtual networks before traffic can flow.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PEERING",type="string",JSONPath=".status.atProvider.peeringState"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.atProvider.peeringSyncLevel"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type VirtualNetworkPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualNetworkPeeringSpec   `json:"spec"`
+	Status VirtualNetworkPeeringStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualNetworkPeeringList contains a list of VirtualNetworkPeering items
+type VirtualNetworkPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualNetworkPeering `json:"items"`
+}
@@ -26,7 +26,19 @@ import (
 // AccountParameters define the desired state of an Azure Blob Storage Account.
 type AccountParameters struct {
 	// ResourceGroupName specifies the resource group for this Account.
-	ResourceGroupName string `json:"resourceGroupName"`
+	// +optional
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef references a ResourceGroup to retrieve its name.
+	// +optional
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector selects a reference to a ResourceGroup to
+	// retrieve its name.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
 
 	// StorageAccountSpec specifies the desired state of this Account.
 	StorageAccountSpec *StorageAccountSpec `json:"storageAccountSpec"`
@@ -50,6 +62,8 @@ type AccountStatus struct {
 // An Account is a managed resource that represents an Azure Blob Service
 // Account.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="RESOURCE_GROUP",type="string",JSONPath=".spec.resourceGroupName"
 // +kubebuilder:printcolumn:name="ACCOUNT_NAME",type="string",JSONPath=".spec.storageAccountName"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
@@ -73,12 +87,46 @@ type AccountList struct {
 // ContainerParameters define the desired state of an Azure Blob Storage
 // Container.
 type ContainerParameters struct {
+	// ResourceGroupName is the name of the resource group of the storage
+	// account.
+	// +optional
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef references a ResourceGroup to retrieve its name.
+	// +optional
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector selects a reference to a ResourceGroup to
+	// retrieve its name.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// AccountName is the name of the storage account of this Container. The
+	// storage account need not be managed by Crossplane.
+	// +optional
+	// +immutable
+	AccountName string `json:"accountName,omitempty"`
+
+	// AccountNameRef references an Account to retrieve its name.
+	// +optional
+	// +immutable
+	AccountNameRef *xpv1.Reference `json:"accountNameRef,omitempty"`
+
+	// AccountNameSelector selects a reference to an Account to retrieve its
+	// name.
+	// +optional
+	AccountNameSelector *xpv1.Selector `json:"accountNameSelector,omitempty"`
+
 	// Metadata for this Container.
 	// +optional
 	Metadata azblob.Metadata `json:"metadata,omitempty"`
 
 	// PublicAccessType for this container; either "blob" or "container".
+	// Public access is disabled if it is not specified.
 	// +optional
+	// +kubebuilder:validation:Enum=blob;container
 	PublicAccessType azblob.PublicAccessType `json:"publicAccessType,omitempty"`
 }
 
@@ -96,10 +144,11 @@ type ContainerStatus struct {
 // +kubebuilder:object:root=true
 
 // A Container is a managed resource that represents an Azure Blob Storage
-// Container.
+// Container. Containers are managed using the Azure Resource Manager API, with
+// the credentials of their ProviderConfig.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:printcolumn:name="STORAGE_ACCOUNT",type="string",JSONPath=".spec.accountRef.name"
+// +kubebuilder:printcolumn:name="STORAGE_ACCOUNT",type="string",JSONPath=".spec.accountName"
 // +kubebuilder:printcolumn:name="PUBLIC_ACCESS_TYPE",type="string",JSONPath=".spec.publicAccessType"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
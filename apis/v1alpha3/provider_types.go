@@ -66,7 +66,38 @@ type ResourceGroupSpec struct {
 
 	// Location of the resource group. See the  official list of valid regions -
 	// https://azure.microsoft.com/en-us/global-infrastructure/regions/
-	Location string `json:"location"`
+	// Defaults to the defaultLocation of the ProviderConfig.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Tags of the resource group.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Lock is a management lock that protects the resource group and the
+	// resources within it. The lock is removed before the resource group is
+	// deleted.
+	// +optional
+	Lock *ResourceGroupLock `json:"lock,omitempty"`
+}
+
+// Management lock levels.
+const (
+	LockLevelCanNotDelete = "CanNotDelete"
+	LockLevelReadOnly     = "ReadOnly"
+)
+
+// A ResourceGroupLock is a management lock of a resource group.
+type ResourceGroupLock struct {
+	// Level of the lock. CanNotDelete means authorized users are able to read
+	// and modify the resources, but not delete them. ReadOnly means
+	// authorized users can only read the resources.
+	// +kubebuilder:validation:Enum=CanNotDelete;ReadOnly
+	Level string `json:"level"`
+
+	// Notes about the lock.
+	// +optional
+	Notes *string `json:"notes,omitempty"`
 }
 
 // A ResourceGroupStatus represents the observed status of a ResourceGroup.
@@ -26,26 +26,165 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// SubscriptionID of the Azure subscription that resources are managed in.
+	// Takes precedence over the subscription ID of the credentials. Managed
+	// resources may override it using the azure.crossplane.io/subscription-id
+	// annotation.
+	// +optional
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
+	// Environment is the Azure cloud this provider manages resources in. Its
+	// endpoints take precedence over any endpoints in the credentials. When
+	// unset the endpoints in the credentials are used, defaulting to those
+	// of AzurePublicCloud.
+	// +kubebuilder:validation:Enum=AzurePublicCloud;AzureUSGovernmentCloud;AzureChinaCloud;Custom
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// CustomEnvironment configures the endpoints of the Azure cloud this
+	// provider manages resources in. Required when the environment is Custom.
+	// +optional
+	CustomEnvironment *EnvironmentEndpoints `json:"customEnvironment,omitempty"`
+
+	// DefaultTags are added to the tags of every managed resource that uses
+	// this ProviderConfig and supports tags. Tags set on a managed resource
+	// take precedence over default tags with the same key.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// DefaultLocation is the location of managed resources that use this
+	// ProviderConfig and do not specify a location.
+	// +optional
+	DefaultLocation string `json:"defaultLocation,omitempty"`
 }
 
+// Azure cloud environments.
+const (
+	EnvironmentPublicCloud       = "AzurePublicCloud"
+	EnvironmentUSGovernmentCloud = "AzureUSGovernmentCloud"
+	EnvironmentChinaCloud        = "AzureChinaCloud"
+	EnvironmentCustom            = "Custom"
+)
+
+// EnvironmentEndpoints are the endpoints of an Azure cloud.
+type EnvironmentEndpoints struct {
+	// ResourceManagerEndpoint is the base URI of the Azure Resource Manager
+	// API, for example https://management.azure.com/.
+	ResourceManagerEndpoint string `json:"resourceManagerEndpoint"`
+
+	// ActiveDirectoryEndpoint is the Azure AD authority host, for example
+	// https://login.microsoftonline.com/.
+	ActiveDirectoryEndpoint string `json:"activeDirectoryEndpoint"`
+
+	// GraphEndpoint is the base URI of the Azure AD Graph API, for example
+	// https://graph.windows.net/.
+	// +optional
+	GraphEndpoint string `json:"graphEndpoint,omitempty"`
+
+	// StorageEndpointSuffix is the DNS suffix of storage account endpoints,
+	// for example core.windows.net.
+	// +optional
+	StorageEndpointSuffix string `json:"storageEndpointSuffix,omitempty"`
+
+	// KeyVaultDNSSuffix is the DNS suffix of Key Vaults, for example
+	// vault.azure.net.
+	// +optional
+	KeyVaultDNSSuffix string `json:"keyVaultDNSSuffix,omitempty"`
+}
+
+// Azure specific sources of provider credentials.
+const (
+	// CredentialsSourceWorkloadIdentity indicates that a provider should
+	// exchange a projected service account token for an Azure AD token using
+	// Azure AD workload identity federation.
+	CredentialsSourceWorkloadIdentity xpv1.CredentialsSource = "WorkloadIdentity"
+
+	// CredentialsSourceUserAssignedManagedIdentity indicates that a provider
+	// should acquire tokens for a user-assigned managed identity from the
+	// instance metadata service.
+	CredentialsSourceUserAssignedManagedIdentity xpv1.CredentialsSource = "UserAssignedManagedIdentity"
+
+	// CredentialsSourceSystemAssignedManagedIdentity indicates that a provider
+	// should acquire tokens for the system-assigned managed identity of the
+	// node it runs on from the instance metadata service.
+	CredentialsSourceSystemAssignedManagedIdentity xpv1.CredentialsSource = "SystemAssignedManagedIdentity"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;Environment;Filesystem;WorkloadIdentity;UserAssignedManagedIdentity;SystemAssignedManagedIdentity
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// Identity configures how to authenticate when the source is
+	// WorkloadIdentity, UserAssignedManagedIdentity or
+	// SystemAssignedManagedIdentity.
+	// +optional
+	Identity *IdentityCredentials `json:"identity,omitempty"`
+}
+
+// IdentityCredentials configure authentication using an Azure AD identity
+// rather than a service principal secret.
+type IdentityCredentials struct {
+	// SubscriptionID of the Azure subscription that resources are managed in.
+	// Required unless spec.subscriptionID is set.
+	// +optional
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
+	// TenantID of the Azure AD tenant the identity belongs to. Used by
+	// WorkloadIdentity, where it defaults to the AZURE_TENANT_ID environment
+	// variable.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// ClientID of the identity. Required for UserAssignedManagedIdentity. Used
+	// by WorkloadIdentity, where it defaults to the AZURE_CLIENT_ID
+	// environment variable.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+
+	// TokenFilePath is the path of the projected service account token that
+	// is exchanged for an Azure AD token. Used by WorkloadIdentity, where it
+	// defaults to the AZURE_FEDERATED_TOKEN_FILE environment variable.
+	// +optional
+	TokenFilePath string `json:"tokenFilePath,omitempty"`
+
+	// Endpoint from which tokens are requested. For WorkloadIdentity this is
+	// the Azure AD authority host, which defaults to the AZURE_AUTHORITY_HOST
+	// environment variable or the public cloud. For managed identities this
+	// is the token endpoint of the instance metadata service.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// TenantID of the Azure AD tenant the credentials authenticated against.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// SubscriptionID of the Azure subscription that resources are managed in,
+	// unless overridden by a managed resource.
+	// +optional
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
+	// TokenExpiry is the time at which the Azure AD token most recently
+	// acquired using the credentials expires.
+	// +optional
+	TokenExpiry *metav1.Time `json:"tokenExpiry,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures an Azure 'provider', i.e. a connection to a particular
 // Azure account using a particular Azure Service Principal.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SUBSCRIPTION",type="string",JSONPath=".status.subscriptionID"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,azure}
 // +kubebuilder:subresource:status
@@ -24,10 +24,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -52,8 +51,10 @@ const (
 	errGetProviderConfig         = "cannot get referenced ProviderConfig"
 	errGetProvider               = "cannot get referenced Provider"
 	errNeitherPCNorPGiven        = "neither providerConfigRef nor providerRef was supplied"
+	errGetCredentials            = "cannot get credentials"
 	errUnmarshalCredentialSecret = "cannot unmarshal the data in credentials secret"
 	errGetAuthorizer             = "cannot get authorizer from client credentials config"
+	errNoSubscriptionID          = "neither spec.subscriptionID nor the credentials specify a subscription ID"
 )
 
 // A FieldOption determines how common Go types are translated to the types
@@ -69,10 +70,16 @@ const (
 	FieldRequired FieldOption = iota
 )
 
+// AnnotationKeySubscriptionID is the annotation that overrides the
+// subscription a managed resource is managed in.
+const AnnotationKeySubscriptionID = "azure.crossplane.io/subscription-id"
+
 // Credentials Secret content is a json whose keys are below.
 const (
 	CredentialsKeyClientID                       = "clientId"
 	CredentialsKeyClientSecret                   = "clientSecret"
+	CredentialsKeyClientCertificate              = "clientCertificate"
+	CredentialsKeyClientCertificatePassword      = "clientCertificatePassword"
 	CredentialsKeyTenantID                       = "tenantId"
 	CredentialsKeySubscriptionID                 = "subscriptionId"
 	CredentialsKeyActiveDirectoryEndpointURL     = "activeDirectoryEndpointUrl"
@@ -81,72 +88,132 @@ const (
 	CredentialsKeySQLManagementEndpointURL       = "sqlManagementEndpointUrl"
 	CredentialsKeyGalleryEndpointURL             = "galleryEndpointUrl"
 	CredentialsManagementEndpointURL             = "managementEndpointUrl"
+	CredentialsKeyFederatedTokenFile             = "federatedTokenFile"
+	CredentialsKeyMSIEndpoint                    = "msiEndpoint"
+	CredentialsKeyStorageEndpointSuffix          = "storageEndpointSuffix"
+	CredentialsKeyKeyVaultDNSSuffix              = "keyVaultDnsSuffix"
 )
 
 // GetAuthInfo figures out how to connect to Azure API and returns the necessary
 // information to be used for controllers to construct their specific clients.
 func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	cc, err := GetCredentials(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return authInfo(cc)
+}
+
+// GetCredentials returns the cached credentials of the Provider or
+// ProviderConfig referenced by the supplied managed resource. Controllers that
+// need tokens for resources other than Azure Resource Manager should use them
+// rather than acquiring their own tokens.
+func GetCredentials(ctx context.Context, c client.Client, mg resource.Managed) (*CachedCredentials, error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg)
+		return providerConfigCredentialsFor(ctx, c, mg)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg)
+		return providerCredentialsFor(ctx, c, mg)
 	default:
-		return nil, nil, errors.New(errNeitherPCNorPGiven)
+		return nil, errors.New(errNeitherPCNorPGiven)
 	}
 }
 
 // UseProvider to return the necessary information to construct an Azure client.
 // Deprecated: Use UseProviderConfig
 func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
-	p := &v1alpha3.Provider{}
-	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
-		return nil, nil, errors.Wrap(err, errGetProvider)
-	}
-
-	ref := p.Spec.CredentialsSecretRef
-	s := &corev1.Secret{}
-	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+	cc, err := providerCredentialsFor(ctx, c, mg)
+	if err != nil {
 		return nil, nil, err
 	}
-	m := map[string]string{}
-	if err := json.Unmarshal(s.Data[ref.Key], &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
-	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
-
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+	return authInfo(cc)
 }
 
 // UseProviderConfig to return the necessary information to construct an Azure
 // client.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	cc, err := providerConfigCredentialsFor(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return authInfo(cc)
+}
+
+func providerCredentialsFor(ctx context.Context, c client.Client, mg resource.Managed) (*CachedCredentials, error) {
+	p := &v1alpha3.Provider{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
+		return nil, errors.Wrap(err, errGetProvider)
+	}
+	return DefaultCredentialsCache.Provider(ctx, c, p)
+}
+
+func providerConfigCredentialsFor(ctx context.Context, c client.Client, mg resource.Managed) (*CachedCredentials, error) {
 	pc := &v1beta1.ProviderConfig{}
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
-		return nil, nil, errors.Wrap(err, errTrackProviderConfigUsage)
+		return nil, errors.Wrap(err, errTrackProviderConfigUsage)
 	}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, nil, errors.Wrap(err, errGetProviderConfig)
+		return nil, errors.Wrap(err, errGetProviderConfig)
 	}
+	return DefaultCredentialsCache.ProviderConfig(ctx, c, pc)
+}
 
-	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+func authInfo(cc *CachedCredentials) (map[string]string, autorest.Authorizer, error) {
+	m := cc.Credentials()
+	a, err := cc.Authorizer(m[CredentialsKeyResourceManagerEndpointURL])
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "cannot get credentials")
+		return nil, nil, errors.Wrap(err, errGetAuthorizer)
+	}
+	return m, a, nil
+}
+
+// ProviderConfigCredentials returns the credentials content of the supplied
+// ProviderConfig, keyed like the JSON credentials blob. The endpoints of the
+// ProviderConfig's environment are included in the content.
+func ProviderConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (map[string]string, error) {
+	var data []byte
+	if !IsIdentitySource(pc.Spec.Credentials.Source) {
+		d, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCredentials)
+		}
+		data = d
 	}
+	return providerConfigCredentials(pc, data)
+}
+
+// providerConfigCredentials returns the credentials content of the supplied
+// ProviderConfig given its extracted credentials data, if any.
+func providerConfigCredentials(pc *v1beta1.ProviderConfig, data []byte) (map[string]string, error) {
 	m := map[string]string{}
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+	if IsIdentitySource(pc.Spec.Credentials.Source) {
+		im, err := IdentityCredentials(pc.Spec.Credentials.Source, pc.Spec.Credentials.Identity)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCredentials)
+		}
+		m = im
+	} else if err := json.Unmarshal(data, &m); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+	}
+	if pc.Spec.SubscriptionID != "" {
+		m[CredentialsKeySubscriptionID] = pc.Spec.SubscriptionID
+	}
+	if m[CredentialsKeySubscriptionID] == "" {
+		return nil, errors.New(errNoSubscriptionID)
 	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
+	return m, SetEnvironment(m, pc.Spec)
+}
 
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+// SubscriptionID returns the ID of the subscription the supplied managed
+// resource is managed in. This is the subscription ID annotation of the
+// resource if it is set, and the subscription ID of the supplied credentials
+// content otherwise.
+func SubscriptionID(o metav1.Object, creds map[string]string) string {
+	if id := o.GetAnnotations()[AnnotationKeySubscriptionID]; id != "" {
+		return id
+	}
+	return creds[CredentialsKeySubscriptionID]
 }
 
 // Client struct that represents the information needed to connect to the Azure services as a client
@@ -175,13 +242,15 @@ func NewClient(credentials []byte) (*Client, error) {
 	if err := json.Unmarshal(credentials, &creds); err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal azure client secret data")
 	}
+	m := map[string]string{}
+	if err := json.Unmarshal(credentials, &m); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal azure client secret data")
+	}
+	if err := SetEnvironment(m, v1beta1.ProviderConfigSpec{}); err != nil {
+		return nil, err
+	}
 
-	// create a config object from the loaded credentials data
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := NewAuthorizer(m)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get authorizer from config")
 	}
@@ -193,20 +262,21 @@ func NewClient(credentials []byte) (*Client, error) {
 			ClientID:                       creds.ClientID,
 			ClientSecret:                   creds.ClientSecret,
 			TenantID:                       creds.TenantID,
-			ActiveDirectoryEndpointURL:     creds.ActiveDirectoryEndpointURL,
-			ActiveDirectoryGraphResourceID: creds.ActiveDirectoryGraphResourceID,
+			ActiveDirectoryEndpointURL:     m[CredentialsKeyActiveDirectoryEndpointURL],
+			ResourceManagerEndpointURL:     m[CredentialsKeyResourceManagerEndpointURL],
+			ActiveDirectoryGraphResourceID: m[CredentialsKeyActiveDirectoryGraphResourceID],
 		},
 	}, nil
 }
 
-// ValidateClient verifies if the given client is valid by testing if it can make an Azure service API call
-// TODO: is there a better way to validate the Azure client?
-func ValidateClient(client *Client) error {
-	groupsClient := resources.NewGroupsClient(client.SubscriptionID)
+// ValidateClient verifies if the given client is valid by testing if it can
+// list the resource groups of its subscription.
+func ValidateClient(ctx context.Context, client *Client) error {
+	groupsClient := resources.NewGroupsClientWithBaseURI(client.ResourceManagerEndpointURL, client.SubscriptionID)
 	groupsClient.Authorizer = client.Authorizer
-	groupsClient.AddToUserAgent(UserAgent)
+	_ = groupsClient.AddToUserAgent(UserAgent)
 
-	_, err := groupsClient.ListComplete(context.TODO(), "", nil)
+	_, err := groupsClient.List(ctx, "", to.Int32Ptr(1))
 	return err
 }
 
@@ -28,10 +28,14 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
 )
 
 const (
@@ -57,6 +61,94 @@ func TestNewClient(t *testing.T) {
 	g.Expect(client.SubscriptionID).To(gomega.Equal("bf1b0e59-93da-42e0-82c6-5a1d94227911"))
 }
 
+func TestProviderConfigCredentials(t *testing.T) {
+	type want struct {
+		subscriptionID string
+		err            error
+	}
+
+	cases := map[string]struct {
+		reason string
+		spec   v1beta1.ProviderConfigSpec
+		want   want
+	}{
+		"CredentialsSubscription": {
+			reason: "The subscription of the credentials should be used when the ProviderConfig specifies none.",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			},
+			want: want{subscriptionID: "bf1b0e59-93da-42e0-82c6-5a1d94227911"},
+		},
+		"SpecSubscription": {
+			reason: "The subscription of the ProviderConfig should take precedence over that of the credentials.",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials:    v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+				SubscriptionID: "cool-subscription",
+			},
+			want: want{subscriptionID: "cool-subscription"},
+		},
+		"IdentitySpecSubscription": {
+			reason: "An identity need not specify a subscription when the ProviderConfig does.",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{
+					Source:   v1beta1.CredentialsSourceSystemAssignedManagedIdentity,
+					Identity: &v1beta1.IdentityCredentials{},
+				},
+				SubscriptionID: "cool-subscription",
+			},
+			want: want{subscriptionID: "cool-subscription"},
+		},
+		"NoSubscription": {
+			reason: "Either the ProviderConfig or its credentials must specify a subscription.",
+			spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{
+					Source:   v1beta1.CredentialsSourceSystemAssignedManagedIdentity,
+					Identity: &v1beta1.IdentityCredentials{},
+				},
+			},
+			want: want{err: errors.New(errNoSubscriptionID)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			creds, err := providerConfigCredentials(&v1beta1.ProviderConfig{Spec: tc.spec}, []byte(authData))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nproviderConfigCredentials(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.subscriptionID, creds[CredentialsKeySubscriptionID]); diff != "" {
+				t.Errorf("\n%s\nproviderConfigCredentials(...): -want subscription, +got subscription:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSubscriptionID(t *testing.T) {
+	creds := map[string]string{CredentialsKeySubscriptionID: "credentials-subscription"}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		want        string
+	}{
+		"NoAnnotation": {
+			want: "credentials-subscription",
+		},
+		"Annotation": {
+			annotations: map[string]string{AnnotationKeySubscriptionID: "annotated-subscription"},
+			want:        "annotated-subscription",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{Annotations: tc.annotations}
+			if diff := cmp.Diff(tc.want, SubscriptionID(o, creds)); diff != "" {
+				t.Errorf("SubscriptionID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestFetchAsyncOperation(t *testing.T) {
 	inprogressStatus := "inprogress"
 	inProgressResponse := fmt.Sprintf(`{"status": "%s"}`, inprogressStatus)
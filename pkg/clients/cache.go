@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errGetCredentialsSecret = "cannot get credentials secret"
+	errNoSecretRef          = "cannot extract from secret key when none specified"
+)
+
+// Kinds of credentials cache entries.
+const (
+	cacheKindProvider       = "Provider"
+	cacheKindProviderConfig = "ProviderConfig"
+)
+
+var (
+	cacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_azure_credentials_cache_hits_total",
+		Help: "Number of times cached credentials were reused.",
+	}, []string{"kind", "name"})
+
+	cacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_azure_credentials_cache_misses_total",
+		Help: "Number of times credentials were loaded because they were not cached or had changed.",
+	}, []string{"kind", "name"})
+
+	tokenRefreshes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_azure_token_refreshes_total",
+		Help: "Number of Azure AD tokens acquired for cached credentials.",
+	}, []string{"kind", "name", "resource"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheHits, cacheMisses, tokenRefreshes)
+}
+
+// DefaultCredentialsCache is the credentials cache shared by all controllers.
+var DefaultCredentialsCache = NewCredentialsCache()
+
+// A CredentialsCache caches the credentials of Providers and ProviderConfigs,
+// and the tokens acquired using them. Cached credentials are reused until the
+// ProviderConfig's spec or its credentials secret change. It is safe for
+// concurrent use.
+type CredentialsCache struct {
+	mu      sync.Mutex
+	entries map[string]*CachedCredentials
+}
+
+// NewCredentialsCache returns an empty credentials cache.
+func NewCredentialsCache() *CredentialsCache {
+	return &CredentialsCache{entries: map[string]*CachedCredentials{}}
+}
+
+// ProviderConfig returns the cached credentials of the supplied
+// ProviderConfig, loading them if they are not cached or have changed.
+func (cc *CredentialsCache) ProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*CachedCredentials, error) {
+	// The generation changes only when the spec does, so status updates do
+	// not invalidate the cache.
+	version := strconv.FormatInt(pc.GetGeneration(), 10)
+	var data []byte
+	switch src := pc.Spec.Credentials.Source; {
+	case IsIdentitySource(src):
+	case src == xpv1.CredentialsSourceSecret:
+		ref := pc.Spec.Credentials.SecretRef
+		if ref == nil {
+			return nil, errors.Wrap(errors.New(errNoSecretRef), errGetCredentials)
+		}
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(errors.Wrap(err, errGetCredentialsSecret), errGetCredentials)
+		}
+		version += "/" + s.GetResourceVersion()
+		data = s.Data[ref.Key]
+	default:
+		// Credentials read from the environment or filesystem have no
+		// version, so we use their content.
+		d, err := resource.CommonCredentialExtractor(ctx, src, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCredentials)
+		}
+		sum := sha256.Sum256(d)
+		version += "/" + hex.EncodeToString(sum[:])
+		data = d
+	}
+
+	return cc.get(cacheKindProviderConfig, pc.GetName(), version, func() (map[string]string, error) {
+		return providerConfigCredentials(pc, data)
+	})
+}
+
+// Provider returns the cached credentials of the supplied Provider, loading
+// them if they are not cached or have changed.
+func (cc *CredentialsCache) Provider(ctx context.Context, c client.Client, p *v1alpha3.Provider) (*CachedCredentials, error) {
+	ref := p.Spec.CredentialsSecretRef
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, err
+	}
+	return cc.get(cacheKindProvider, p.GetName(), s.GetResourceVersion(), func() (map[string]string, error) {
+		m := map[string]string{}
+		if err := json.Unmarshal(s.Data[ref.Key], &m); err != nil {
+			return nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+		}
+		return m, SetEnvironment(m, v1beta1.ProviderConfigSpec{})
+	})
+}
+
+func (cc *CredentialsCache) get(kind, name, version string, load func() (map[string]string, error)) (*CachedCredentials, error) {
+	key := kind + "/" + name
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if e, ok := cc.entries[key]; ok && e.version == version {
+		cacheHits.WithLabelValues(kind, name).Inc()
+		return e, nil
+	}
+	cacheMisses.WithLabelValues(kind, name).Inc()
+
+	// Any tokens acquired using outdated credentials are dropped along with
+	// their entry.
+	delete(cc.entries, key)
+	creds, err := load()
+	if err != nil {
+		return nil, err
+	}
+	e := &CachedCredentials{
+		kind:    kind,
+		name:    name,
+		version: version,
+		creds:   creds,
+		tokens:  map[string]*adal.ServicePrincipalToken{},
+	}
+	cc.entries[key] = e
+	return e, nil
+}
+
+// CachedCredentials are the credentials content of a Provider or
+// ProviderConfig, and the tokens that were acquired using them.
+type CachedCredentials struct {
+	kind    string
+	name    string
+	version string
+	creds   map[string]string
+
+	mu     sync.Mutex
+	tokens map[string]*adal.ServicePrincipalToken
+}
+
+// Credentials returns a copy of the credentials content, keyed like the JSON
+// credentials blob.
+func (c *CachedCredentials) Credentials() map[string]string {
+	m := make(map[string]string, len(c.creds))
+	for k, v := range c.creds {
+		m[k] = v
+	}
+	return m
+}
+
+// Authorizer returns an authorizer for the supplied resource. The underlying
+// token is shared by all authorizers for the resource, and is only refreshed
+// when it is close to expiry.
+func (c *CachedCredentials) Authorizer(resource string) (autorest.Authorizer, error) {
+	t, err := c.Token(resource)
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(t), nil
+}
+
+// Token returns the token for the supplied resource.
+func (c *CachedCredentials) Token(resource string) (*adal.ServicePrincipalToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t, ok := c.tokens[resource]; ok {
+		return t, nil
+	}
+	t, err := NewServicePrincipalToken(c.creds, resource)
+	if err != nil {
+		return nil, err
+	}
+	t.SetRefreshCallbacks([]adal.TokenRefreshCallback{func(adal.Token) error {
+		tokenRefreshes.WithLabelValues(c.kind, c.name, resource).Inc()
+		return nil
+	}})
+	c.tokens[resource] = t
+	return t, nil
+}
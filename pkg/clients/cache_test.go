@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+func cacheTestProviderConfig(name string) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: name},
+						Key:             "credentials",
+					},
+				},
+			},
+		},
+	}
+}
+
+// secretGetter returns a client that gets a credentials secret with the
+// supplied resource version. The secret's credentials request tokens from the
+// supplied Azure AD endpoint.
+func secretGetter(rv *string, aad string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetResourceVersion(*rv)
+			s.Data = map[string][]byte{"credentials": []byte(fmt.Sprintf(`{"clientId": %q, "clientSecret": "secret-%s", "tenantId": %q, "subscriptionId": %q, "activeDirectoryEndpointUrl": %q}`,
+				testClientID, *rv, testTenantID, testSubscriptionID, aad))}
+			return nil
+		},
+	}
+}
+
+func TestCredentialsCacheProviderConfig(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		tokenResponse(w, "https://management.azure.com/")
+	}))
+	defer srv.Close()
+
+	rv := "1"
+	c := secretGetter(&rv, srv.URL)
+	pc := cacheTestProviderConfig("cache-test")
+	cc := NewCredentialsCache()
+
+	hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheKindProviderConfig, pc.GetName()))
+	refreshes := testutil.ToFloat64(tokenRefreshes.WithLabelValues(cacheKindProviderConfig, pc.GetName(), "https://management.azure.com/"))
+
+	first, err := cc.ProviderConfig(context.Background(), c, pc)
+	if err != nil {
+		t.Fatalf("ProviderConfig(...): %s", err)
+	}
+
+	// Concurrent reconciles should all share the first entry and its token.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e, err := cc.ProviderConfig(context.Background(), c, pc)
+			if err != nil {
+				t.Errorf("ProviderConfig(...): %s", err)
+				return
+			}
+			if e != first {
+				t.Errorf("ProviderConfig(...): want cached entry")
+			}
+			tk, err := e.Token("https://management.azure.com/")
+			if err != nil {
+				t.Errorf("Token(...): %s", err)
+				return
+			}
+			if err := tk.EnsureFresh(); err != nil {
+				t.Errorf("EnsureFresh(): %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if diff := cmp.Diff(1, requests); diff != "" {
+		t.Errorf("token requests: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(hits+10, testutil.ToFloat64(cacheHits.WithLabelValues(cacheKindProviderConfig, pc.GetName()))); diff != "" {
+		t.Errorf("cache hits: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(refreshes+1, testutil.ToFloat64(tokenRefreshes.WithLabelValues(cacheKindProviderConfig, pc.GetName(), "https://management.azure.com/"))); diff != "" {
+		t.Errorf("token refreshes: -want, +got:\n%s", diff)
+	}
+
+	// A changed secret should replace the entry.
+	rv = "2"
+	second, err := cc.ProviderConfig(context.Background(), c, pc)
+	if err != nil {
+		t.Fatalf("ProviderConfig(...): %s", err)
+	}
+	if second == first {
+		t.Errorf("ProviderConfig(...): want new entry after secret changed")
+	}
+	if diff := cmp.Diff("secret-2", second.Credentials()[CredentialsKeyClientSecret]); diff != "" {
+		t.Errorf("ProviderConfig(...): -want client secret, +got:\n%s", diff)
+	}
+
+	// So should a changed spec.
+	pc.SetGeneration(2)
+	third, err := cc.ProviderConfig(context.Background(), c, pc)
+	if err != nil {
+		t.Fatalf("ProviderConfig(...): %s", err)
+	}
+	if third == second {
+		t.Errorf("ProviderConfig(...): want new entry after spec changed")
+	}
+}
+
+func TestCredentialsCacheProviderConfigErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		c    client.Client
+		pc   *v1beta1.ProviderConfig
+		want error
+	}{
+		"NoSecretRef": {
+			c: &test.MockClient{},
+			pc: &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			}},
+			want: errors.Wrap(errors.New(errNoSecretRef), errGetCredentials),
+		},
+		"GetSecretError": {
+			c:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			pc:   cacheTestProviderConfig("cache-test"),
+			want: errors.Wrap(errors.Wrap(errBoom, errGetCredentialsSecret), errGetCredentials),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCredentialsCache().ProviderConfig(context.Background(), tc.c, tc.pc)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ProviderConfig(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+)
+
+// PEM block types of client certificates and their private keys.
+const (
+	pemTypeCertificate   = "CERTIFICATE"
+	pemTypeRSAPrivateKey = "RSA PRIVATE KEY"
+	pemTypePrivateKey    = "PRIVATE KEY"
+)
+
+// Error strings.
+const (
+	errDecodeCertificate   = "cannot decode client certificate"
+	errParseCertificate    = "cannot parse client certificate"
+	errParsePrivateKey     = "cannot parse client certificate private key"
+	errDecryptPrivateKey   = "cannot decrypt client certificate private key"
+	errNoCertificate       = "client certificate PEM data contains no certificate"
+	errNoPrivateKey        = "client certificate PEM data contains no private key"
+	errNotRSAPrivateKey    = "client certificate private key is not an RSA key"
+	errFmtUnsupportedBlock = "unsupported PEM block type %q in client certificate"
+)
+
+// ParseClientCertificate parses the supplied client certificate, which may be
+// either PEM or PKCS#12 (PFX) data, optionally base64 encoded. A PEM
+// certificate must be accompanied by its private key. The password is used to
+// decrypt PKCS#12 data and encrypted PEM private keys.
+func ParseClientCertificate(data []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.Contains(data, []byte("-----BEGIN")) {
+		if d, err := base64.StdEncoding.DecodeString(string(data)); err == nil {
+			data = d
+		}
+	}
+	if !bytes.Contains(data, []byte("-----BEGIN")) {
+		cert, key, err := adal.DecodePfxCertificateData(data, password)
+		return cert, key, errors.Wrap(err, errDecodeCertificate)
+	}
+	return parsePEMCertificate(data, password)
+}
+
+func parsePEMCertificate(data []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	var cert *x509.Certificate
+	var key *rsa.PrivateKey
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			break
+		}
+
+		der := b.Bytes
+		if x509.IsEncryptedPEMBlock(b) { // nolint:staticcheck
+			d, err := x509.DecryptPEMBlock(b, []byte(password)) // nolint:staticcheck
+			if err != nil {
+				return nil, nil, errors.Wrap(err, errDecryptPrivateKey)
+			}
+			der = d
+		}
+
+		switch b.Type {
+		case pemTypeCertificate:
+			// The first certificate is the client certificate. Any others
+			// belong to its chain, which Azure AD does not require.
+			if cert != nil {
+				continue
+			}
+			c, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, errParseCertificate)
+			}
+			cert = c
+		case pemTypeRSAPrivateKey:
+			k, err := x509.ParsePKCS1PrivateKey(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, errParsePrivateKey)
+			}
+			key = k
+		case pemTypePrivateKey:
+			k, err := x509.ParsePKCS8PrivateKey(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, errParsePrivateKey)
+			}
+			rk, ok := k.(*rsa.PrivateKey)
+			if !ok {
+				return nil, nil, errors.New(errNotRSAPrivateKey)
+			}
+			key = rk
+		default:
+			return nil, nil, errors.Errorf(errFmtUnsupportedBlock, b.Type)
+		}
+	}
+
+	switch {
+	case cert == nil:
+		return nil, nil, errors.New(errNoCertificate)
+	case key == nil:
+		return nil, nil, errors.New(errNoPrivateKey)
+	}
+	return cert, key, nil
+}
@@ -19,18 +19,25 @@ package compute
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
 	authorizationmgmt "github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice/containerserviceapi"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -49,6 +56,36 @@ const (
 	NetworkContributorRoleID = "/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"
 
 	appCredsValidYears = 5
+
+	// DefaultCredentialGracePeriod is the time for which a previous service
+	// principal secret remains valid after the secret was rotated.
+	DefaultCredentialGracePeriod = 1 * time.Hour
+)
+
+// Names and configuration keys of AKS add-ons.
+const (
+	AddOnMonitoring  = "omsagent"
+	AddOnAzurePolicy = "azurepolicy"
+	AddOnIngress     = "ingressApplicationGateway"
+
+	addOnConfigLogAnalyticsWorkspace = "logAnalyticsWorkspaceResourceID"
+	addOnConfigApplicationGatewayID  = "applicationGatewayId"
+	addOnConfigSubnetCIDR            = "subnetCIDR"
+)
+
+const (
+	authProviderAzure = "azure"
+	kubeloginCommand  = "kubelogin"
+	kubeloginDefault  = "devicecode"
+	execAPIVersion    = "client.authentication.k8s.io/v1beta1"
+)
+
+// Error strings.
+const (
+	errNoIdentityPrincipal = "managed cluster has no identity principal"
+	errRemoveUnusedSecret  = "cannot remove service principal secret the cluster rejected"
+	errNoApplication       = "cannot find the service principal application of the managed cluster"
+	errImmutableField      = "cannot change immutable field %s of the managed cluster"
 )
 
 // An AKSClient can create, read, and delete AKS clusters and the various other
@@ -56,59 +93,73 @@ const (
 type AKSClient interface {
 	GetManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
 	EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, secret string) error
+	EnsureNetworkContributor(ctx context.Context, ac *v1alpha3.AKSCluster, principalID string) error
 	DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
-	GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster, kc v1alpha3.AKSClusterKubeconfig) ([]byte, error)
+	GetServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error)
+	RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (string, error)
+	RemoveServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster, keyIDs []string) error
 }
 
 // An AggregateClient aggregates the various clients used by the AKS controller.
 type AggregateClient struct {
-	ManagedClusters   containerservice.ManagedClustersClient
+	ManagedClusters   containerserviceapi.ManagedClustersClientAPI
 	Applications      graphrbac.ApplicationsClient
 	ServicePrincipals graphrbac.ServicePrincipalsClient
 	RoleAssignments   authorization.RoleAssignmentsClient
+
+	// Defaults are applied to the managed clusters that are created.
+	Defaults azure.ResourceDefaults
 }
 
 // NewAggregateClient produces the various clients used by the AKS controller.
-func NewAggregateClient(creds map[string]string, auth autorest.Authorizer) (AKSClient, error) {
-	mcc := containerservice.NewManagedClustersClient(creds[azure.CredentialsKeySubscriptionID])
+// Azure Resource Manager clients manage the supplied subscription, and the
+// supplied defaults are applied to the managed clusters they create. Azure AD
+// Graph clients are only produced for clusters that use a service principal.
+func NewAggregateClient(cc *azure.CachedCredentials, subscriptionID string, d azure.ResourceDefaults, ac *v1alpha3.AKSCluster) (AKSClient, error) {
+	creds := cc.Credentials()
+	auth, err := cc.Authorizer(creds[azure.CredentialsKeyResourceManagerEndpointURL])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create service principal token")
+	}
+
+	mcc := containerservice.NewManagedClustersClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], subscriptionID)
 	mcc.Authorizer = auth
 	_ = mcc.AddToUserAgent(azure.UserAgent)
 
-	rac := authorization.NewRoleAssignmentsClient(creds[azure.CredentialsKeySubscriptionID])
+	rac := authorization.NewRoleAssignmentsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], subscriptionID)
 	rac.Authorizer = auth
 	_ = rac.AddToUserAgent(azure.UserAgent)
 
-	cfg, err := adal.NewOAuthConfig(creds[azure.CredentialsKeyActiveDirectoryEndpointURL], creds[azure.CredentialsKeyTenantID])
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot create OAuth configuration")
+	if UsesManagedIdentity(ac) {
+		return AggregateClient{ManagedClusters: mcc, RoleAssignments: rac, Defaults: d}, nil
 	}
 
-	token, err := adal.NewServicePrincipalToken(*cfg,
-		creds[azure.CredentialsKeyClientID],
-		creds[azure.CredentialsKeyClientSecret],
-		creds[azure.CredentialsKeyActiveDirectoryGraphResourceID])
+	token, err := cc.Token(creds[azure.CredentialsKeyActiveDirectoryGraphResourceID])
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create service principal token")
 	}
-	if err := token.Refresh(); err != nil {
+	if err := token.EnsureFresh(); err != nil {
 		return nil, errors.Wrap(err, "cannot refresh service principal token")
 	}
 
 	ta := autorest.NewBearerAuthorizer(token)
 
-	ac := graphrbac.NewApplicationsClient(creds[azure.CredentialsKeyTenantID])
-	ac.Authorizer = ta
-	_ = ac.AddToUserAgent(azure.UserAgent)
+	apc := graphrbac.NewApplicationsClientWithBaseURI(creds[azure.CredentialsKeyActiveDirectoryGraphResourceID], creds[azure.CredentialsKeyTenantID])
+	apc.Authorizer = ta
+	_ = apc.AddToUserAgent(azure.UserAgent)
 
-	spc := graphrbac.NewServicePrincipalsClient(creds[azure.CredentialsKeyTenantID])
+	spc := graphrbac.NewServicePrincipalsClientWithBaseURI(creds[azure.CredentialsKeyActiveDirectoryGraphResourceID], creds[azure.CredentialsKeyTenantID])
 	spc.Authorizer = ta
 	_ = spc.AddToUserAgent(azure.UserAgent)
 
 	return AggregateClient{
 		ManagedClusters:   mcc,
-		Applications:      ac,
+		Applications:      apc,
 		ServicePrincipals: spc,
 		RoleAssignments:   rac,
+		Defaults:          d,
 	}, nil
 }
 
@@ -119,7 +170,15 @@ func (c AggregateClient) GetManagedCluster(ctx context.Context, ac *v1alpha3.AKS
 
 // EnsureManagedCluster ensures the supplied AKS cluster exists, including
 // ensuring any required service principals and role assignments exist.
+// Clusters with a managed identity do not use a service principal; their
+// identity is granted access to the subnet by EnsureNetworkContributor once
+// the cluster exists.
 func (c AggregateClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
+	if UsesManagedIdentity(ac) {
+		_, err := c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), newManagedCluster(ac, "", "", c.Defaults))
+		return err
+	}
+
 	app, err := c.ensureApplication(ctx, meta.GetExternalName(ac), secret)
 	if err != nil {
 		return err
@@ -134,14 +193,140 @@ func (c AggregateClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.
 		return err
 	}
 
-	mc := newManagedCluster(ac, to.String(app.AppID), secret)
+	mc := newManagedCluster(ac, to.String(app.AppID), secret, c.Defaults)
 	_, err = c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), mc)
 	return err
 }
 
+// UpdateManagedCluster updates the supplied AKS cluster in place. Azure only
+// upgrades clusters one minor Kubernetes version at a time, so the cluster may
+// be upgraded to an intermediate version that is on the way to the desired one.
+// The service principal secret is only updated if one is supplied. Changes to
+// immutable fields are rejected.
+func (c AggregateClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, secret string) error {
+	if f := changedImmutableField(ac, mc); f != "" {
+		return errors.Errorf(errImmutableField, f)
+	}
+
+	version := ac.Spec.Version
+	if current := kubernetesVersion(mc); isVersionUpToDate(ac, current) {
+		version = current
+	} else {
+		up, err := c.ManagedClusters.GetUpgradeProfile(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+		if err != nil {
+			return err
+		}
+		var available []string
+		if up.ManagedClusterUpgradeProfileProperties != nil && up.ControlPlaneProfile != nil {
+			available = upgradeVersions(up.ControlPlaneProfile.Upgrades)
+		}
+		if version, err = UpgradeVersion(current, version, available); err != nil {
+			return err
+		}
+	}
+
+	p := newManagedClusterUpdate(ac, mc, version, secret, c.Defaults)
+	_, err := c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), p)
+	return err
+}
+
+// EnsureNetworkContributor ensures the supplied principal has the Network
+// Contributor role on the subnet of the supplied AKS cluster, if any.
+func (c AggregateClient) EnsureNetworkContributor(ctx context.Context, ac *v1alpha3.AKSCluster, principalID string) error {
+	if principalID == "" {
+		return errors.New(errNoIdentityPrincipal)
+	}
+	return c.ensureRoleAssignment(ctx, principalID, NetworkContributorRoleID, ac.Spec.VnetSubnetID)
+}
+
+// GetServicePrincipalCredentials returns the password credentials of the
+// service principal application of the supplied AKS cluster.
+func (c AggregateClient) GetServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+	app, err := c.getApplication(ctx, meta.GetExternalName(ac))
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.Applications.ListPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil || r.Value == nil {
+		return nil, err
+	}
+	return *r.Value, nil
+}
+
+// RotateServicePrincipalSecret adds the supplied secret to the service
+// principal application of the supplied AKS cluster, starts resetting the
+// service principal profile of the cluster to use it, and returns the key ID
+// of the added secret. The secret is removed again if the cluster rejects the
+// reset. Existing secrets remain valid until they are removed by
+// RemoveServicePrincipalCredentials.
+func (c AggregateClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (string, error) {
+	app, err := c.getApplication(ctx, meta.GetExternalName(ac))
+	if err != nil {
+		return "", err
+	}
+	r, err := c.Applications.ListPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil {
+		return "", err
+	}
+	pc, err := newPasswordCredential(secret)
+	if err != nil {
+		return "", err
+	}
+	existing := []graphrbac.PasswordCredential{}
+	if r.Value != nil {
+		existing = *r.Value
+	}
+	creds := append(append([]graphrbac.PasswordCredential{}, existing...), pc)
+	p := graphrbac.PasswordCredentialsUpdateParameters{Value: &creds}
+	if _, err := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); err != nil {
+		return "", err
+	}
+
+	sp := containerservice.ManagedClusterServicePrincipalProfile{ClientID: app.AppID, Secret: to.StringPtr(secret)}
+	if _, err := c.ManagedClusters.ResetServicePrincipalProfile(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), sp); err != nil {
+		p := graphrbac.PasswordCredentialsUpdateParameters{Value: &existing}
+		if _, rerr := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); rerr != nil {
+			return "", errors.Wrapf(err, "%s: %s", errRemoveUnusedSecret, rerr)
+		}
+		return "", err
+	}
+	return to.String(pc.KeyID), nil
+}
+
+// RemoveServicePrincipalCredentials removes the password credentials with the
+// supplied key IDs from the service principal application of the supplied AKS
+// cluster.
+func (c AggregateClient) RemoveServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster, keyIDs []string) error {
+	app, err := c.getApplication(ctx, meta.GetExternalName(ac))
+	if err != nil {
+		return err
+	}
+	r, err := c.Applications.ListPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil || r.Value == nil {
+		return err
+	}
+	remove := make(map[string]bool, len(keyIDs))
+	for _, id := range keyIDs {
+		remove[id] = true
+	}
+	creds := make([]graphrbac.PasswordCredential, 0, len(*r.Value))
+	for _, pc := range *r.Value {
+		if !remove[to.String(pc.KeyID)] {
+			creds = append(creds, pc)
+		}
+	}
+	p := graphrbac.PasswordCredentialsUpdateParameters{Value: &creds}
+	_, err = c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p)
+	return err
+}
+
 // DeleteManagedCluster deletes the supplied AKS cluster, including its service
 // principals and any role assignments.
 func (c AggregateClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	if UsesManagedIdentity(ac) {
+		_, err := c.ManagedClusters.Delete(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+		return err
+	}
 	if err := c.deleteApplication(ctx, meta.GetExternalName(ac)); err != nil {
 		return err
 	}
@@ -149,10 +334,17 @@ func (c AggregateClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.
 	return err
 }
 
-// GetKubeConfig produces a kubeconfig file that configures access to the
-// supplied AKS cluster.
-func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
-	creds, err := c.ManagedClusters.ListClusterAdminCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+// GetKubeConfig produces a kubeconfig file of the supplied type that
+// configures access to the supplied AKS cluster.
+func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster, kc v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+	var creds containerservice.CredentialResults
+	var err error
+	switch kc.Type {
+	case v1alpha3.KubeconfigTypeUser:
+		creds, err = c.ManagedClusters.ListClusterUserCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), "")
+	default:
+		creds, err = c.ManagedClusters.ListClusterAdminCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), "")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +357,48 @@ func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSClus
 	// Azure's generated Godoc claims Value is a 'base64 encoded kubeconfig'.
 	// This is true on the wire, but not true in the actual struct because
 	// encoding/json automatically base64 encodes and decodes byte slices.
-	return *((*creds.Kubeconfigs)[0].Value), nil
+	kubeconfig := *((*creds.Kubeconfigs)[0].Value)
+	if kc.Type != v1alpha3.KubeconfigTypeUser || azure.ToString(kc.Format) != v1alpha3.KubeconfigFormatExec {
+		return kubeconfig, nil
+	}
+	return ExecKubeConfig(kubeconfig, azure.ToString(kc.LoginMode))
+}
+
+// ExecKubeConfig converts users of the supplied kubeconfig file that use the
+// azure auth provider to users that get tokens by executing kubelogin with the
+// supplied login mode. Other users are left as they are.
+func ExecKubeConfig(kubeconfig []byte, loginMode string) ([]byte, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse kubeconfig file")
+	}
+	if loginMode == "" {
+		loginMode = kubeloginDefault
+	}
+	for _, u := range cfg.AuthInfos {
+		if u.AuthProvider == nil || u.AuthProvider.Name != authProviderAzure {
+			continue
+		}
+		c := u.AuthProvider.Config
+		args := []string{"get-token", "--login", loginMode, "--server-id", c["apiserver-id"]}
+		for _, f := range []struct{ flag, key string }{
+			{flag: "--client-id", key: "client-id"},
+			{flag: "--tenant-id", key: "tenant-id"},
+			{flag: "--environment", key: "environment"},
+		} {
+			if c[f.key] != "" {
+				args = append(args, f.flag, c[f.key])
+			}
+		}
+		u.AuthProvider = nil
+		u.Exec = &clientcmdapi.ExecConfig{
+			APIVersion:      execAPIVersion,
+			Command:         kubeloginCommand,
+			Args:            args,
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		}
+	}
+	return clientcmd.Write(*cfg)
 }
 
 func (c AggregateClient) ensureApplication(ctx context.Context, name, secret string) (graphrbac.Application, error) {
@@ -249,6 +482,20 @@ func (c AggregateClient) ensureRoleAssignment(ctx context.Context, principalID,
 	return err
 }
 
+func (c AggregateClient) getApplication(ctx context.Context, name string) (graphrbac.Application, error) {
+	filter := fmt.Sprintf("displayName eq '%s'", name)
+	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
+		if err != nil {
+			return graphrbac.Application{}, err
+		}
+
+		// We presume the first application with our desired display name is
+		// the one we created.
+		return l.Value(), nil // nolint:staticcheck
+	}
+	return graphrbac.Application{}, errors.New(errNoApplication)
+}
+
 func (c AggregateClient) deleteApplication(ctx context.Context, name string) error {
 	filter := fmt.Sprintf("displayName eq '%s'", name)
 	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
@@ -264,7 +511,7 @@ func (c AggregateClient) deleteApplication(ctx context.Context, name string) err
 	return nil
 }
 
-func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string) containerservice.ManagedCluster {
+func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string, d azure.ResourceDefaults) containerservice.ManagedCluster {
 	nodeCount := int32(v1alpha3.DefaultNodeCount)
 	if c.Spec.NodeCount != nil {
 		nodeCount = int32(*c.Spec.NodeCount)
@@ -272,7 +519,8 @@ func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string) containerse
 
 	p := containerservice.ManagedCluster{
 		Name:     to.StringPtr(meta.GetExternalName(c)),
-		Location: to.StringPtr(c.Spec.Location),
+		Location: to.StringPtr(d.LocationOr(c.Spec.Location)),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(c.Spec.Tags)),
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 			KubernetesVersion: to.StringPtr(c.Spec.Version),
 			DNSPrefix:         to.StringPtr(c.Spec.DNSNamePrefix),
@@ -280,32 +528,660 @@ func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string) containerse
 				{
 					Name:   to.StringPtr(AgentPoolProfileName),
 					Count:  &nodeCount,
-					VMSize: containerservice.VMSizeTypes(c.Spec.NodeVMSize),
+					VMSize: to.StringPtr(c.Spec.NodeVMSize),
+					Mode:   containerservice.AgentPoolModeSystem,
+					Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
 				},
 			},
-			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
-				ClientID: to.StringPtr(appID),
-				Secret:   to.StringPtr(secret),
-			},
 			EnableRBAC: to.BoolPtr(!c.Spec.DisableRBAC),
 		},
 	}
 
-	if c.Spec.VnetSubnetID != "" {
-		p.ManagedClusterProperties.NetworkProfile = &containerservice.NetworkProfile{NetworkPlugin: containerservice.Azure}
-		p.ManagedClusterProperties.AgentPoolProfiles = &[]containerservice.ManagedClusterAgentPoolProfile{
-			{
-				Name:         to.StringPtr(AgentPoolProfileName),
-				Count:        &nodeCount,
-				VMSize:       containerservice.VMSizeTypes(c.Spec.NodeVMSize),
-				VnetSubnetID: to.StringPtr(c.Spec.VnetSubnetID),
-			},
+	if id := c.Spec.Identity; id != nil {
+		p.Identity = &containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityType(id.Type)}
+		if id.Type == v1alpha3.IdentityTypeUserAssigned {
+			p.Identity.UserAssignedIdentities = map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+				id.UserAssignedIdentityID: {},
+			}
+		}
+	} else {
+		p.ServicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: to.StringPtr(appID),
+			Secret:   to.StringPtr(secret),
+		}
+	}
+
+	if c.Spec.VnetSubnetID != "" || c.Spec.NetworkPolicy != nil || c.Spec.OutboundType != nil {
+		np := &containerservice.NetworkProfile{
+			NetworkPolicy: containerservice.NetworkPolicy(azure.ToString(c.Spec.NetworkPolicy)),
+			OutboundType:  containerservice.OutboundType(azure.ToString(c.Spec.OutboundType)),
+		}
+		if c.Spec.VnetSubnetID != "" {
+			np.NetworkPlugin = containerservice.NetworkPluginAzure
+			(*p.ManagedClusterProperties.AgentPoolProfiles)[0].VnetSubnetID = to.StringPtr(c.Spec.VnetSubnetID)
+		}
+		p.ManagedClusterProperties.NetworkProfile = np
+	}
+
+	if c.Spec.PrivateCluster != nil || c.Spec.AuthorizedIPRanges != nil {
+		p.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
+			EnablePrivateCluster: c.Spec.PrivateCluster,
+			AuthorizedIPRanges:   azure.ToStringArrayPtr(c.Spec.AuthorizedIPRanges),
 		}
 	}
+	if c.Spec.AADProfile != nil {
+		p.ManagedClusterProperties.AadProfile = newAADProfile(c.Spec.AADProfile)
+	}
+	if len(c.Spec.AvailabilityZones) > 0 {
+		(*p.ManagedClusterProperties.AgentPoolProfiles)[0].AvailabilityZones = azure.ToStringArrayPtr(c.Spec.AvailabilityZones)
+	}
+	if c.Spec.AutoUpgradeChannel != nil {
+		p.ManagedClusterProperties.AutoUpgradeProfile = &containerservice.ManagedClusterAutoUpgradeProfile{
+			UpgradeChannel: containerservice.UpgradeChannel(*c.Spec.AutoUpgradeChannel),
+		}
+	}
+	if c.Spec.AddOns != nil {
+		p.ManagedClusterProperties.AddonProfiles = newAddonProfiles(c.Spec.AddOns, nil)
+	}
 
 	return p
 }
 
+func newAADProfile(a *v1alpha3.AKSClusterAADProfile) *containerservice.ManagedClusterAADProfile {
+	return &containerservice.ManagedClusterAADProfile{
+		Managed:             to.BoolPtr(true),
+		AdminGroupObjectIDs: azure.ToStringArrayPtr(a.AdminGroupObjectIDs),
+		EnableAzureRBAC:     a.EnableAzureRBAC,
+		TenantID:            a.TenantID,
+	}
+}
+
+// newAddonProfiles returns the supplied add-on profiles with the supplied
+// add-ons applied to them. Add-ons that are not configured are left as they
+// are.
+func newAddonProfiles(a *v1alpha3.AKSClusterAddOns, observed map[string]*containerservice.ManagedClusterAddonProfile) map[string]*containerservice.ManagedClusterAddonProfile {
+	m := make(map[string]*containerservice.ManagedClusterAddonProfile, len(observed)+3)
+	for k, v := range observed {
+		m[k] = v
+	}
+	set := func(name string, enabled bool, config map[string]string) {
+		k, _ := addonProfile(observed, name)
+		if k == "" {
+			k = name
+		}
+		m[k] = &containerservice.ManagedClusterAddonProfile{Enabled: to.BoolPtr(enabled), Config: azure.ToStringPtrMap(config)}
+	}
+	if o := a.Monitoring; o != nil {
+		set(AddOnMonitoring, o.Enabled, nonEmpty(map[string]string{addOnConfigLogAnalyticsWorkspace: o.LogAnalyticsWorkspaceResourceID}))
+	}
+	if o := a.AzurePolicy; o != nil {
+		set(AddOnAzurePolicy, o.Enabled, nil)
+	}
+	if o := a.Ingress; o != nil {
+		set(AddOnIngress, o.Enabled, nonEmpty(map[string]string{
+			addOnConfigApplicationGatewayID: o.ApplicationGatewayID,
+			addOnConfigSubnetCIDR:           o.SubnetCIDR,
+		}))
+	}
+	return m
+}
+
+// addonProfile returns the key and profile of the named add-on. Azure does
+// not consistently preserve the case of add-on names.
+func addonProfile(profiles map[string]*containerservice.ManagedClusterAddonProfile, name string) (string, *containerservice.ManagedClusterAddonProfile) {
+	for k, v := range profiles {
+		if strings.EqualFold(k, name) && v != nil {
+			return k, v
+		}
+	}
+	return "", nil
+}
+
+// addonConfig returns the named configuration value of the supplied add-on
+// profile.
+func addonConfig(p *containerservice.ManagedClusterAddonProfile, key string) string {
+	for k, v := range p.Config {
+		if strings.EqualFold(k, key) {
+			return azure.ToString(v)
+		}
+	}
+	return ""
+}
+
+func nonEmpty(m map[string]string) map[string]string {
+	for k, v := range m {
+		if v == "" {
+			delete(m, k)
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// newManagedClusterUpdate returns the supplied managed cluster with the
+// updatable fields of the supplied AKSCluster applied to it. Only the agent
+// pool created with the cluster is included, upgraded along with the control
+// plane; other pools are managed through the agent pools API, for example by
+// AKSNodePools.
+func newManagedClusterUpdate(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, version, secret string, d azure.ResourceDefaults) containerservice.ManagedCluster {
+	props := containerservice.ManagedClusterProperties{}
+	if mc.ManagedClusterProperties != nil {
+		props = *mc.ManagedClusterProperties
+	}
+	props.KubernetesVersion = to.StringPtr(version)
+	props.EnableRBAC = to.BoolPtr(!c.Spec.DisableRBAC)
+
+	if props.AgentPoolProfiles != nil {
+		var pools []containerservice.ManagedClusterAgentPoolProfile
+		if i := agentPoolIndex(*props.AgentPoolProfiles); i >= 0 {
+			pool := (*props.AgentPoolProfiles)[i]
+			pool.OrchestratorVersion = to.StringPtr(version)
+			if c.Spec.NodeCount != nil {
+				pool.Count = azure.ToInt32Ptr(*c.Spec.NodeCount, azure.FieldRequired)
+			}
+			pools = append(pools, pool)
+		}
+		props.AgentPoolProfiles = &pools
+	}
+
+	if c.Spec.AuthorizedIPRanges != nil {
+		ap := containerservice.ManagedClusterAPIServerAccessProfile{}
+		if props.APIServerAccessProfile != nil {
+			ap = *props.APIServerAccessProfile
+		}
+		ap.AuthorizedIPRanges = azure.ToStringArrayPtr(c.Spec.AuthorizedIPRanges)
+		props.APIServerAccessProfile = &ap
+	}
+	if c.Spec.AADProfile != nil {
+		props.AadProfile = newAADProfile(c.Spec.AADProfile)
+	}
+	if c.Spec.AutoUpgradeChannel != nil {
+		props.AutoUpgradeProfile = &containerservice.ManagedClusterAutoUpgradeProfile{
+			UpgradeChannel: containerservice.UpgradeChannel(*c.Spec.AutoUpgradeChannel),
+		}
+	}
+	if c.Spec.AddOns != nil {
+		props.AddonProfiles = newAddonProfiles(c.Spec.AddOns, props.AddonProfiles)
+	}
+
+	if props.ServicePrincipalProfile != nil && secret != "" {
+		sp := *props.ServicePrincipalProfile
+		sp.Secret = to.StringPtr(secret)
+		props.ServicePrincipalProfile = &sp
+	}
+
+	out := mc
+	out.Tags = d.UpdateTags(d.MergeTags(c.Spec.Tags), mc.Tags)
+	out.ManagedClusterProperties = &props
+	return out
+}
+
+// LateInitialize fills the empty fields of the supplied AKSCluster parameters
+// with the values of the supplied managed cluster.
+func LateInitialize(p *v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, mc.Tags)
+	if mc.ManagedClusterProperties == nil {
+		return
+	}
+	if p.DNSNamePrefix == "" {
+		p.DNSNamePrefix = azure.ToString(mc.DNSPrefix)
+	}
+	if np := mc.NetworkProfile; np != nil {
+		if p.NetworkPolicy == nil && np.NetworkPolicy != "" {
+			p.NetworkPolicy = to.StringPtr(string(np.NetworkPolicy))
+		}
+		if p.OutboundType == nil && np.OutboundType != "" {
+			p.OutboundType = to.StringPtr(string(np.OutboundType))
+		}
+	}
+	if ap := mc.APIServerAccessProfile; ap != nil {
+		p.PrivateCluster = azure.LateInitializeBoolPtrFromPtr(p.PrivateCluster, ap.EnablePrivateCluster)
+		p.AuthorizedIPRanges = azure.LateInitializeStringValArrFromArrPtr(p.AuthorizedIPRanges, ap.AuthorizedIPRanges)
+	}
+	if aad := mc.AadProfile; aad != nil && p.AADProfile == nil {
+		p.AADProfile = &v1alpha3.AKSClusterAADProfile{
+			AdminGroupObjectIDs: azure.ToStringArray(aad.AdminGroupObjectIDs),
+			EnableAzureRBAC:     aad.EnableAzureRBAC,
+			TenantID:            aad.TenantID,
+		}
+	}
+	if up := mc.AutoUpgradeProfile; up != nil && p.AutoUpgradeChannel == nil && up.UpgradeChannel != "" {
+		p.AutoUpgradeChannel = to.StringPtr(string(up.UpgradeChannel))
+	}
+	p.AddOns = lateInitializeAddOns(p.AddOns, mc.AddonProfiles)
+	if mc.AgentPoolProfiles == nil {
+		return
+	}
+	if i := agentPoolIndex(*mc.AgentPoolProfiles); i >= 0 {
+		pool := (*mc.AgentPoolProfiles)[i]
+		p.NodeCount = azure.LateInitializeIntPtrFromInt32Ptr(p.NodeCount, pool.Count)
+		if p.NodeVMSize == "" {
+			p.NodeVMSize = azure.ToString(pool.VMSize)
+		}
+		p.AvailabilityZones = azure.LateInitializeStringValArrFromArrPtr(p.AvailabilityZones, pool.AvailabilityZones)
+	}
+}
+
+func lateInitializeAddOns(in *v1alpha3.AKSClusterAddOns, from map[string]*containerservice.ManagedClusterAddonProfile) *v1alpha3.AKSClusterAddOns {
+	a := &v1alpha3.AKSClusterAddOns{}
+	if in != nil {
+		a = in.DeepCopy()
+	}
+	if _, o := addonProfile(from, AddOnMonitoring); o != nil && a.Monitoring == nil {
+		a.Monitoring = &v1alpha3.AKSClusterMonitoringAddOn{
+			Enabled:                         azure.ToBool(o.Enabled),
+			LogAnalyticsWorkspaceResourceID: addonConfig(o, addOnConfigLogAnalyticsWorkspace),
+		}
+	}
+	if _, o := addonProfile(from, AddOnAzurePolicy); o != nil && a.AzurePolicy == nil {
+		a.AzurePolicy = &v1alpha3.AKSClusterAzurePolicyAddOn{Enabled: azure.ToBool(o.Enabled)}
+	}
+	if _, o := addonProfile(from, AddOnIngress); o != nil && a.Ingress == nil {
+		a.Ingress = &v1alpha3.AKSClusterIngressAddOn{
+			Enabled:              azure.ToBool(o.Enabled),
+			ApplicationGatewayID: addonConfig(o, addOnConfigApplicationGatewayID),
+			SubnetCIDR:           addonConfig(o, addOnConfigSubnetCIDR),
+		}
+	}
+	if in == nil && cmp.Equal(a, &v1alpha3.AKSClusterAddOns{}) {
+		return nil
+	}
+	return a
+}
+
+// IsManagedClusterUpToDate returns true if the supplied managed cluster is up
+// to date with the supplied AKSCluster.
+func IsManagedClusterUpToDate(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, d azure.ResourceDefaults) bool {
+	if !d.TagsUpToDate(d.MergeTags(c.Spec.Tags), mc.Tags) {
+		return false
+	}
+	if mc.ManagedClusterProperties == nil {
+		return false
+	}
+	if changedImmutableField(c, mc) != "" {
+		return false
+	}
+	if !isVersionUpToDate(c, azure.ToString(mc.KubernetesVersion)) {
+		return false
+	}
+	if azure.ToBool(mc.EnableRBAC) == c.Spec.DisableRBAC {
+		return false
+	}
+	if c.Spec.AuthorizedIPRanges != nil {
+		var observed []string
+		if mc.APIServerAccessProfile != nil {
+			observed = azure.ToStringArray(mc.APIServerAccessProfile.AuthorizedIPRanges)
+		}
+		if !equalStrings(c.Spec.AuthorizedIPRanges, observed) {
+			return false
+		}
+	}
+	if !isAADProfileUpToDate(c.Spec.AADProfile, mc.AadProfile) {
+		return false
+	}
+	if c.Spec.AutoUpgradeChannel != nil {
+		if mc.AutoUpgradeProfile == nil || string(mc.AutoUpgradeProfile.UpgradeChannel) != *c.Spec.AutoUpgradeChannel {
+			return false
+		}
+	}
+	if !areAddOnsUpToDate(c.Spec.AddOns, mc.AddonProfiles) {
+		return false
+	}
+	if mc.AgentPoolProfiles == nil {
+		return true
+	}
+	i := agentPoolIndex(*mc.AgentPoolProfiles)
+	if i < 0 {
+		return true
+	}
+	pool := (*mc.AgentPoolProfiles)[i]
+	if v := azure.ToString(pool.OrchestratorVersion); v != "" && v != azure.ToString(mc.KubernetesVersion) {
+		return false
+	}
+	return c.Spec.NodeCount == nil || azure.ToInt(pool.Count) == *c.Spec.NodeCount
+}
+
+// changedImmutableField returns the name of the first immutable field of the
+// supplied AKSCluster that differs from the supplied managed cluster, or an
+// empty string if none does.
+func changedImmutableField(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) string {
+	if mc.ManagedClusterProperties == nil {
+		return ""
+	}
+	np := containerservice.NetworkProfile{}
+	if mc.NetworkProfile != nil {
+		np = *mc.NetworkProfile
+	}
+	if c.Spec.NetworkPolicy != nil && *c.Spec.NetworkPolicy != string(np.NetworkPolicy) {
+		return "networkPolicy"
+	}
+	if c.Spec.OutboundType != nil && *c.Spec.OutboundType != string(np.OutboundType) {
+		return "outboundType"
+	}
+	private := false
+	if mc.APIServerAccessProfile != nil {
+		private = azure.ToBool(mc.APIServerAccessProfile.EnablePrivateCluster)
+	}
+	if c.Spec.PrivateCluster != nil && *c.Spec.PrivateCluster != private {
+		return "privateCluster"
+	}
+	if c.Spec.AvailabilityZones != nil {
+		var zones []string
+		if mc.AgentPoolProfiles != nil {
+			if i := agentPoolIndex(*mc.AgentPoolProfiles); i >= 0 {
+				zones = azure.ToStringArray((*mc.AgentPoolProfiles)[i].AvailabilityZones)
+			}
+		}
+		if !equalStrings(c.Spec.AvailabilityZones, zones) {
+			return "availabilityZones"
+		}
+	}
+	return ""
+}
+
+// isVersionUpToDate returns true if a cluster running the supplied version is
+// up to date with the version of the supplied AKSCluster. Clusters that are
+// automatically upgraded may be running a newer version.
+func isVersionUpToDate(c *v1alpha3.AKSCluster, current string) bool {
+	if current == c.Spec.Version {
+		return true
+	}
+	if ch := azure.ToString(c.Spec.AutoUpgradeChannel); ch == "" || ch == string(containerservice.UpgradeChannelNone) {
+		return false
+	}
+	cv, err := parseVersion(current)
+	if err != nil {
+		return false
+	}
+	dv, err := parseVersion(c.Spec.Version)
+	if err != nil {
+		return false
+	}
+	return !cv.less(dv)
+}
+
+func isAADProfileUpToDate(a *v1alpha3.AKSClusterAADProfile, observed *containerservice.ManagedClusterAADProfile) bool {
+	if a == nil {
+		return true
+	}
+	if observed == nil || !azure.ToBool(observed.Managed) {
+		return false
+	}
+	if !equalStrings(a.AdminGroupObjectIDs, azure.ToStringArray(observed.AdminGroupObjectIDs)) {
+		return false
+	}
+	if a.EnableAzureRBAC != nil && *a.EnableAzureRBAC != azure.ToBool(observed.EnableAzureRBAC) {
+		return false
+	}
+	return a.TenantID == nil || *a.TenantID == azure.ToString(observed.TenantID)
+}
+
+func areAddOnsUpToDate(a *v1alpha3.AKSClusterAddOns, observed map[string]*containerservice.ManagedClusterAddonProfile) bool {
+	if a == nil {
+		return true
+	}
+	upToDate := func(name string, enabled bool, config map[string]string) bool {
+		_, o := addonProfile(observed, name)
+		if o == nil {
+			return !enabled
+		}
+		if azure.ToBool(o.Enabled) != enabled {
+			return false
+		}
+		for k, v := range config {
+			if v != "" && addonConfig(o, k) != v {
+				return false
+			}
+		}
+		return true
+	}
+	if o := a.Monitoring; o != nil && !upToDate(AddOnMonitoring, o.Enabled, map[string]string{addOnConfigLogAnalyticsWorkspace: o.LogAnalyticsWorkspaceResourceID}) {
+		return false
+	}
+	if o := a.AzurePolicy; o != nil && !upToDate(AddOnAzurePolicy, o.Enabled, nil) {
+		return false
+	}
+	if o := a.Ingress; o != nil && !upToDate(AddOnIngress, o.Enabled, map[string]string{
+		addOnConfigApplicationGatewayID: o.ApplicationGatewayID,
+		addOnConfigSubnetCIDR:           o.SubnetCIDR,
+	}) {
+		return false
+	}
+	return true
+}
+
+// equalStrings returns true if the supplied slices contain the same strings,
+// regardless of their order.
+func equalStrings(a, b []string) bool {
+	return cmp.Equal(a, b, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(i, j string) bool { return i < j }))
+}
+
+// UpgradeVersion returns the Kubernetes version a cluster running the current
+// version should be upgraded to in order to reach the desired version. Azure
+// only allows upgrades of one minor version at a time, so when the desired
+// version is further away the newest of the available upgrades within the next
+// minor version is returned.
+func UpgradeVersion(current, desired string, available []string) (string, error) {
+	cv, err := parseVersion(current)
+	if err != nil {
+		return "", err
+	}
+	dv, err := parseVersion(desired)
+	if err != nil {
+		return "", err
+	}
+	if dv.less(cv) {
+		return "", errors.Errorf("cannot downgrade Kubernetes from version %s to %s", current, desired)
+	}
+	if dv.major != cv.major || dv.minor <= cv.minor+1 {
+		return desired, nil
+	}
+
+	next, found := "", version{}
+	for _, a := range available {
+		av, err := parseVersion(a)
+		if err != nil || av.major != cv.major || av.minor != cv.minor+1 {
+			continue
+		}
+		if next == "" || found.less(av) {
+			next, found = a, av
+		}
+	}
+	if next == "" {
+		return "", errors.Errorf("no upgrade from Kubernetes version %s towards %s is available", current, desired)
+	}
+	return next, nil
+}
+
+type version struct {
+	major, minor, patch int
+}
+
+func (v version) less(o version) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	if v.minor != o.minor {
+		return v.minor < o.minor
+	}
+	return v.patch < o.patch
+}
+
+func parseVersion(s string) (version, error) {
+	parts := strings.SplitN(strings.TrimPrefix(s, "v"), ".", 3)
+	if len(parts) < 2 {
+		return version{}, errors.Errorf("cannot parse Kubernetes version %q", s)
+	}
+	n := make([]int, 3)
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil {
+			return version{}, errors.Errorf("cannot parse Kubernetes version %q", s)
+		}
+		n[i] = v
+	}
+	return version{major: n[0], minor: n[1], patch: n[2]}, nil
+}
+
+// UsesManagedIdentity returns true if the supplied AKS cluster uses a managed
+// identity rather than a service principal.
+func UsesManagedIdentity(ac *v1alpha3.AKSCluster) bool {
+	return ac.Spec.Identity != nil
+}
+
+// IdentityPrincipalID returns the principal ID of the managed identity of the
+// supplied managed cluster, or an empty string if it has none.
+func IdentityPrincipalID(mc containerservice.ManagedCluster) string {
+	if mc.Identity == nil {
+		return ""
+	}
+	if mc.Identity.Type != containerservice.ResourceIdentityTypeUserAssigned {
+		return azure.ToString(mc.Identity.PrincipalID)
+	}
+	for _, v := range mc.Identity.UserAssignedIdentities {
+		if v != nil {
+			return azure.ToString(v.PrincipalID)
+		}
+	}
+	return ""
+}
+
+// IsNetworkContributorUpToDate returns true if the managed identity of the
+// supplied managed cluster has been granted the Network Contributor role on
+// the subnet of the supplied AKSCluster, or if no such role is required.
+func IsNetworkContributorUpToDate(c *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) bool {
+	if !UsesManagedIdentity(c) || c.Spec.VnetSubnetID == "" {
+		return true
+	}
+	return c.Status.NetworkContributorPrincipalID == IdentityPrincipalID(mc)
+}
+
+// IsCredentialRotationDue returns true if the newest of the supplied service
+// principal credentials is older than the rotation interval of the supplied
+// AKSCluster, or if the cluster was last reset to use another credential, for
+// example because a reset failed after the newest credential was added.
+// Clusters with a managed identity or without a rotation interval are never
+// due.
+func IsCredentialRotationDue(c *v1alpha3.AKSCluster, creds []graphrbac.PasswordCredential, now time.Time) bool {
+	r := c.Spec.CredentialRotation
+	if r == nil || UsesManagedIdentity(c) {
+		return false
+	}
+	newest := newestCredential(creds)
+	if newest == nil {
+		return true
+	}
+	if id := c.Status.ServicePrincipalKeyID; id != "" && id != to.String(newest.KeyID) {
+		return true
+	}
+	return !now.Before(credentialStart(*newest).Add(r.Interval.Duration))
+}
+
+// ExpiredCredentials returns the key IDs of the supplied service principal
+// credentials that were superseded by the newest credential more than the
+// grace period of the supplied AKSCluster ago. Nothing expires until the
+// cluster has finished resetting its service principal profile to use the
+// newest credential.
+func ExpiredCredentials(c *v1alpha3.AKSCluster, creds []graphrbac.PasswordCredential, now time.Time) []string {
+	r := c.Spec.CredentialRotation
+	if r == nil || UsesManagedIdentity(c) {
+		return nil
+	}
+	newest := newestCredential(creds)
+	if newest == nil {
+		return nil
+	}
+	if c.Status.ServicePrincipalKeyID != to.String(newest.KeyID) || !IsUpdatable(c.Status.State) {
+		return nil
+	}
+	grace := DefaultCredentialGracePeriod
+	if r.GracePeriod != nil {
+		grace = r.GracePeriod.Duration
+	}
+	if now.Before(credentialStart(*newest).Add(grace)) {
+		return nil
+	}
+	var expired []string
+	for _, pc := range creds {
+		if id := to.String(pc.KeyID); id != to.String(newest.KeyID) {
+			expired = append(expired, id)
+		}
+	}
+	return expired
+}
+
+// IsCredentialRotationUpToDate returns true if the service principal secret of
+// the supplied AKSCluster is neither due for rotation nor has expired
+// predecessors.
+func IsCredentialRotationUpToDate(c *v1alpha3.AKSCluster, creds []graphrbac.PasswordCredential, now time.Time) bool {
+	return !IsCredentialRotationDue(c, creds, now) && len(ExpiredCredentials(c, creds, now)) == 0
+}
+
+// GenerateCredentialStatus returns the observed state of the newest of the
+// supplied service principal credentials.
+func GenerateCredentialStatus(creds []graphrbac.PasswordCredential) *v1alpha3.AKSClusterCredentialStatus {
+	newest := newestCredential(creds)
+	if newest == nil {
+		return nil
+	}
+	s := &v1alpha3.AKSClusterCredentialStatus{KeyID: to.String(newest.KeyID)}
+	if newest.StartDate != nil {
+		s.CreatedAt = &metav1.Time{Time: newest.StartDate.Time}
+	}
+	return s
+}
+
+func newestCredential(creds []graphrbac.PasswordCredential) *graphrbac.PasswordCredential {
+	var newest *graphrbac.PasswordCredential
+	for i := range creds {
+		if newest == nil || credentialStart(creds[i]).After(credentialStart(*newest)) {
+			newest = &creds[i]
+		}
+	}
+	return newest
+}
+
+func credentialStart(pc graphrbac.PasswordCredential) time.Time {
+	if pc.StartDate == nil {
+		return time.Time{}
+	}
+	return pc.StartDate.Time
+}
+
+func upgradeVersions(items *[]containerservice.ManagedClusterPoolUpgradeProfileUpgradesItem) []string {
+	if items == nil {
+		return nil
+	}
+	v := make([]string, len(*items))
+	for i, u := range *items {
+		v[i] = azure.ToString(u.KubernetesVersion)
+	}
+	return v
+}
+
+func kubernetesVersion(mc containerservice.ManagedCluster) string {
+	if mc.ManagedClusterProperties == nil {
+		return ""
+	}
+	return azure.ToString(mc.KubernetesVersion)
+}
+
+// agentPoolIndex returns the index of the agent pool created with the cluster,
+// or of the first agent pool if there is no such pool.
+func agentPoolIndex(pools []containerservice.ManagedClusterAgentPoolProfile) int {
+	for i, p := range pools {
+		if azure.ToString(p.Name) == AgentPoolProfileName {
+			return i
+		}
+	}
+	if len(pools) > 0 {
+		return 0
+	}
+	return -1
+}
+
 func newPasswordCredential(secret string) (graphrbac.PasswordCredential, error) {
 	keyID, err := uuid.NewRandom()
 	return graphrbac.PasswordCredential{
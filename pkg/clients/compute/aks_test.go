@@ -0,0 +1,1061 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	testVersion = "1.21.7"
+	testVMSize  = "Standard_B2s"
+	testPrefix  = "cool"
+	testSubnet  = "/subscriptions/cool/resourceGroups/cool-rg/providers/Microsoft.Network/virtualNetworks/cool-vnet/subnets/cool-subnet"
+	testMSI     = "/subscriptions/cool/resourceGroups/cool-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/cool-identity"
+)
+
+type aksModifier func(*v1alpha3.AKSCluster)
+
+func withSpec(f func(p *v1alpha3.AKSClusterParameters)) aksModifier {
+	return func(c *v1alpha3.AKSCluster) { f(&c.Spec.AKSClusterParameters) }
+}
+
+func aksCluster(m ...aksModifier) *v1alpha3.AKSCluster {
+	c := &v1alpha3.AKSCluster{Spec: v1alpha3.AKSClusterSpec{AKSClusterParameters: v1alpha3.AKSClusterParameters{
+		Version: testVersion,
+	}}}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+type mcModifier func(*containerservice.ManagedCluster)
+
+func withTags(t map[string]*string) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.Tags = t }
+}
+
+func withKubernetesVersion(v string) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.KubernetesVersion = to.StringPtr(v) }
+}
+
+func withRBAC(enabled bool) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.EnableRBAC = to.BoolPtr(enabled) }
+}
+
+func withPools(p ...containerservice.ManagedClusterAgentPoolProfile) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.AgentPoolProfiles = &p }
+}
+
+func withSecret(s string) mcModifier {
+	return func(mc *containerservice.ManagedCluster) {
+		mc.ServicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: to.StringPtr("cool-app"),
+			Secret:   azure.ToStringPtr(s),
+		}
+	}
+}
+
+func withIdentity(id *containerservice.ManagedClusterIdentity) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.Identity = id }
+}
+
+func withSku(tier containerservice.ManagedClusterSKUTier) mcModifier {
+	return func(mc *containerservice.ManagedCluster) {
+		mc.Sku = &containerservice.ManagedClusterSKU{Name: containerservice.ManagedClusterSKUNameBasic, Tier: tier}
+	}
+}
+
+func withAPIServerAccess(ap *containerservice.ManagedClusterAPIServerAccessProfile) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.APIServerAccessProfile = ap }
+}
+
+func withAAD(aad *containerservice.ManagedClusterAADProfile) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.AadProfile = aad }
+}
+
+func withUpgradeChannel(ch containerservice.UpgradeChannel) mcModifier {
+	return func(mc *containerservice.ManagedCluster) {
+		mc.AutoUpgradeProfile = &containerservice.ManagedClusterAutoUpgradeProfile{UpgradeChannel: ch}
+	}
+}
+
+func withAddons(a map[string]*containerservice.ManagedClusterAddonProfile) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.AddonProfiles = a }
+}
+
+func withNetworkProfile(np *containerservice.NetworkProfile) mcModifier {
+	return func(mc *containerservice.ManagedCluster) { mc.NetworkProfile = np }
+}
+
+func addon(enabled bool, config map[string]*string) *containerservice.ManagedClusterAddonProfile {
+	return &containerservice.ManagedClusterAddonProfile{Enabled: to.BoolPtr(enabled), Config: config}
+}
+
+func managedCluster(m ...mcModifier) containerservice.ManagedCluster {
+	mc := containerservice.ManagedCluster{
+		Location: to.StringPtr("westeurope"),
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			KubernetesVersion: to.StringPtr(testVersion),
+			DNSPrefix:         to.StringPtr(testPrefix),
+			EnableRBAC:        to.BoolPtr(true),
+		},
+	}
+	for _, f := range m {
+		f(&mc)
+	}
+	return mc
+}
+
+func pool(name string, count int32) containerservice.ManagedClusterAgentPoolProfile {
+	return containerservice.ManagedClusterAgentPoolProfile{
+		Name:   to.StringPtr(name),
+		Count:  to.Int32Ptr(count),
+		VMSize: to.StringPtr(testVMSize),
+	}
+}
+
+func withOrchestratorVersion(p containerservice.ManagedClusterAgentPoolProfile, v string) containerservice.ManagedClusterAgentPoolProfile {
+	p.OrchestratorVersion = to.StringPtr(v)
+	return p
+}
+
+func withAvailabilityZones(p containerservice.ManagedClusterAgentPoolProfile, zones ...string) containerservice.ManagedClusterAgentPoolProfile {
+	p.AvailabilityZones = &zones
+	return p
+}
+
+func TestNewManagedClusterUpdate(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	type args struct {
+		c       *v1alpha3.AKSCluster
+		mc      containerservice.ManagedCluster
+		version string
+		secret  string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   containerservice.ManagedCluster
+	}{
+		"Scale": {
+			reason: "The node count of the agent pool created with the cluster should be updated, omitting other pools.",
+			args: args{
+				c:       aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.NodeCount = to.IntPtr(3) })),
+				mc:      managedCluster(withPools(pool("other", 1), pool(AgentPoolProfileName, 1))),
+				version: testVersion,
+			},
+			want: managedCluster(
+				withTags(map[string]*string{"owner": to.StringPtr("finance")}),
+				withPools(withOrchestratorVersion(pool(AgentPoolProfileName, 3), testVersion)),
+			),
+		},
+		"Upgrade": {
+			reason: "The Kubernetes version of the control plane and the agent pool created with the cluster and the RBAC setting should be updated, retaining unmanaged tags.",
+			args: args{
+				c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.DisableRBAC = true
+					p.Tags = map[string]string{"a": "b"}
+				})),
+				mc: managedCluster(
+					withTags(map[string]*string{"policy": to.StringPtr("yes")}),
+					withPools(withOrchestratorVersion(pool(AgentPoolProfileName, 1), testVersion)),
+				),
+				version: "1.22.4",
+			},
+			want: managedCluster(
+				withTags(map[string]*string{"a": to.StringPtr("b"), "owner": to.StringPtr("finance"), "policy": to.StringPtr("yes")}),
+				withKubernetesVersion("1.22.4"),
+				withRBAC(false),
+				withPools(withOrchestratorVersion(pool(AgentPoolProfileName, 1), "1.22.4")),
+			),
+		},
+		"Options": {
+			reason: "Authorized IP ranges, Azure AD integration, the auto-upgrade channel and add-ons should be updated, retaining unmanaged add-ons.",
+			args: args{
+				c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.AuthorizedIPRanges = []string{"10.0.0.0/8"}
+					p.AADProfile = &v1alpha3.AKSClusterAADProfile{AdminGroupObjectIDs: []string{"admins"}}
+					p.AutoUpgradeChannel = to.StringPtr("stable")
+					p.AddOns = &v1alpha3.AKSClusterAddOns{
+						Monitoring:  &v1alpha3.AKSClusterMonitoringAddOn{Enabled: true, LogAnalyticsWorkspaceResourceID: "cool-workspace"},
+						AzurePolicy: &v1alpha3.AKSClusterAzurePolicyAddOn{Enabled: false},
+					}
+				})),
+				mc: managedCluster(
+					withAPIServerAccess(&containerservice.ManagedClusterAPIServerAccessProfile{EnablePrivateCluster: to.BoolPtr(false)}),
+					withAddons(map[string]*containerservice.ManagedClusterAddonProfile{
+						"omsAgent":      addon(false, nil),
+						"azurepolicy":   addon(true, nil),
+						"kubeDashboard": addon(false, nil),
+					}),
+				),
+				version: testVersion,
+			},
+			want: managedCluster(
+				withTags(map[string]*string{"owner": to.StringPtr("finance")}),
+				withAPIServerAccess(&containerservice.ManagedClusterAPIServerAccessProfile{
+					EnablePrivateCluster: to.BoolPtr(false),
+					AuthorizedIPRanges:   &[]string{"10.0.0.0/8"},
+				}),
+				withAAD(&containerservice.ManagedClusterAADProfile{Managed: to.BoolPtr(true), AdminGroupObjectIDs: &[]string{"admins"}}),
+				withUpgradeChannel(containerservice.UpgradeChannelStable),
+				withAddons(map[string]*containerservice.ManagedClusterAddonProfile{
+					"omsAgent":      addon(true, map[string]*string{"logAnalyticsWorkspaceResourceID": to.StringPtr("cool-workspace")}),
+					"azurepolicy":   addon(false, nil),
+					"kubeDashboard": addon(false, nil),
+				}),
+			),
+		},
+		"RetainsIdentityAndSku": {
+			reason: "The identity and SKU of the cluster should be sent with the update, so that it keeps its identity and uptime SLA.",
+			args: args{
+				c: aksCluster(),
+				mc: managedCluster(
+					withIdentity(&containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityTypeSystemAssigned}),
+					withSku(containerservice.ManagedClusterSKUTierPaid),
+				),
+				version: testVersion,
+			},
+			want: managedCluster(
+				withTags(map[string]*string{"owner": to.StringPtr("finance")}),
+				withIdentity(&containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityTypeSystemAssigned}),
+				withSku(containerservice.ManagedClusterSKUTierPaid),
+			),
+		},
+		"Secret": {
+			reason: "The service principal secret should be set when one is supplied.",
+			args: args{
+				c:       aksCluster(),
+				mc:      managedCluster(withSecret("")),
+				version: testVersion,
+				secret:  "verysecure",
+			},
+			want: managedCluster(
+				withTags(map[string]*string{"owner": to.StringPtr("finance")}),
+				withSecret("verysecure"),
+			),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newManagedClusterUpdate(tc.args.c, tc.args.mc, tc.args.version, tc.args.secret, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nnewManagedClusterUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateManagedCluster(t *testing.T) {
+	errBoom := errors.New("boom")
+	systemAssigned := &containerservice.ManagedClusterIdentity{
+		Type:        containerservice.ResourceIdentityTypeSystemAssigned,
+		PrincipalID: to.StringPtr("cool-principal"),
+	}
+	userAssigned := &containerservice.ManagedClusterIdentity{
+		Type: containerservice.ResourceIdentityTypeUserAssigned,
+		UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+			testMSI: {PrincipalID: to.StringPtr("cool-principal")},
+		},
+	}
+
+	type args struct {
+		ac *v1alpha3.AKSCluster
+		mc containerservice.ManagedCluster
+	}
+	type want struct {
+		identity *containerservice.ManagedClusterIdentity
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"SystemAssigned": {
+			reason: "Updates of clusters with a system-assigned identity should keep the identity.",
+			args: args{
+				ac: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeSystemAssigned}
+					p.NodeCount = to.IntPtr(3)
+				})),
+				mc: managedCluster(withIdentity(systemAssigned), withPools(pool(AgentPoolProfileName, 1))),
+			},
+			want: want{identity: systemAssigned},
+		},
+		"UserAssigned": {
+			reason: "Updates of clusters with a user-assigned identity should keep the identity.",
+			args: args{
+				ac: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeUserAssigned, UserAssignedIdentityID: testMSI}
+					p.NodeCount = to.IntPtr(3)
+				})),
+				mc: managedCluster(withIdentity(userAssigned), withPools(pool(AgentPoolProfileName, 1))),
+			},
+			want: want{identity: userAssigned},
+		},
+		"ImmutableFieldChanged": {
+			reason: "Changes to immutable fields should be rejected rather than silently ignored.",
+			args: args{
+				ac: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.PrivateCluster = to.BoolPtr(true) })),
+				mc: managedCluster(),
+			},
+			want: want{err: errors.Errorf(errImmutableField, "privateCluster")},
+		},
+		"ErrGetUpgradeProfile": {
+			reason: "Errors getting the available upgrades should be returned.",
+			args: args{
+				ac: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.Version = "1.22.4" })),
+				mc: managedCluster(),
+			},
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *containerservice.ManagedClusterIdentity
+			c := AggregateClient{ManagedClusters: &fake.MockManagedClustersClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, mc containerservice.ManagedCluster) (containerservice.ManagedClustersCreateOrUpdateFuture, error) {
+					got = mc.Identity
+					return containerservice.ManagedClustersCreateOrUpdateFuture{}, nil
+				},
+				MockGetUpgradeProfile: func(_ context.Context, _, _ string) (containerservice.ManagedClusterUpgradeProfile, error) {
+					return containerservice.ManagedClusterUpgradeProfile{}, errBoom
+				},
+			}}
+			err := c.UpdateManagedCluster(context.Background(), tc.args.ac, tc.args.mc, "")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdateManagedCluster(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.identity, got); diff != "" {
+				t.Errorf("\n%s\nUpdateManagedCluster(...): -want identity, +got identity:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha3.AKSClusterParameters
+		mc     containerservice.ManagedCluster
+		want   *v1alpha3.AKSClusterParameters
+	}{
+		"Empty": {
+			reason: "Empty fields should be late initialized, except for default tags.",
+			p:      &v1alpha3.AKSClusterParameters{},
+			mc: managedCluster(
+				withTags(map[string]*string{"owner": to.StringPtr("finance"), "a": to.StringPtr("b")}),
+				withPools(pool(AgentPoolProfileName, 2)),
+			),
+			want: &v1alpha3.AKSClusterParameters{
+				DNSNamePrefix: testPrefix,
+				NodeCount:     to.IntPtr(2),
+				NodeVMSize:    testVMSize,
+				Tags:          map[string]string{"a": "b"},
+			},
+		},
+		"Options": {
+			reason: "Cluster options should be late initialized.",
+			p:      &v1alpha3.AKSClusterParameters{DNSNamePrefix: testPrefix},
+			mc: managedCluster(
+				withNetworkProfile(&containerservice.NetworkProfile{
+					NetworkPolicy: containerservice.NetworkPolicyCalico,
+					OutboundType:  containerservice.OutboundTypeLoadBalancer,
+				}),
+				withAPIServerAccess(&containerservice.ManagedClusterAPIServerAccessProfile{
+					EnablePrivateCluster: to.BoolPtr(false),
+					AuthorizedIPRanges:   &[]string{"10.0.0.0/8"},
+				}),
+				withAAD(&containerservice.ManagedClusterAADProfile{
+					Managed:             to.BoolPtr(true),
+					AdminGroupObjectIDs: &[]string{"admins"},
+					EnableAzureRBAC:     to.BoolPtr(true),
+					TenantID:            to.StringPtr("cool-tenant"),
+				}),
+				withUpgradeChannel(containerservice.UpgradeChannelPatch),
+				withAddons(map[string]*containerservice.ManagedClusterAddonProfile{
+					"omsAgent":                  addon(true, map[string]*string{"logAnalyticsWorkspaceResourceID": to.StringPtr("cool-workspace")}),
+					"ingressApplicationGateway": addon(true, map[string]*string{"applicationGatewayId": to.StringPtr("cool-gateway")}),
+				}),
+				withPools(containerservice.ManagedClusterAgentPoolProfile{
+					Name:              to.StringPtr(AgentPoolProfileName),
+					Count:             to.Int32Ptr(1),
+					VMSize:            to.StringPtr(testVMSize),
+					AvailabilityZones: &[]string{"1", "2"},
+				}),
+			),
+			want: &v1alpha3.AKSClusterParameters{
+				DNSNamePrefix:      testPrefix,
+				NodeCount:          to.IntPtr(1),
+				NodeVMSize:         testVMSize,
+				NetworkPolicy:      to.StringPtr("calico"),
+				OutboundType:       to.StringPtr("loadBalancer"),
+				PrivateCluster:     to.BoolPtr(false),
+				AuthorizedIPRanges: []string{"10.0.0.0/8"},
+				AADProfile: &v1alpha3.AKSClusterAADProfile{
+					AdminGroupObjectIDs: []string{"admins"},
+					EnableAzureRBAC:     to.BoolPtr(true),
+					TenantID:            to.StringPtr("cool-tenant"),
+				},
+				AvailabilityZones:  []string{"1", "2"},
+				AutoUpgradeChannel: to.StringPtr("patch"),
+				AddOns: &v1alpha3.AKSClusterAddOns{
+					Monitoring: &v1alpha3.AKSClusterMonitoringAddOn{Enabled: true, LogAnalyticsWorkspaceResourceID: "cool-workspace"},
+					Ingress:    &v1alpha3.AKSClusterIngressAddOn{Enabled: true, ApplicationGatewayID: "cool-gateway"},
+				},
+			},
+		},
+		"AlreadySet": {
+			reason: "Fields that are already set should not be late initialized.",
+			p: &v1alpha3.AKSClusterParameters{
+				DNSNamePrefix: "mine",
+				NodeCount:     to.IntPtr(0),
+				NodeVMSize:    "Standard_F2s_v2",
+			},
+			mc: managedCluster(withPools(pool(AgentPoolProfileName, 2))),
+			want: &v1alpha3.AKSClusterParameters{
+				DNSNamePrefix: "mine",
+				NodeCount:     to.IntPtr(0),
+				NodeVMSize:    "Standard_F2s_v2",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(tc.p, tc.mc, d)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsManagedClusterUpToDate(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+	tags := withTags(map[string]*string{"owner": to.StringPtr("finance")})
+
+	cases := map[string]struct {
+		c    *v1alpha3.AKSCluster
+		mc   containerservice.ManagedCluster
+		want bool
+	}{
+		"UpToDate": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.NodeCount = to.IntPtr(2) })),
+			mc:   managedCluster(tags, withPools(pool(AgentPoolProfileName, 2))),
+			want: true,
+		},
+		"MissingDefaultTags": {
+			c:    aksCluster(),
+			mc:   managedCluster(),
+			want: false,
+		},
+		"VersionChanged": {
+			c:    aksCluster(),
+			mc:   managedCluster(tags, withKubernetesVersion("1.20.9")),
+			want: false,
+		},
+		"RBACChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.DisableRBAC = true })),
+			mc:   managedCluster(tags),
+			want: false,
+		},
+		"NodeCountChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.NodeCount = to.IntPtr(3) })),
+			mc:   managedCluster(tags, withPools(pool(AgentPoolProfileName, 2))),
+			want: false,
+		},
+		"AgentPoolVersionBehind": {
+			c:    aksCluster(),
+			mc:   managedCluster(tags, withPools(withOrchestratorVersion(pool(AgentPoolProfileName, 2), "1.20.9"))),
+			want: false,
+		},
+		"AutoUpgradedVersion": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AutoUpgradeChannel = to.StringPtr("patch") })),
+			mc:   managedCluster(tags, withKubernetesVersion("1.21.9"), withUpgradeChannel(containerservice.UpgradeChannelPatch)),
+			want: true,
+		},
+		"OutdatedVersionWithChannel": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AutoUpgradeChannel = to.StringPtr("patch") })),
+			mc:   managedCluster(tags, withKubernetesVersion("1.20.9"), withUpgradeChannel(containerservice.UpgradeChannelPatch)),
+			want: false,
+		},
+		"AutoUpgradeChannelChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AutoUpgradeChannel = to.StringPtr("stable") })),
+			mc:   managedCluster(tags, withUpgradeChannel(containerservice.UpgradeChannelPatch)),
+			want: false,
+		},
+		"AuthorizedIPRangesReordered": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.AuthorizedIPRanges = []string{"10.0.0.0/8", "192.168.0.0/16"}
+			})),
+			mc: managedCluster(tags, withAPIServerAccess(&containerservice.ManagedClusterAPIServerAccessProfile{
+				AuthorizedIPRanges: &[]string{"192.168.0.0/16", "10.0.0.0/8"},
+			})),
+			want: true,
+		},
+		"AuthorizedIPRangesChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AuthorizedIPRanges = []string{"10.0.0.0/8"} })),
+			mc: managedCluster(tags, withAPIServerAccess(&containerservice.ManagedClusterAPIServerAccessProfile{
+				AuthorizedIPRanges: &[]string{"192.168.0.0/16"},
+			})),
+			want: false,
+		},
+		"NetworkPolicyChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.NetworkPolicy = to.StringPtr("calico") })),
+			mc:   managedCluster(tags, withNetworkProfile(&containerservice.NetworkProfile{NetworkPolicy: containerservice.NetworkPolicyAzure})),
+			want: false,
+		},
+		"OutboundTypeChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.OutboundType = to.StringPtr("userDefinedRouting") })),
+			mc: managedCluster(tags, withNetworkProfile(&containerservice.NetworkProfile{
+				OutboundType: containerservice.OutboundTypeLoadBalancer,
+			})),
+			want: false,
+		},
+		"PrivateClusterChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.PrivateCluster = to.BoolPtr(true) })),
+			mc:   managedCluster(tags),
+			want: false,
+		},
+		"AvailabilityZonesReordered": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AvailabilityZones = []string{"1", "2"} })),
+			mc:   managedCluster(tags, withPools(withAvailabilityZones(pool(AgentPoolProfileName, 1), "2", "1"))),
+			want: true,
+		},
+		"AvailabilityZonesChanged": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AvailabilityZones = []string{"1", "2", "3"} })),
+			mc:   managedCluster(tags, withPools(withAvailabilityZones(pool(AgentPoolProfileName, 1), "1"))),
+			want: false,
+		},
+		"AADNotEnabled": {
+			c:    aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) { p.AADProfile = &v1alpha3.AKSClusterAADProfile{} })),
+			mc:   managedCluster(tags),
+			want: false,
+		},
+		"AADAdminGroupsChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.AADProfile = &v1alpha3.AKSClusterAADProfile{AdminGroupObjectIDs: []string{"admins"}}
+			})),
+			mc:   managedCluster(tags, withAAD(&containerservice.ManagedClusterAADProfile{Managed: to.BoolPtr(true)})),
+			want: false,
+		},
+		"AddOnDisabled": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.AddOns = &v1alpha3.AKSClusterAddOns{AzurePolicy: &v1alpha3.AKSClusterAzurePolicyAddOn{Enabled: true}}
+			})),
+			mc:   managedCluster(tags, withAddons(map[string]*containerservice.ManagedClusterAddonProfile{"azurepolicy": addon(false, nil)})),
+			want: false,
+		},
+		"AddOnMissing": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.AddOns = &v1alpha3.AKSClusterAddOns{AzurePolicy: &v1alpha3.AKSClusterAzurePolicyAddOn{Enabled: false}}
+			})),
+			mc:   managedCluster(tags),
+			want: true,
+		},
+		"AddOnConfigChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.AddOns = &v1alpha3.AKSClusterAddOns{Monitoring: &v1alpha3.AKSClusterMonitoringAddOn{Enabled: true, LogAnalyticsWorkspaceResourceID: "new"}}
+			})),
+			mc: managedCluster(tags, withAddons(map[string]*containerservice.ManagedClusterAddonProfile{
+				"omsagent": addon(true, map[string]*string{"logAnalyticsWorkspaceResourceID": to.StringPtr("old")}),
+			})),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsManagedClusterUpToDate(tc.c, tc.mc, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsManagedClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewManagedCluster(t *testing.T) {
+	type args struct {
+		c      *v1alpha3.AKSCluster
+		appID  string
+		secret string
+	}
+	type want struct {
+		identity *containerservice.ManagedClusterIdentity
+		sp       *containerservice.ManagedClusterServicePrincipalProfile
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ServicePrincipal": {
+			reason: "Clusters without an identity should use the supplied service principal.",
+			args: args{
+				c:      aksCluster(),
+				appID:  "cool-app",
+				secret: "cool-secret",
+			},
+			want: want{
+				sp: &containerservice.ManagedClusterServicePrincipalProfile{
+					ClientID: to.StringPtr("cool-app"),
+					Secret:   to.StringPtr("cool-secret"),
+				},
+			},
+		},
+		"SystemAssigned": {
+			reason: "Clusters with a system-assigned identity should not use a service principal.",
+			args: args{
+				c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeSystemAssigned}
+				})),
+			},
+			want: want{
+				identity: &containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityTypeSystemAssigned},
+			},
+		},
+		"UserAssigned": {
+			reason: "Clusters with a user-assigned identity should reference it.",
+			args: args{
+				c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+					p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeUserAssigned, UserAssignedIdentityID: testMSI}
+				})),
+			},
+			want: want{
+				identity: &containerservice.ManagedClusterIdentity{
+					Type: containerservice.ResourceIdentityTypeUserAssigned,
+					UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+						testMSI: {},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newManagedCluster(tc.args.c, tc.args.appID, tc.args.secret, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want.identity, got.Identity); diff != "" {
+				t.Errorf("\n%s\nnewManagedCluster(...): -want identity, +got identity:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sp, got.ServicePrincipalProfile); diff != "" {
+				t.Errorf("\n%s\nnewManagedCluster(...): -want service principal, +got service principal:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewManagedClusterOptions(t *testing.T) {
+	c := aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.NodeVMSize = testVMSize
+		p.VnetSubnetID = testSubnet
+		p.NetworkPolicy = to.StringPtr("azure")
+		p.OutboundType = to.StringPtr("userDefinedRouting")
+		p.PrivateCluster = to.BoolPtr(true)
+		p.AvailabilityZones = []string{"1", "2", "3"}
+		p.AADProfile = &v1alpha3.AKSClusterAADProfile{EnableAzureRBAC: to.BoolPtr(true)}
+		p.AutoUpgradeChannel = to.StringPtr("rapid")
+		p.AddOns = &v1alpha3.AKSClusterAddOns{Ingress: &v1alpha3.AKSClusterIngressAddOn{Enabled: true, SubnetCIDR: "10.2.0.0/16"}}
+	}))
+
+	got := newManagedCluster(c, "cool-app", "cool-secret", azure.ResourceDefaults{}).ManagedClusterProperties
+	want := &containerservice.ManagedClusterProperties{
+		KubernetesVersion: to.StringPtr(testVersion),
+		DNSPrefix:         to.StringPtr(""),
+		EnableRBAC:        to.BoolPtr(true),
+		AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{
+			Name:              to.StringPtr(AgentPoolProfileName),
+			Count:             to.Int32Ptr(v1alpha3.DefaultNodeCount),
+			VMSize:            to.StringPtr(testVMSize),
+			Mode:              containerservice.AgentPoolModeSystem,
+			Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+			VnetSubnetID:      to.StringPtr(testSubnet),
+			AvailabilityZones: &[]string{"1", "2", "3"},
+		}},
+		ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: to.StringPtr("cool-app"),
+			Secret:   to.StringPtr("cool-secret"),
+		},
+		NetworkProfile: &containerservice.NetworkProfile{
+			NetworkPlugin: containerservice.NetworkPluginAzure,
+			NetworkPolicy: containerservice.NetworkPolicyAzure,
+			OutboundType:  containerservice.OutboundTypeUserDefinedRouting,
+		},
+		APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{EnablePrivateCluster: to.BoolPtr(true)},
+		AadProfile:             &containerservice.ManagedClusterAADProfile{Managed: to.BoolPtr(true), EnableAzureRBAC: to.BoolPtr(true)},
+		AutoUpgradeProfile:     &containerservice.ManagedClusterAutoUpgradeProfile{UpgradeChannel: containerservice.UpgradeChannelRapid},
+		AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+			AddOnIngress: addon(true, map[string]*string{"subnetCIDR": to.StringPtr("10.2.0.0/16")}),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newManagedCluster(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIdentityPrincipalID(t *testing.T) {
+	cases := map[string]struct {
+		mc   containerservice.ManagedCluster
+		want string
+	}{
+		"NoIdentity": {
+			mc:   managedCluster(),
+			want: "",
+		},
+		"SystemAssigned": {
+			mc: managedCluster(withIdentity(&containerservice.ManagedClusterIdentity{
+				Type:        containerservice.ResourceIdentityTypeSystemAssigned,
+				PrincipalID: to.StringPtr("cool-principal"),
+			})),
+			want: "cool-principal",
+		},
+		"UserAssigned": {
+			mc: managedCluster(withIdentity(&containerservice.ManagedClusterIdentity{
+				Type: containerservice.ResourceIdentityTypeUserAssigned,
+				UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+					testMSI: {PrincipalID: to.StringPtr("cool-principal")},
+				},
+			})),
+			want: "cool-principal",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IdentityPrincipalID(tc.mc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IdentityPrincipalID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkContributorUpToDate(t *testing.T) {
+	identity := withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeSystemAssigned}
+	})
+	subnet := withSpec(func(p *v1alpha3.AKSClusterParameters) { p.VnetSubnetID = testSubnet })
+	granted := func(c *v1alpha3.AKSCluster) { c.Status.NetworkContributorPrincipalID = "cool-principal" }
+	mc := managedCluster(withIdentity(&containerservice.ManagedClusterIdentity{
+		Type:        containerservice.ResourceIdentityTypeSystemAssigned,
+		PrincipalID: to.StringPtr("cool-principal"),
+	}))
+
+	cases := map[string]struct {
+		c    *v1alpha3.AKSCluster
+		want bool
+	}{
+		"ServicePrincipal": {
+			c:    aksCluster(subnet),
+			want: true,
+		},
+		"NoSubnet": {
+			c:    aksCluster(identity),
+			want: true,
+		},
+		"NotGranted": {
+			c:    aksCluster(identity, subnet),
+			want: false,
+		},
+		"Granted": {
+			c:    aksCluster(identity, subnet, granted),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkContributorUpToDate(tc.c, mc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNetworkContributorUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func credential(keyID string, start time.Time) graphrbac.PasswordCredential {
+	return graphrbac.PasswordCredential{KeyID: to.StringPtr(keyID), StartDate: &date.Time{Time: start}}
+}
+
+func TestCredentialRotation(t *testing.T) {
+	now := time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC)
+	rotation := withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.CredentialRotation = &v1alpha3.AKSClusterCredentialRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}}
+	})
+	identity := withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.Identity = &v1alpha3.AKSClusterIdentity{Type: v1alpha3.IdentityTypeSystemAssigned}
+	})
+	grace := withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.CredentialRotation.GracePeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	})
+	reset := func(keyID, state string) aksModifier {
+		return func(c *v1alpha3.AKSCluster) {
+			c.Status.ServicePrincipalKeyID = keyID
+			c.Status.State = state
+		}
+	}
+
+	type want struct {
+		due      bool
+		expired  []string
+		upToDate bool
+		status   *v1alpha3.AKSClusterCredentialStatus
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      *v1alpha3.AKSCluster
+		creds  []graphrbac.PasswordCredential
+		want   want
+	}{
+		"NoRotation": {
+			reason: "Secrets of clusters without a rotation interval should never be rotated.",
+			c:      aksCluster(),
+			creds:  []graphrbac.PasswordCredential{credential("old", now.AddDate(-1, 0, 0)), credential("new", now.AddDate(0, 0, -2))},
+			want: want{
+				upToDate: true,
+				status:   &v1alpha3.AKSClusterCredentialStatus{KeyID: "new", CreatedAt: &metav1.Time{Time: now.AddDate(0, 0, -2)}},
+			},
+		},
+		"ManagedIdentity": {
+			reason: "Clusters with a managed identity have no secret to rotate.",
+			c:      aksCluster(rotation, identity),
+			want:   want{upToDate: true},
+		},
+		"NoCredentials": {
+			reason: "A secret should be generated if the application has none.",
+			c:      aksCluster(rotation),
+			want:   want{due: true},
+		},
+		"Due": {
+			reason: "A secret that is older than the rotation interval should be rotated.",
+			c:      aksCluster(rotation),
+			creds:  []graphrbac.PasswordCredential{credential("current", now.Add(-24*time.Hour))},
+			want: want{
+				due:    true,
+				status: &v1alpha3.AKSClusterCredentialStatus{KeyID: "current", CreatedAt: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+			},
+		},
+		"InGracePeriod": {
+			reason: "Previous secrets should be kept during the default grace period.",
+			c:      aksCluster(rotation),
+			creds:  []graphrbac.PasswordCredential{credential("old", now.Add(-48*time.Hour)), credential("new", now.Add(-30*time.Minute))},
+			want: want{
+				upToDate: true,
+				status:   &v1alpha3.AKSClusterCredentialStatus{KeyID: "new", CreatedAt: &metav1.Time{Time: now.Add(-30 * time.Minute)}},
+			},
+		},
+		"Orphaned": {
+			reason: "A secret should be rotated again if the cluster was never reset to use the newest secret.",
+			c:      aksCluster(rotation, reset("old", "Succeeded")),
+			creds:  []graphrbac.PasswordCredential{credential("old", now.Add(-48*time.Hour)), credential("new", now.Add(-30*time.Minute))},
+			want: want{
+				due:    true,
+				status: &v1alpha3.AKSClusterCredentialStatus{KeyID: "new", CreatedAt: &metav1.Time{Time: now.Add(-30 * time.Minute)}},
+			},
+		},
+		"ResetInProgress": {
+			reason: "Previous secrets should be kept until the cluster has finished resetting to the newest secret.",
+			c:      aksCluster(rotation, grace, reset("new", "Updating")),
+			creds:  []graphrbac.PasswordCredential{credential("new", now.Add(-30*time.Minute)), credential("old", now.Add(-48*time.Hour))},
+			want: want{
+				upToDate: true,
+				status:   &v1alpha3.AKSClusterCredentialStatus{KeyID: "new", CreatedAt: &metav1.Time{Time: now.Add(-30 * time.Minute)}},
+			},
+		},
+		"Expired": {
+			reason: "Previous secrets should be removed once the grace period has passed.",
+			c:      aksCluster(rotation, grace, reset("new", "Succeeded")),
+			creds:  []graphrbac.PasswordCredential{credential("new", now.Add(-30*time.Minute)), credential("old", now.Add(-48*time.Hour))},
+			want: want{
+				expired: []string{"old"},
+				status:  &v1alpha3.AKSClusterCredentialStatus{KeyID: "new", CreatedAt: &metav1.Time{Time: now.Add(-30 * time.Minute)}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.due, IsCredentialRotationDue(tc.c, tc.creds, now)); diff != "" {
+				t.Errorf("\n%s\nIsCredentialRotationDue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.expired, ExpiredCredentials(tc.c, tc.creds, now)); diff != "" {
+				t.Errorf("\n%s\nExpiredCredentials(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, IsCredentialRotationUpToDate(tc.c, tc.creds, now)); diff != "" {
+				t.Errorf("\n%s\nIsCredentialRotationUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, GenerateCredentialStatus(tc.creds)); diff != "" {
+				t.Errorf("\n%s\nGenerateCredentialStatus(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeVersion(t *testing.T) {
+	type args struct {
+		current   string
+		desired   string
+		available []string
+	}
+	type want struct {
+		version string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Patch": {
+			reason: "Patch upgrades should go straight to the desired version.",
+			args:   args{current: "1.21.2", desired: "1.21.7"},
+			want:   want{version: "1.21.7"},
+		},
+		"NextMinor": {
+			reason: "Upgrades to the next minor version should go straight to the desired version.",
+			args:   args{current: "1.21.2", desired: "1.22.4"},
+			want:   want{version: "1.22.4"},
+		},
+		"SkipMinor": {
+			reason: "Upgrades that skip minor versions should go to the newest available version of the next minor version.",
+			args: args{
+				current:   "1.20.9",
+				desired:   "1.22.4",
+				available: []string{"1.20.13", "1.21.2", "1.21.7", "1.22.4"},
+			},
+			want: want{version: "1.21.7"},
+		},
+		"NoUpgradeAvailable": {
+			reason: "An error should be returned if the next minor version is not available.",
+			args: args{
+				current:   "1.20.9",
+				desired:   "1.22.4",
+				available: []string{"1.20.13"},
+			},
+			want: want{err: errors.New("no upgrade from Kubernetes version 1.20.9 towards 1.22.4 is available")},
+		},
+		"Downgrade": {
+			reason: "Downgrades should return an error.",
+			args:   args{current: "1.22.4", desired: "1.21.7"},
+			want:   want{err: errors.New("cannot downgrade Kubernetes from version 1.22.4 to 1.21.7")},
+		},
+		"InvalidVersion": {
+			reason: "Versions that cannot be parsed should return an error.",
+			args:   args{current: "1.21.2", desired: "latest"},
+			want:   want{err: errors.New(`cannot parse Kubernetes version "latest"`)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UpgradeVersion(tc.args.current, tc.args.desired, tc.args.available)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpgradeVersion(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.version, got); diff != "" {
+				t.Errorf("\n%s\nUpgradeVersion(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExecKubeConfig(t *testing.T) {
+	kubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: cool-cluster
+  cluster:
+    server: https://cool-cluster.example.org:443
+contexts:
+- name: cool-cluster
+  context:
+    cluster: cool-cluster
+    user: cool-user
+current-context: cool-cluster
+users:
+- name: cool-user
+  user:
+    auth-provider:
+      name: azure
+      config:
+        apiserver-id: cool-server
+        client-id: cool-client
+        tenant-id: cool-tenant
+        environment: AzurePublicCloud
+- name: cert-user
+  user:
+    client-certificate-data: Y29vbA==
+`)
+
+	got, err := ExecKubeConfig(kubeconfig, "azurecli")
+	if err != nil {
+		t.Fatalf("ExecKubeConfig(...): %s", err)
+	}
+	cfg, err := clientcmd.Load(got)
+	if err != nil {
+		t.Fatalf("clientcmd.Load(...): %s", err)
+	}
+
+	want := &clientcmdapi.ExecConfig{
+		APIVersion:      "client.authentication.k8s.io/v1beta1",
+		Command:         "kubelogin",
+		Args:            []string{"get-token", "--login", "azurecli", "--server-id", "cool-server", "--client-id", "cool-client", "--tenant-id", "cool-tenant", "--environment", "AzurePublicCloud"},
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+	}
+	if diff := cmp.Diff(want, cfg.AuthInfos["cool-user"].Exec, cmpopts.IgnoreFields(clientcmdapi.ExecConfig{}, "Config")); diff != "" {
+		t.Errorf("ExecKubeConfig(...): -want exec, +got exec:\n%s", diff)
+	}
+	if cfg.AuthInfos["cool-user"].AuthProvider != nil {
+		t.Errorf("ExecKubeConfig(...): want no auth provider")
+	}
+	if diff := cmp.Diff([]byte("cool"), cfg.AuthInfos["cert-user"].ClientCertificateData); diff != "" {
+		t.Errorf("ExecKubeConfig(...): -want certificate, +got certificate:\n%s", diff)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"strings"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// galleryImagePath is part of the IDs of shared image gallery images.
+const galleryImagePath = "/galleries/"
+
+// A DisksClient handles CRUD operations for Azure managed disks.
+type DisksClient computeapi.DisksClientAPI
+
+// NewDisk returns disk creation parameters suitable for use with the Azure
+// API.
+func NewDisk(p v1alpha3.DiskParameters, d azure.ResourceDefaults) computemgmt.Disk {
+	disk := computemgmt.Disk{
+		Location: to.StringPtr(d.LocationOr(p.Location)),
+		DiskProperties: &computemgmt.DiskProperties{
+			CreationData: newDiskCreationData(p.Source),
+			DiskSizeGB:   azure.ToInt32(p.DiskSizeGB),
+			Encryption:   newEncryption(p.DiskEncryptionSetID, nil),
+		},
+		Tags: azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+	if p.SKU != nil {
+		disk.Sku = &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypes(*p.SKU)}
+	}
+	if p.Zone != nil {
+		disk.Zones = &[]string{*p.Zone}
+	}
+	return disk
+}
+
+// NewDiskUpdate returns an update of the supplied disk that applies the
+// updatable fields of the supplied parameters.
+func NewDiskUpdate(p v1alpha3.DiskParameters, disk computemgmt.Disk, d azure.ResourceDefaults) computemgmt.DiskUpdate {
+	var observed *computemgmt.Encryption
+	if disk.DiskProperties != nil {
+		observed = disk.Encryption
+	}
+	u := computemgmt.DiskUpdate{
+		DiskUpdateProperties: &computemgmt.DiskUpdateProperties{
+			DiskSizeGB: azure.ToInt32(p.DiskSizeGB),
+			Encryption: newEncryption(p.DiskEncryptionSetID, observed),
+		},
+		Tags: d.UpdateTags(d.MergeTags(p.Tags), disk.Tags),
+	}
+	if p.SKU != nil {
+		u.Sku = &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypes(*p.SKU)}
+	}
+	return u
+}
+
+// LateInitializeDisk fills the empty fields of the supplied parameters with the
+// values of the supplied disk.
+func LateInitializeDisk(p *v1alpha3.DiskParameters, disk computemgmt.Disk, d azure.ResourceDefaults) {
+	if p.SKU == nil && disk.Sku != nil && disk.Sku.Name != "" {
+		p.SKU = to.StringPtr(string(disk.Sku.Name))
+	}
+	if p.Zone == nil && disk.Zones != nil && len(*disk.Zones) > 0 {
+		p.Zone = to.StringPtr((*disk.Zones)[0])
+	}
+	p.Tags = d.LateInitializeTags(p.Tags, disk.Tags)
+	if disk.DiskProperties == nil {
+		return
+	}
+	p.DiskSizeGB = azure.LateInitializeIntPtrFromInt32Ptr(p.DiskSizeGB, disk.DiskSizeGB)
+}
+
+// IsDiskUpToDate returns true if the supplied disk is up to date with the
+// updatable fields of the supplied parameters.
+func IsDiskUpToDate(p v1alpha3.DiskParameters, disk computemgmt.Disk, d azure.ResourceDefaults) bool {
+	if disk.DiskProperties == nil {
+		return false
+	}
+	if p.SKU != nil && (disk.Sku == nil || !strings.EqualFold(string(disk.Sku.Name), *p.SKU)) {
+		return false
+	}
+	if p.DiskSizeGB != nil && azure.ToInt(disk.DiskSizeGB) != *p.DiskSizeGB {
+		return false
+	}
+	if !isEncryptionUpToDate(p.DiskEncryptionSetID, disk.Encryption) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), disk.Tags)
+}
+
+func newDiskCreationData(s *v1alpha3.DiskSource) *computemgmt.CreationData {
+	switch {
+	case s == nil:
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionEmpty}
+	case s.SnapshotID != nil:
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionCopy, SourceResourceID: s.SnapshotID}
+	case s.ImageID != nil:
+		ref := &computemgmt.ImageDiskReference{ID: s.ImageID}
+		if strings.Contains(strings.ToLower(*s.ImageID), galleryImagePath) {
+			return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionFromImage, GalleryImageReference: ref}
+		}
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionFromImage, ImageReference: ref}
+	case s.BlobURI != nil:
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionImport, SourceURI: s.BlobURI, StorageAccountID: s.StorageAccountID}
+	default:
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionEmpty}
+	}
+}
+
+// newEncryption returns the encryption settings of a disk or snapshot that
+// uses the supplied disk encryption set. Resources without a disk encryption
+// set are switched back to platform-managed keys if they use one.
+func newEncryption(id *string, observed *computemgmt.Encryption) *computemgmt.Encryption {
+	if id != nil {
+		return &computemgmt.Encryption{DiskEncryptionSetID: id, Type: computemgmt.EncryptionTypeEncryptionAtRestWithCustomerKey}
+	}
+	if observed == nil || observed.DiskEncryptionSetID == nil {
+		return nil
+	}
+	return &computemgmt.Encryption{Type: computemgmt.EncryptionTypeEncryptionAtRestWithPlatformKey}
+}
+
+// isEncryptionUpToDate returns true if the supplied encryption settings use the
+// supplied disk encryption set. Azure may change the case of resource IDs, so
+// they are compared case-insensitively.
+func isEncryptionUpToDate(id *string, observed *computemgmt.Encryption) bool {
+	var o string
+	if observed != nil {
+		o = azure.ToString(observed.DiskEncryptionSetID)
+	}
+	return strings.EqualFold(azure.ToString(id), o)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	testSnapshotID      = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/cool-snapshot"
+	testGalleryImageID  = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/galleries/cool/images/ubuntu/versions/1.0.0"
+	testEncryptionSetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/cool-des"
+)
+
+func TestNewDisk(t *testing.T) {
+	d := azure.ResourceDefaults{Location: "westeurope", Tags: map[string]string{"team": "cool"}}
+
+	cases := map[string]struct {
+		p    v1alpha3.DiskParameters
+		want computemgmt.Disk
+	}{
+		"Empty": {
+			p: v1alpha3.DiskParameters{
+				SKU:        to.StringPtr("Premium_LRS"),
+				DiskSizeGB: to.IntPtr(64),
+				Zone:       to.StringPtr("1"),
+			},
+			want: computemgmt.Disk{
+				Location: to.StringPtr("westeurope"),
+				Sku:      &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypesPremiumLRS},
+				Zones:    &[]string{"1"},
+				DiskProperties: &computemgmt.DiskProperties{
+					CreationData: &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionEmpty},
+					DiskSizeGB:   to.Int32Ptr(64),
+				},
+				Tags: map[string]*string{"team": to.StringPtr("cool")},
+			},
+		},
+		"FromSnapshot": {
+			p: v1alpha3.DiskParameters{
+				Location:            "northeurope",
+				Source:              &v1alpha3.DiskSource{SnapshotID: to.StringPtr(testSnapshotID)},
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+			},
+			want: computemgmt.Disk{
+				Location: to.StringPtr("northeurope"),
+				DiskProperties: &computemgmt.DiskProperties{
+					CreationData: &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionCopy, SourceResourceID: to.StringPtr(testSnapshotID)},
+					Encryption:   &computemgmt.Encryption{DiskEncryptionSetID: to.StringPtr(testEncryptionSetID), Type: computemgmt.EncryptionTypeEncryptionAtRestWithCustomerKey},
+				},
+				Tags: map[string]*string{"team": to.StringPtr("cool")},
+			},
+		},
+		"FromGalleryImage": {
+			p: v1alpha3.DiskParameters{
+				Source: &v1alpha3.DiskSource{ImageID: to.StringPtr(testGalleryImageID)},
+			},
+			want: computemgmt.Disk{
+				Location: to.StringPtr("westeurope"),
+				DiskProperties: &computemgmt.DiskProperties{
+					CreationData: &computemgmt.CreationData{
+						CreateOption:          computemgmt.DiskCreateOptionFromImage,
+						GalleryImageReference: &computemgmt.ImageDiskReference{ID: to.StringPtr(testGalleryImageID)},
+					},
+				},
+				Tags: map[string]*string{"team": to.StringPtr("cool")},
+			},
+		},
+		"FromBlob": {
+			p: v1alpha3.DiskParameters{
+				Source: &v1alpha3.DiskSource{BlobURI: to.StringPtr("https://cool.blob.core.windows.net/vhds/cool.vhd"), StorageAccountID: to.StringPtr("cool-account")},
+			},
+			want: computemgmt.Disk{
+				Location: to.StringPtr("westeurope"),
+				DiskProperties: &computemgmt.DiskProperties{
+					CreationData: &computemgmt.CreationData{
+						CreateOption:     computemgmt.DiskCreateOptionImport,
+						SourceURI:        to.StringPtr("https://cool.blob.core.windows.net/vhds/cool.vhd"),
+						StorageAccountID: to.StringPtr("cool-account"),
+					},
+				},
+				Tags: map[string]*string{"team": to.StringPtr("cool")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewDisk(tc.p, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewDiskUpdate(t *testing.T) {
+	disk := computemgmt.Disk{
+		DiskProperties: &computemgmt.DiskProperties{
+			DiskSizeGB: to.Int32Ptr(64),
+			Encryption: &computemgmt.Encryption{DiskEncryptionSetID: to.StringPtr(testEncryptionSetID), Type: computemgmt.EncryptionTypeEncryptionAtRestWithCustomerKey},
+		},
+		Tags: map[string]*string{"policy": to.StringPtr("yes")},
+	}
+	p := v1alpha3.DiskParameters{
+		SKU:        to.StringPtr("StandardSSD_LRS"),
+		DiskSizeGB: to.IntPtr(128),
+		Tags:       map[string]string{"a": "b"},
+	}
+	want := computemgmt.DiskUpdate{
+		Sku: &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypesStandardSSDLRS},
+		DiskUpdateProperties: &computemgmt.DiskUpdateProperties{
+			DiskSizeGB: to.Int32Ptr(128),
+			Encryption: &computemgmt.Encryption{Type: computemgmt.EncryptionTypeEncryptionAtRestWithPlatformKey},
+		},
+		Tags: map[string]*string{"policy": to.StringPtr("yes"), "a": to.StringPtr("b")},
+	}
+
+	got := NewDiskUpdate(p, disk, azure.ResourceDefaults{})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewDiskUpdate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeDisk(t *testing.T) {
+	disk := computemgmt.Disk{
+		Sku:            &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypesStandardLRS},
+		Zones:          &[]string{"2"},
+		DiskProperties: &computemgmt.DiskProperties{DiskSizeGB: to.Int32Ptr(32)},
+		Tags:           map[string]*string{"team": to.StringPtr("cool"), "a": to.StringPtr("b")},
+	}
+	want := v1alpha3.DiskParameters{
+		SKU:        to.StringPtr("Standard_LRS"),
+		DiskSizeGB: to.IntPtr(32),
+		Zone:       to.StringPtr("2"),
+		Tags:       map[string]string{"a": "b"},
+	}
+
+	got := v1alpha3.DiskParameters{}
+	LateInitializeDisk(&got, disk, azure.ResourceDefaults{Tags: map[string]string{"team": "cool"}})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeDisk(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDiskUpToDate(t *testing.T) {
+	disk := computemgmt.Disk{
+		Sku: &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypesPremiumLRS},
+		DiskProperties: &computemgmt.DiskProperties{
+			DiskSizeGB: to.Int32Ptr(64),
+			Encryption: &computemgmt.Encryption{DiskEncryptionSetID: to.StringPtr(testEncryptionSetID)},
+		},
+		Tags: map[string]*string{"a": to.StringPtr("b")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.DiskParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.DiskParameters{
+				SKU:                 to.StringPtr("premium_lrs"),
+				DiskSizeGB:          to.IntPtr(64),
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+			},
+			want: true,
+		},
+		"Resized": {
+			p: v1alpha3.DiskParameters{
+				DiskSizeGB:          to.IntPtr(128),
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+			},
+			want: false,
+		},
+		"SKU": {
+			p: v1alpha3.DiskParameters{
+				SKU:                 to.StringPtr("Standard_LRS"),
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+			},
+			want: false,
+		},
+		"PlatformKey": {
+			p:    v1alpha3.DiskParameters{},
+			want: false,
+		},
+		"Tags": {
+			p: v1alpha3.DiskParameters{
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+				Tags:                map[string]string{"a": "c"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDiskUpToDate(tc.p, disk, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDiskUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
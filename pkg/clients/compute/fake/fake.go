@@ -19,17 +19,27 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice/containerserviceapi"
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 
 	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
 )
 
 // AKSClient is a fake AKS client.
 type AKSClient struct {
-	MockGetManagedCluster    func(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
-	MockEnsureManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
-	MockDeleteManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster) error
-	MockGetKubeConfig        func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	MockGetManagedCluster        func(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
+	MockEnsureManagedCluster     func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	MockUpdateManagedCluster     func(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, secret string) error
+	MockEnsureNetworkContributor func(ctx context.Context, ac *v1alpha3.AKSCluster, principalID string) error
+	MockDeleteManagedCluster     func(ctx context.Context, ac *v1alpha3.AKSCluster) error
+	MockGetKubeConfig            func(ctx context.Context, ac *v1alpha3.AKSCluster, kc v1alpha3.AKSClusterKubeconfig) ([]byte, error)
+
+	MockGetServicePrincipalCredentials    func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error)
+	MockRotateServicePrincipalSecret      func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (string, error)
+	MockRemoveServicePrincipalCredentials func(ctx context.Context, ac *v1alpha3.AKSCluster, keyIDs []string) error
 }
 
 // GetManagedCluster calls MockGetManagedCluster.
@@ -42,12 +52,215 @@ func (c AKSClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSClu
 	return c.MockEnsureManagedCluster(ctx, ac, secret)
 }
 
+// UpdateManagedCluster calls MockUpdateManagedCluster.
+func (c AKSClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster, secret string) error {
+	return c.MockUpdateManagedCluster(ctx, ac, mc, secret)
+}
+
+// EnsureNetworkContributor calls MockEnsureNetworkContributor.
+func (c AKSClient) EnsureNetworkContributor(ctx context.Context, ac *v1alpha3.AKSCluster, principalID string) error {
+	return c.MockEnsureNetworkContributor(ctx, ac, principalID)
+}
+
 // DeleteManagedCluster calls DeleteManagedCluster.
 func (c AKSClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
 	return c.MockDeleteManagedCluster(ctx, ac)
 }
 
 // GetKubeConfig calls GetKubeConfig.
-func (c AKSClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
-	return c.MockGetKubeConfig(ctx, ac)
+func (c AKSClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster, kc v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+	return c.MockGetKubeConfig(ctx, ac, kc)
+}
+
+// GetServicePrincipalCredentials calls MockGetServicePrincipalCredentials.
+func (c AKSClient) GetServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+	return c.MockGetServicePrincipalCredentials(ctx, ac)
+}
+
+// RotateServicePrincipalSecret calls MockRotateServicePrincipalSecret.
+func (c AKSClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (string, error) {
+	return c.MockRotateServicePrincipalSecret(ctx, ac, secret)
+}
+
+// RemoveServicePrincipalCredentials calls MockRemoveServicePrincipalCredentials.
+func (c AKSClient) RemoveServicePrincipalCredentials(ctx context.Context, ac *v1alpha3.AKSCluster, keyIDs []string) error {
+	return c.MockRemoveServicePrincipalCredentials(ctx, ac, keyIDs)
+}
+
+var _ containerserviceapi.ManagedClustersClientAPI = &MockManagedClustersClient{}
+
+// MockManagedClustersClient is a fake implementation of the Azure managed
+// clusters client.
+type MockManagedClustersClient struct {
+	containerserviceapi.ManagedClustersClientAPI
+
+	MockCreateOrUpdate    func(ctx context.Context, resourceGroupName string, resourceName string, parameters containerservice.ManagedCluster) (containerservice.ManagedClustersCreateOrUpdateFuture, error)
+	MockGetUpgradeProfile func(ctx context.Context, resourceGroupName string, resourceName string) (containerservice.ManagedClusterUpgradeProfile, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockManagedClustersClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, parameters containerservice.ManagedCluster) (containerservice.ManagedClustersCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, resourceName, parameters)
+}
+
+// GetUpgradeProfile calls the underlying MockGetUpgradeProfile method.
+func (m *MockManagedClustersClient) GetUpgradeProfile(ctx context.Context, resourceGroupName string, resourceName string) (containerservice.ManagedClusterUpgradeProfile, error) {
+	return m.MockGetUpgradeProfile(ctx, resourceGroupName, resourceName)
+}
+
+var _ containerserviceapi.AgentPoolsClientAPI = &MockAgentPoolsClient{}
+
+// MockAgentPoolsClient is a fake implementation of the Azure agent pools
+// client.
+type MockAgentPoolsClient struct {
+	containerserviceapi.AgentPoolsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string, parameters containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string) (containerservice.AgentPoolsDeleteFuture, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string) (containerservice.AgentPool, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockAgentPoolsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string, parameters containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, resourceName, agentPoolName, parameters)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockAgentPoolsClient) Delete(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string) (containerservice.AgentPoolsDeleteFuture, error) {
+	return m.MockDelete(ctx, resourceGroupName, resourceName, agentPoolName)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockAgentPoolsClient) Get(ctx context.Context, resourceGroupName string, resourceName string, agentPoolName string) (containerservice.AgentPool, error) {
+	return m.MockGet(ctx, resourceGroupName, resourceName, agentPoolName)
+}
+
+var _ computeapi.VirtualMachinesClientAPI = &MockVirtualMachinesClient{}
+
+// MockVirtualMachinesClient is a fake implementation of the Azure virtual
+// machines client.
+type MockVirtualMachinesClient struct {
+	computeapi.VirtualMachinesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachine) (compute.VirtualMachinesCreateOrUpdateFuture, error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachineUpdate) (compute.VirtualMachinesUpdateFuture, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, VMName string, forceDeletion *bool) (compute.VirtualMachinesDeleteFuture, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, VMName string, expand compute.InstanceViewTypes) (compute.VirtualMachine, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockVirtualMachinesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachine) (compute.VirtualMachinesCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, VMName, parameters)
+}
+
+// Update calls the underlying MockUpdate method.
+func (m *MockVirtualMachinesClient) Update(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachineUpdate) (compute.VirtualMachinesUpdateFuture, error) {
+	return m.MockUpdate(ctx, resourceGroupName, VMName, parameters)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockVirtualMachinesClient) Delete(ctx context.Context, resourceGroupName string, VMName string, forceDeletion *bool) (compute.VirtualMachinesDeleteFuture, error) {
+	return m.MockDelete(ctx, resourceGroupName, VMName, forceDeletion)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockVirtualMachinesClient) Get(ctx context.Context, resourceGroupName string, VMName string, expand compute.InstanceViewTypes) (compute.VirtualMachine, error) {
+	return m.MockGet(ctx, resourceGroupName, VMName, expand)
+}
+
+var _ computeapi.VirtualMachineScaleSetsClientAPI = &MockVirtualMachineScaleSetsClient{}
+
+// MockVirtualMachineScaleSetsClient is a fake implementation of the Azure
+// virtual machine scale sets client.
+type MockVirtualMachineScaleSetsClient struct {
+	computeapi.VirtualMachineScaleSetsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, VMScaleSetName string, parameters compute.VirtualMachineScaleSet) (compute.VirtualMachineScaleSetsCreateOrUpdateFuture, error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, VMScaleSetName string, parameters compute.VirtualMachineScaleSetUpdate) (compute.VirtualMachineScaleSetsUpdateFuture, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, VMScaleSetName string, forceDeletion *bool) (compute.VirtualMachineScaleSetsDeleteFuture, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, VMScaleSetName string, expand compute.ExpandTypesForGetVMScaleSets) (compute.VirtualMachineScaleSet, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockVirtualMachineScaleSetsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, VMScaleSetName string, parameters compute.VirtualMachineScaleSet) (compute.VirtualMachineScaleSetsCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, VMScaleSetName, parameters)
+}
+
+// Update calls the underlying MockUpdate method.
+func (m *MockVirtualMachineScaleSetsClient) Update(ctx context.Context, resourceGroupName string, VMScaleSetName string, parameters compute.VirtualMachineScaleSetUpdate) (compute.VirtualMachineScaleSetsUpdateFuture, error) {
+	return m.MockUpdate(ctx, resourceGroupName, VMScaleSetName, parameters)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockVirtualMachineScaleSetsClient) Delete(ctx context.Context, resourceGroupName string, VMScaleSetName string, forceDeletion *bool) (compute.VirtualMachineScaleSetsDeleteFuture, error) {
+	return m.MockDelete(ctx, resourceGroupName, VMScaleSetName, forceDeletion)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockVirtualMachineScaleSetsClient) Get(ctx context.Context, resourceGroupName string, VMScaleSetName string, expand compute.ExpandTypesForGetVMScaleSets) (compute.VirtualMachineScaleSet, error) {
+	return m.MockGet(ctx, resourceGroupName, VMScaleSetName, expand)
+}
+
+var _ computeapi.DisksClientAPI = &MockDisksClient{}
+
+// MockDisksClient is a fake implementation of the Azure disks client.
+type MockDisksClient struct {
+	computeapi.DisksClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, diskName string, disk compute.Disk) (compute.DisksCreateOrUpdateFuture, error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, diskName string, disk compute.DiskUpdate) (compute.DisksUpdateFuture, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, diskName string) (compute.DisksDeleteFuture, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, diskName string) (compute.Disk, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockDisksClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, diskName string, disk compute.Disk) (compute.DisksCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, diskName, disk)
+}
+
+// Update calls the underlying MockUpdate method.
+func (m *MockDisksClient) Update(ctx context.Context, resourceGroupName string, diskName string, disk compute.DiskUpdate) (compute.DisksUpdateFuture, error) {
+	return m.MockUpdate(ctx, resourceGroupName, diskName, disk)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockDisksClient) Delete(ctx context.Context, resourceGroupName string, diskName string) (compute.DisksDeleteFuture, error) {
+	return m.MockDelete(ctx, resourceGroupName, diskName)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockDisksClient) Get(ctx context.Context, resourceGroupName string, diskName string) (compute.Disk, error) {
+	return m.MockGet(ctx, resourceGroupName, diskName)
+}
+
+var _ computeapi.SnapshotsClientAPI = &MockSnapshotsClient{}
+
+// MockSnapshotsClient is a fake implementation of the Azure snapshots client.
+type MockSnapshotsClient struct {
+	computeapi.SnapshotsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.Snapshot) (compute.SnapshotsCreateOrUpdateFuture, error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.SnapshotUpdate) (compute.SnapshotsUpdateFuture, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, snapshotName string) (compute.SnapshotsDeleteFuture, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, snapshotName string) (compute.Snapshot, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockSnapshotsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.Snapshot) (compute.SnapshotsCreateOrUpdateFuture, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, snapshotName, snapshot)
+}
+
+// Update calls the underlying MockUpdate method.
+func (m *MockSnapshotsClient) Update(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.SnapshotUpdate) (compute.SnapshotsUpdateFuture, error) {
+	return m.MockUpdate(ctx, resourceGroupName, snapshotName, snapshot)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockSnapshotsClient) Delete(ctx context.Context, resourceGroupName string, snapshotName string) (compute.SnapshotsDeleteFuture, error) {
+	return m.MockDelete(ctx, resourceGroupName, snapshotName)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockSnapshotsClient) Get(ctx context.Context, resourceGroupName string, snapshotName string) (compute.Snapshot, error) {
+	return m.MockGet(ctx, resourceGroupName, snapshotName)
 }
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice/containerserviceapi"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// An AgentPoolsClient handles CRUD operations for the node pools of AKS
+// clusters.
+type AgentPoolsClient containerserviceapi.AgentPoolsClientAPI
+
+// NewAgentPool returns agent pool creation parameters suitable for use with
+// the Azure API.
+func NewAgentPool(p v1alpha3.AKSNodePoolParameters, d azure.ResourceDefaults) containerservice.AgentPool {
+	props := &containerservice.ManagedClusterAgentPoolProfileProperties{
+		VMSize:            to.StringPtr(p.VMSize),
+		Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+		Mode:              containerservice.AgentPoolMode(nodePoolMode(p)),
+		OsType:            containerservice.OSType(azure.ToString(p.OSType)),
+		MaxPods:           azure.ToInt32(p.MaxPods),
+		AvailabilityZones: azure.ToStringArrayPtr(p.AvailabilityZones),
+		VnetSubnetID:      azure.ToStringPtr(p.VnetSubnetID),
+		NodeTaints:        azure.ToStringArrayPtr(p.NodeTaints),
+		NodeLabels:        azure.ToStringPtrMap(p.NodeLabels),
+		Tags:              azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		EnableAutoScaling: to.BoolPtr(p.Autoscaling != nil),
+		Count:             to.Int32Ptr(v1alpha3.DefaultNodeCount),
+	}
+	if p.Count != nil {
+		props.Count = azure.ToInt32Ptr(*p.Count, azure.FieldRequired)
+	}
+	if a := p.Autoscaling; a != nil {
+		props.MinCount = azure.ToInt32Ptr(a.MinCount, azure.FieldRequired)
+		props.MaxCount = azure.ToInt32Ptr(a.MaxCount, azure.FieldRequired)
+		// The initial node count must be within the bounds of the
+		// autoscaler.
+		if c := int(*props.Count); c < a.MinCount || c > a.MaxCount {
+			props.Count = props.MinCount
+		}
+	}
+	return containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: props}
+}
+
+// NewAgentPoolUpdate returns the supplied agent pool with the updatable fields
+// of the supplied node pool parameters applied to it. The node count is left
+// to the cluster autoscaler when autoscaling is enabled.
+func NewAgentPoolUpdate(p v1alpha3.AKSNodePoolParameters, ap containerservice.AgentPool, d azure.ResourceDefaults) containerservice.AgentPool {
+	props := containerservice.ManagedClusterAgentPoolProfileProperties{}
+	if ap.ManagedClusterAgentPoolProfileProperties != nil {
+		props = *ap.ManagedClusterAgentPoolProfileProperties
+	}
+	props.Mode = containerservice.AgentPoolMode(nodePoolMode(p))
+	props.NodeTaints = azure.ToStringArrayPtr(p.NodeTaints)
+	props.NodeLabels = azure.ToStringPtrMap(p.NodeLabels)
+	props.Tags = d.UpdateTags(d.MergeTags(p.Tags), props.Tags)
+	props.EnableAutoScaling = to.BoolPtr(p.Autoscaling != nil)
+	props.MinCount, props.MaxCount = nil, nil
+	switch {
+	case p.Autoscaling != nil:
+		props.MinCount = azure.ToInt32Ptr(p.Autoscaling.MinCount, azure.FieldRequired)
+		props.MaxCount = azure.ToInt32Ptr(p.Autoscaling.MaxCount, azure.FieldRequired)
+	case p.Count != nil:
+		props.Count = azure.ToInt32Ptr(*p.Count, azure.FieldRequired)
+	}
+	return containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &props}
+}
+
+// LateInitializeNodePool fills the empty fields of the supplied node pool
+// parameters with the values of the supplied agent pool.
+func LateInitializeNodePool(p *v1alpha3.AKSNodePoolParameters, ap containerservice.AgentPool, d azure.ResourceDefaults) {
+	if ap.ManagedClusterAgentPoolProfileProperties == nil {
+		return
+	}
+	p.Count = azure.LateInitializeIntPtrFromInt32Ptr(p.Count, ap.Count)
+	p.MaxPods = azure.LateInitializeIntPtrFromInt32Ptr(p.MaxPods, ap.MaxPods)
+	if p.Mode == nil && ap.Mode != "" {
+		p.Mode = to.StringPtr(string(ap.Mode))
+	}
+	if p.OSType == nil && ap.OsType != "" {
+		p.OSType = to.StringPtr(string(ap.OsType))
+	}
+	if p.VnetSubnetID == "" {
+		p.VnetSubnetID = azure.ToString(ap.VnetSubnetID)
+	}
+	p.AvailabilityZones = azure.LateInitializeStringValArrFromArrPtr(p.AvailabilityZones, ap.AvailabilityZones)
+	p.NodeTaints = azure.LateInitializeStringValArrFromArrPtr(p.NodeTaints, ap.NodeTaints)
+	if len(ap.NodeLabels) > 0 {
+		p.NodeLabels = azure.LateInitializeStringMap(p.NodeLabels, ap.NodeLabels)
+	}
+	p.Tags = d.LateInitializeTags(p.Tags, ap.Tags)
+}
+
+// IsNodePoolUpToDate returns true if the supplied agent pool is up to date with
+// the updatable fields of the supplied node pool parameters.
+func IsNodePoolUpToDate(p v1alpha3.AKSNodePoolParameters, ap containerservice.AgentPool, d azure.ResourceDefaults) bool {
+	if ap.ManagedClusterAgentPoolProfileProperties == nil {
+		return false
+	}
+	if azure.ToBool(ap.EnableAutoScaling) != (p.Autoscaling != nil) {
+		return false
+	}
+	switch {
+	case p.Autoscaling != nil:
+		if azure.ToInt(ap.MinCount) != p.Autoscaling.MinCount || azure.ToInt(ap.MaxCount) != p.Autoscaling.MaxCount {
+			return false
+		}
+	case p.Count != nil:
+		if azure.ToInt(ap.Count) != *p.Count {
+			return false
+		}
+	}
+	if string(ap.Mode) != nodePoolMode(p) {
+		return false
+	}
+	if !cmp.Equal(p.NodeTaints, azure.ToStringArray(ap.NodeTaints), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(p.NodeLabels, azure.ToStringMap(ap.NodeLabels), cmpopts.EquateEmpty()) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), ap.Tags)
+}
+
+func nodePoolMode(p v1alpha3.AKSNodePoolParameters) string {
+	if p.Mode == nil {
+		return v1alpha3.NodePoolModeUser
+	}
+	return *p.Mode
+}
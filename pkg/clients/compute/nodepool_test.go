@@ -0,0 +1,267 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const testNodePoolVMSize = "Standard_NC6s_v3"
+
+func TestNewAgentPool(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha3.AKSNodePoolParameters
+		want   containerservice.AgentPool
+	}{
+		"Defaults": {
+			reason: "Node pools should default to a single node in User mode.",
+			p:      v1alpha3.AKSNodePoolParameters{VMSize: testNodePoolVMSize},
+			want: containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				VMSize:            to.StringPtr(testNodePoolVMSize),
+				Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+				Mode:              containerservice.AgentPoolModeUser,
+				Tags:              map[string]*string{"owner": to.StringPtr("finance")},
+				EnableAutoScaling: to.BoolPtr(false),
+				Count:             to.Int32Ptr(1),
+			}},
+		},
+		"Full": {
+			reason: "All fields should be converted, starting autoscaled pools within their bounds.",
+			p: v1alpha3.AKSNodePoolParameters{
+				VMSize:            testNodePoolVMSize,
+				Count:             to.IntPtr(0),
+				Autoscaling:       &v1alpha3.AKSNodePoolAutoscaling{MinCount: 1, MaxCount: 5},
+				Mode:              to.StringPtr(v1alpha3.NodePoolModeSystem),
+				NodeTaints:        []string{"sku=gpu:NoSchedule"},
+				NodeLabels:        map[string]string{"sku": "gpu"},
+				AvailabilityZones: []string{"1", "2"},
+				OSType:            to.StringPtr("Linux"),
+				MaxPods:           to.IntPtr(30),
+				VnetSubnetID:      "cool-subnet",
+			},
+			want: containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				VMSize:            to.StringPtr(testNodePoolVMSize),
+				Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+				Mode:              containerservice.AgentPoolModeSystem,
+				OsType:            containerservice.OSTypeLinux,
+				MaxPods:           to.Int32Ptr(30),
+				AvailabilityZones: &[]string{"1", "2"},
+				VnetSubnetID:      to.StringPtr("cool-subnet"),
+				NodeTaints:        &[]string{"sku=gpu:NoSchedule"},
+				NodeLabels:        map[string]*string{"sku": to.StringPtr("gpu")},
+				Tags:              map[string]*string{"owner": to.StringPtr("finance")},
+				EnableAutoScaling: to.BoolPtr(true),
+				MinCount:          to.Int32Ptr(1),
+				MaxCount:          to.Int32Ptr(5),
+				Count:             to.Int32Ptr(1),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewAgentPool(tc.p, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nNewAgentPool(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewAgentPoolUpdate(t *testing.T) {
+	observed := containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+		VMSize:              to.StringPtr(testNodePoolVMSize),
+		OrchestratorVersion: to.StringPtr("1.21.7"),
+		Count:               to.Int32Ptr(4),
+		Mode:                containerservice.AgentPoolModeUser,
+		Tags:                map[string]*string{"policy": to.StringPtr("yes")},
+	}}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha3.AKSNodePoolParameters
+		want   containerservice.AgentPool
+	}{
+		"Scale": {
+			reason: "The node count should be updated, retaining unmanaged tags and read-only fields.",
+			p:      v1alpha3.AKSNodePoolParameters{Count: to.IntPtr(2), Tags: map[string]string{"a": "b"}},
+			want: containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				VMSize:              to.StringPtr(testNodePoolVMSize),
+				OrchestratorVersion: to.StringPtr("1.21.7"),
+				Count:               to.Int32Ptr(2),
+				Mode:                containerservice.AgentPoolModeUser,
+				Tags:                map[string]*string{"policy": to.StringPtr("yes"), "a": to.StringPtr("b")},
+				EnableAutoScaling:   to.BoolPtr(false),
+			}},
+		},
+		"Autoscale": {
+			reason: "The node count should be left to the autoscaler when autoscaling is enabled.",
+			p: v1alpha3.AKSNodePoolParameters{
+				Count:       to.IntPtr(2),
+				Autoscaling: &v1alpha3.AKSNodePoolAutoscaling{MinCount: 1, MaxCount: 5},
+			},
+			want: containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				VMSize:              to.StringPtr(testNodePoolVMSize),
+				OrchestratorVersion: to.StringPtr("1.21.7"),
+				Count:               to.Int32Ptr(4),
+				Mode:                containerservice.AgentPoolModeUser,
+				Tags:                map[string]*string{"policy": to.StringPtr("yes")},
+				EnableAutoScaling:   to.BoolPtr(true),
+				MinCount:            to.Int32Ptr(1),
+				MaxCount:            to.Int32Ptr(5),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewAgentPoolUpdate(tc.p, observed, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nNewAgentPoolUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeNodePool(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+	ap := containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+		Count:             to.Int32Ptr(3),
+		MaxPods:           to.Int32Ptr(110),
+		Mode:              containerservice.AgentPoolModeSystem,
+		OsType:            containerservice.OSTypeLinux,
+		VnetSubnetID:      to.StringPtr("cool-subnet"),
+		AvailabilityZones: &[]string{"1"},
+		NodeLabels:        map[string]*string{},
+		Tags:              map[string]*string{"owner": to.StringPtr("finance")},
+	}}
+
+	cases := map[string]struct {
+		reason string
+		p      *v1alpha3.AKSNodePoolParameters
+		want   *v1alpha3.AKSNodePoolParameters
+	}{
+		"Empty": {
+			reason: "Empty fields should be late initialized, except for default tags and empty labels.",
+			p:      &v1alpha3.AKSNodePoolParameters{},
+			want: &v1alpha3.AKSNodePoolParameters{
+				Count:             to.IntPtr(3),
+				MaxPods:           to.IntPtr(110),
+				Mode:              to.StringPtr(v1alpha3.NodePoolModeSystem),
+				OSType:            to.StringPtr("Linux"),
+				VnetSubnetID:      "cool-subnet",
+				AvailabilityZones: []string{"1"},
+			},
+		},
+		"AlreadySet": {
+			reason: "Fields that are already set should not be late initialized.",
+			p:      &v1alpha3.AKSNodePoolParameters{Count: to.IntPtr(1), Mode: to.StringPtr(v1alpha3.NodePoolModeUser)},
+			want: &v1alpha3.AKSNodePoolParameters{
+				Count:             to.IntPtr(1),
+				MaxPods:           to.IntPtr(110),
+				Mode:              to.StringPtr(v1alpha3.NodePoolModeUser),
+				OSType:            to.StringPtr("Linux"),
+				VnetSubnetID:      "cool-subnet",
+				AvailabilityZones: []string{"1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeNodePool(tc.p, ap, d)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitializeNodePool(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsNodePoolUpToDate(t *testing.T) {
+	ap := containerservice.AgentPool{ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+		Count:             to.Int32Ptr(3),
+		Mode:              containerservice.AgentPoolModeUser,
+		EnableAutoScaling: to.BoolPtr(true),
+		MinCount:          to.Int32Ptr(1),
+		MaxCount:          to.Int32Ptr(5),
+		NodeTaints:        &[]string{"sku=gpu:NoSchedule"},
+	}}
+	p := func(f func(p *v1alpha3.AKSNodePoolParameters)) v1alpha3.AKSNodePoolParameters {
+		p := v1alpha3.AKSNodePoolParameters{
+			Count:       to.IntPtr(1),
+			Autoscaling: &v1alpha3.AKSNodePoolAutoscaling{MinCount: 1, MaxCount: 5},
+			NodeTaints:  []string{"sku=gpu:NoSchedule"},
+		}
+		if f != nil {
+			f(&p)
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.AKSNodePoolParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    p(nil),
+			want: true,
+		},
+		"AutoscalingDisabled": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.Autoscaling = nil }),
+			want: false,
+		},
+		"AutoscalingBoundsChanged": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.Autoscaling.MaxCount = 10 }),
+			want: false,
+		},
+		"ModeChanged": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.Mode = to.StringPtr(v1alpha3.NodePoolModeSystem) }),
+			want: false,
+		},
+		"TaintsChanged": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.NodeTaints = nil }),
+			want: false,
+		},
+		"LabelsChanged": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.NodeLabels = map[string]string{"a": "b"} }),
+			want: false,
+		},
+		"TagsChanged": {
+			p:    p(func(p *v1alpha3.AKSNodePoolParameters) { p.Tags = map[string]string{"a": "b"} }),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNodePoolUpToDate(tc.p, ap, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNodePoolUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
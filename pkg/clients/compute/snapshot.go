@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"strings"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// A SnapshotsClient handles CRUD operations for Azure disk snapshots.
+type SnapshotsClient computeapi.SnapshotsClientAPI
+
+// NewSnapshot returns snapshot creation parameters suitable for use with the
+// Azure API.
+func NewSnapshot(p v1alpha3.SnapshotParameters, d azure.ResourceDefaults) computemgmt.Snapshot {
+	s := computemgmt.Snapshot{
+		Location: to.StringPtr(d.LocationOr(p.Location)),
+		SnapshotProperties: &computemgmt.SnapshotProperties{
+			CreationData: newSnapshotCreationData(p.Source),
+			Incremental:  p.Incremental,
+			Encryption:   newEncryption(p.DiskEncryptionSetID, nil),
+		},
+		Tags: azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+	if p.SKU != nil {
+		s.Sku = &computemgmt.SnapshotSku{Name: computemgmt.SnapshotStorageAccountTypes(*p.SKU)}
+	}
+	return s
+}
+
+// NewSnapshotUpdate returns an update of the supplied snapshot that applies
+// the updatable fields of the supplied parameters.
+func NewSnapshotUpdate(p v1alpha3.SnapshotParameters, s computemgmt.Snapshot, d azure.ResourceDefaults) computemgmt.SnapshotUpdate {
+	var observed *computemgmt.Encryption
+	if s.SnapshotProperties != nil {
+		observed = s.Encryption
+	}
+	u := computemgmt.SnapshotUpdate{
+		SnapshotUpdateProperties: &computemgmt.SnapshotUpdateProperties{
+			Encryption: newEncryption(p.DiskEncryptionSetID, observed),
+		},
+		Tags: d.UpdateTags(d.MergeTags(p.Tags), s.Tags),
+	}
+	if p.SKU != nil {
+		u.Sku = &computemgmt.SnapshotSku{Name: computemgmt.SnapshotStorageAccountTypes(*p.SKU)}
+	}
+	return u
+}
+
+// LateInitializeSnapshot fills the empty fields of the supplied parameters with
+// the values of the supplied snapshot.
+func LateInitializeSnapshot(p *v1alpha3.SnapshotParameters, s computemgmt.Snapshot, d azure.ResourceDefaults) {
+	if p.SKU == nil && s.Sku != nil && s.Sku.Name != "" {
+		p.SKU = to.StringPtr(string(s.Sku.Name))
+	}
+	p.Tags = d.LateInitializeTags(p.Tags, s.Tags)
+	if s.SnapshotProperties == nil {
+		return
+	}
+	p.Incremental = azure.LateInitializeBoolPtrFromPtr(p.Incremental, s.Incremental)
+}
+
+// IsSnapshotUpToDate returns true if the supplied snapshot is up to date with
+// the updatable fields of the supplied parameters.
+func IsSnapshotUpToDate(p v1alpha3.SnapshotParameters, s computemgmt.Snapshot, d azure.ResourceDefaults) bool {
+	if s.SnapshotProperties == nil {
+		return false
+	}
+	if p.SKU != nil && (s.Sku == nil || !strings.EqualFold(string(s.Sku.Name), *p.SKU)) {
+		return false
+	}
+	if !isEncryptionUpToDate(p.DiskEncryptionSetID, s.Encryption) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), s.Tags)
+}
+
+func newSnapshotCreationData(s v1alpha3.SnapshotSource) *computemgmt.CreationData {
+	if s.BlobURI != nil {
+		return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionImport, SourceURI: s.BlobURI, StorageAccountID: s.StorageAccountID}
+	}
+	return &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionCopy, SourceResourceID: s.DiskID}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const testDiskID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/cool-disk"
+
+func TestNewSnapshot(t *testing.T) {
+	d := azure.ResourceDefaults{Location: "westeurope"}
+
+	cases := map[string]struct {
+		p    v1alpha3.SnapshotParameters
+		want computemgmt.Snapshot
+	}{
+		"FromDisk": {
+			p: v1alpha3.SnapshotParameters{
+				SKU:         to.StringPtr("Standard_ZRS"),
+				Incremental: to.BoolPtr(true),
+				Source:      v1alpha3.SnapshotSource{DiskID: to.StringPtr(testDiskID)},
+			},
+			want: computemgmt.Snapshot{
+				Location: to.StringPtr("westeurope"),
+				Sku:      &computemgmt.SnapshotSku{Name: computemgmt.SnapshotStorageAccountTypesStandardZRS},
+				SnapshotProperties: &computemgmt.SnapshotProperties{
+					CreationData: &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionCopy, SourceResourceID: to.StringPtr(testDiskID)},
+					Incremental:  to.BoolPtr(true),
+				},
+			},
+		},
+		"FromBlob": {
+			p: v1alpha3.SnapshotParameters{
+				Source:              v1alpha3.SnapshotSource{BlobURI: to.StringPtr("https://cool.blob.core.windows.net/vhds/cool.vhd")},
+				DiskEncryptionSetID: to.StringPtr(testEncryptionSetID),
+			},
+			want: computemgmt.Snapshot{
+				Location: to.StringPtr("westeurope"),
+				SnapshotProperties: &computemgmt.SnapshotProperties{
+					CreationData: &computemgmt.CreationData{CreateOption: computemgmt.DiskCreateOptionImport, SourceURI: to.StringPtr("https://cool.blob.core.windows.net/vhds/cool.vhd")},
+					Encryption:   &computemgmt.Encryption{DiskEncryptionSetID: to.StringPtr(testEncryptionSetID), Type: computemgmt.EncryptionTypeEncryptionAtRestWithCustomerKey},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewSnapshot(tc.p, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewSnapshot(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnapshotUpToDate(t *testing.T) {
+	s := computemgmt.Snapshot{
+		Sku:                &computemgmt.SnapshotSku{Name: computemgmt.SnapshotStorageAccountTypesStandardLRS},
+		SnapshotProperties: &computemgmt.SnapshotProperties{},
+		Tags:               map[string]*string{"a": to.StringPtr("b")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.SnapshotParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha3.SnapshotParameters{SKU: to.StringPtr("Standard_LRS"), Tags: map[string]string{"a": "b"}},
+			want: true,
+		},
+		"SKU": {
+			p:    v1alpha3.SnapshotParameters{SKU: to.StringPtr("Premium_LRS")},
+			want: false,
+		},
+		"CustomerKey": {
+			p:    v1alpha3.SnapshotParameters{DiskEncryptionSetID: to.StringPtr(testEncryptionSetID)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSnapshotUpToDate(tc.p, s, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSnapshotUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
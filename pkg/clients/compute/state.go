@@ -0,0 +1,29 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+// ProvisioningStateSucceeded is the provisioning state of compute resources
+// that are not being created, updated or deleted.
+const ProvisioningStateSucceeded = "Succeeded"
+
+// IsUpdatable returns true if a compute resource in the supplied provisioning
+// state accepts updates. Azure rejects updates to resources that are being
+// created, updated, scaled or upgraded, so controllers consider such resources
+// up to date until they're done.
+func IsUpdatable(state string) bool {
+	return state == ProvisioningStateSucceeded
+}
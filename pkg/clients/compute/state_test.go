@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsUpdatable(t *testing.T) {
+	cases := map[string]struct {
+		state string
+		want  bool
+	}{
+		"Succeeded": {state: ProvisioningStateSucceeded, want: true},
+		"Updating":  {state: "Updating", want: false},
+		"Unknown":   {state: "", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpdatable(tc.state)); diff != "" {
+				t.Errorf("IsUpdatable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// sshKeyPathFmt is the path of the authorized keys of the admin user of a
+// Linux virtual machine.
+const sshKeyPathFmt = "/home/%s/.ssh/authorized_keys"
+
+// Error strings.
+const (
+	errGetSSHPublicKey      = "cannot get SSH public key"
+	errFmtEmptySSHPublicKey = "SSH public key %s/%s[%s] is empty"
+	errGetCustomData        = "cannot get custom data"
+)
+
+// A VirtualMachinesClient handles CRUD operations for Azure virtual machines.
+type VirtualMachinesClient computeapi.VirtualMachinesClientAPI
+
+// LinuxSecrets are the values of the Secrets referenced by the OS profile of a
+// Linux virtual machine or scale set.
+type LinuxSecrets struct {
+	// SSHPublicKeys that are authorized to log in as the admin user.
+	SSHPublicKeys []string
+
+	// CustomData that is passed to the virtual machine, e.g. a cloud-init
+	// configuration.
+	CustomData []byte
+}
+
+// GetLinuxSecrets returns the values of the supplied Secret keys.
+func GetLinuxSecrets(ctx context.Context, kube client.Client, keys []v1alpha3.SSHPublicKey, customData *xpv1.SecretKeySelector) (LinuxSecrets, error) {
+	s := LinuxSecrets{SSHPublicKeys: make([]string, len(keys))}
+	for i, k := range keys {
+		ref := k.SecretRef
+		v, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: &ref})
+		if err != nil {
+			return LinuxSecrets{}, errors.Wrap(err, errGetSSHPublicKey)
+		}
+		if len(v) == 0 {
+			return LinuxSecrets{}, errors.Errorf(errFmtEmptySSHPublicKey, ref.Namespace, ref.Name, ref.Key)
+		}
+		s.SSHPublicKeys[i] = string(v)
+	}
+	if customData == nil {
+		return s, nil
+	}
+	v, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: customData})
+	if err != nil {
+		return LinuxSecrets{}, errors.Wrap(err, errGetCustomData)
+	}
+	s.CustomData = v
+	return s, nil
+}
+
+// NewVirtualMachine returns virtual machine creation parameters suitable for
+// use with the Azure API.
+func NewVirtualMachine(name string, p v1alpha3.VirtualMachineParameters, s LinuxSecrets, d azure.ResourceDefaults) computemgmt.VirtualMachine {
+	nics := make([]computemgmt.NetworkInterfaceReference, len(p.NetworkInterfaceIDs))
+	for i, id := range p.NetworkInterfaceIDs {
+		nics[i] = computemgmt.NetworkInterfaceReference{
+			ID:                                  to.StringPtr(id),
+			NetworkInterfaceReferenceProperties: &computemgmt.NetworkInterfaceReferenceProperties{Primary: to.BoolPtr(i == 0)},
+		}
+	}
+	osDisk := &computemgmt.OSDisk{
+		CreateOption: computemgmt.DiskCreateOptionTypesFromImage,
+		// The OS disk belongs to the virtual machine, so it should not
+		// outlive it.
+		DeleteOption: computemgmt.DiskDeleteOptionTypesDelete,
+	}
+	if o := p.OSDisk; o != nil {
+		osDisk.DiskSizeGB = azure.ToInt32(o.DiskSizeGB)
+		osDisk.Caching = computemgmt.CachingTypes(azure.ToString(o.Caching))
+		if o.StorageAccountType != nil {
+			osDisk.ManagedDisk = &computemgmt.ManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypes(*o.StorageAccountType)}
+		}
+	}
+	return computemgmt.VirtualMachine{
+		Location: to.StringPtr(d.LocationOr(p.Location)),
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			HardwareProfile: &computemgmt.HardwareProfile{VMSize: computemgmt.VirtualMachineSizeTypes(p.Size)},
+			StorageProfile: &computemgmt.StorageProfile{
+				ImageReference: newImageReference(p.Image),
+				OsDisk:         osDisk,
+				DataDisks:      newDataDisks(p.DataDisks, nil),
+			},
+			OsProfile: &computemgmt.OSProfile{
+				ComputerName:       to.StringPtr(name),
+				AdminUsername:      to.StringPtr(p.AdminUsername),
+				CustomData:         customData(s.CustomData),
+				LinuxConfiguration: newLinuxConfiguration(p.AdminUsername, s.SSHPublicKeys),
+			},
+			NetworkProfile: &computemgmt.NetworkProfile{NetworkInterfaces: &nics},
+		},
+		Identity: newVirtualMachineIdentity(p.Identity),
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+}
+
+// NewVirtualMachineUpdate returns an update of the supplied virtual machine
+// that applies the updatable fields of the supplied parameters. Data disks that
+// are no longer desired are detached.
+func NewVirtualMachineUpdate(p v1alpha3.VirtualMachineParameters, vm computemgmt.VirtualMachine, d azure.ResourceDefaults) computemgmt.VirtualMachineUpdate {
+	var observed *[]computemgmt.DataDisk
+	if vm.VirtualMachineProperties != nil && vm.StorageProfile != nil {
+		observed = vm.StorageProfile.DataDisks
+	}
+	disks := newDataDisks(p.DataDisks, observed)
+	if disks == nil {
+		// An empty list detaches all data disks, while a nil list leaves
+		// them alone.
+		disks = &[]computemgmt.DataDisk{}
+	}
+	id := newVirtualMachineIdentity(p.Identity)
+	if id == nil && vm.Identity != nil {
+		id = &computemgmt.VirtualMachineIdentity{Type: computemgmt.ResourceIdentityTypeNone}
+	}
+	return computemgmt.VirtualMachineUpdate{
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			HardwareProfile: &computemgmt.HardwareProfile{VMSize: computemgmt.VirtualMachineSizeTypes(p.Size)},
+			StorageProfile:  &computemgmt.StorageProfile{DataDisks: disks},
+		},
+		Identity: id,
+		Tags:     d.UpdateTags(d.MergeTags(p.Tags), vm.Tags),
+	}
+}
+
+// LateInitializeVirtualMachine fills the empty fields of the supplied
+// parameters with the values of the supplied virtual machine.
+func LateInitializeVirtualMachine(p *v1alpha3.VirtualMachineParameters, vm computemgmt.VirtualMachine, d azure.ResourceDefaults) {
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, vm.Zones)
+	p.Tags = d.LateInitializeTags(p.Tags, vm.Tags)
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil {
+		return
+	}
+	if o := vm.StorageProfile.OsDisk; o != nil {
+		var sat string
+		if o.ManagedDisk != nil {
+			sat = string(o.ManagedDisk.StorageAccountType)
+		}
+		p.OSDisk = lateInitializeOSDisk(p.OSDisk, o.DiskSizeGB, string(o.Caching), sat)
+	}
+	if vm.StorageProfile.DataDisks == nil {
+		return
+	}
+	for i := range p.DataDisks {
+		for _, o := range *vm.StorageProfile.DataDisks {
+			if azure.ToInt(o.Lun) != p.DataDisks[i].Lun {
+				continue
+			}
+			var sat string
+			if o.ManagedDisk != nil {
+				sat = string(o.ManagedDisk.StorageAccountType)
+			}
+			lateInitializeDataDisk(&p.DataDisks[i], string(o.Caching), sat)
+		}
+	}
+}
+
+// IsVirtualMachineUpToDate returns true if the supplied virtual machine is up
+// to date with the updatable fields of the supplied parameters.
+func IsVirtualMachineUpToDate(p v1alpha3.VirtualMachineParameters, vm computemgmt.VirtualMachine, d azure.ResourceDefaults) bool {
+	if vm.VirtualMachineProperties == nil {
+		return false
+	}
+	if vm.HardwareProfile == nil || !strings.EqualFold(string(vm.HardwareProfile.VMSize), p.Size) {
+		return false
+	}
+	var disks []computemgmt.DataDisk
+	if vm.StorageProfile != nil && vm.StorageProfile.DataDisks != nil {
+		disks = *vm.StorageProfile.DataDisks
+	}
+	if len(disks) != len(p.DataDisks) {
+		return false
+	}
+	for _, dd := range p.DataDisks {
+		if !isDataDiskUpToDate(dd, disks) {
+			return false
+		}
+	}
+	var idType string
+	var ids []string
+	if vm.Identity != nil {
+		idType = string(vm.Identity.Type)
+		for k := range vm.Identity.UserAssignedIdentities {
+			ids = append(ids, k)
+		}
+	}
+	if !isIdentityUpToDate(p.Identity, idType, ids) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), vm.Tags)
+}
+
+// VirtualMachinePrincipalID returns the principal ID of the system-assigned
+// identity of the supplied virtual machine, if any.
+func VirtualMachinePrincipalID(vm computemgmt.VirtualMachine) string {
+	if vm.Identity == nil {
+		return ""
+	}
+	return azure.ToString(vm.Identity.PrincipalID)
+}
+
+func newImageReference(i v1alpha3.ImageReference) *computemgmt.ImageReference {
+	if i.ID != nil {
+		return &computemgmt.ImageReference{ID: i.ID}
+	}
+	v := "latest"
+	if i.Version != nil {
+		v = *i.Version
+	}
+	return &computemgmt.ImageReference{Publisher: i.Publisher, Offer: i.Offer, Sku: i.SKU, Version: to.StringPtr(v)}
+}
+
+// newDataDisks returns the supplied data disks. Disks that are already
+// attached keep their managed disk, and new disks are created empty.
+func newDataDisks(disks []v1alpha3.DataDisk, observed *[]computemgmt.DataDisk) *[]computemgmt.DataDisk {
+	if len(disks) == 0 {
+		return nil
+	}
+	attached := map[int]computemgmt.DataDisk{}
+	if observed != nil {
+		for _, o := range *observed {
+			attached[azure.ToInt(o.Lun)] = o
+		}
+	}
+	dd := make([]computemgmt.DataDisk, len(disks))
+	for i, d := range disks {
+		if o, ok := attached[d.Lun]; ok {
+			o.DiskSizeGB = azure.ToInt32Ptr(d.DiskSizeGB, azure.FieldRequired)
+			if d.Caching != nil {
+				o.Caching = computemgmt.CachingTypes(*d.Caching)
+			}
+			dd[i] = o
+			continue
+		}
+		dd[i] = computemgmt.DataDisk{
+			Lun:          azure.ToInt32Ptr(d.Lun, azure.FieldRequired),
+			DiskSizeGB:   azure.ToInt32Ptr(d.DiskSizeGB, azure.FieldRequired),
+			Caching:      computemgmt.CachingTypes(azure.ToString(d.Caching)),
+			CreateOption: computemgmt.DiskCreateOptionTypesEmpty,
+			DeleteOption: computemgmt.DiskDeleteOptionTypesDelete,
+		}
+		if d.StorageAccountType != nil {
+			dd[i].ManagedDisk = &computemgmt.ManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypes(*d.StorageAccountType)}
+		}
+	}
+	return &dd
+}
+
+func isDataDiskUpToDate(d v1alpha3.DataDisk, observed []computemgmt.DataDisk) bool {
+	for _, o := range observed {
+		if azure.ToInt(o.Lun) != d.Lun {
+			continue
+		}
+		if azure.ToInt(o.DiskSizeGB) != d.DiskSizeGB {
+			return false
+		}
+		return d.Caching == nil || strings.EqualFold(string(o.Caching), *d.Caching)
+	}
+	return false
+}
+
+func lateInitializeOSDisk(in *v1alpha3.OSDisk, size *int32, caching, storageAccountType string) *v1alpha3.OSDisk {
+	if in == nil {
+		in = &v1alpha3.OSDisk{}
+	}
+	in.DiskSizeGB = azure.LateInitializeIntPtrFromInt32Ptr(in.DiskSizeGB, size)
+	if in.Caching == nil && caching != "" {
+		in.Caching = to.StringPtr(caching)
+	}
+	if in.StorageAccountType == nil && storageAccountType != "" {
+		in.StorageAccountType = to.StringPtr(storageAccountType)
+	}
+	if cmp.Equal(in, &v1alpha3.OSDisk{}) {
+		return nil
+	}
+	return in
+}
+
+func lateInitializeDataDisk(in *v1alpha3.DataDisk, caching, storageAccountType string) {
+	if in.Caching == nil && caching != "" {
+		in.Caching = to.StringPtr(caching)
+	}
+	if in.StorageAccountType == nil && storageAccountType != "" {
+		in.StorageAccountType = to.StringPtr(storageAccountType)
+	}
+}
+
+func newLinuxConfiguration(user string, keys []string) *computemgmt.LinuxConfiguration {
+	pk := make([]computemgmt.SSHPublicKey, len(keys))
+	for i, k := range keys {
+		pk[i] = computemgmt.SSHPublicKey{
+			Path:    to.StringPtr(fmt.Sprintf(sshKeyPathFmt, user)),
+			KeyData: to.StringPtr(strings.TrimSpace(k)),
+		}
+	}
+	return &computemgmt.LinuxConfiguration{
+		DisablePasswordAuthentication: to.BoolPtr(true),
+		SSH:                           &computemgmt.SSHConfiguration{PublicKeys: &pk},
+	}
+}
+
+func customData(data []byte) *string {
+	if len(data) == 0 {
+		return nil
+	}
+	return to.StringPtr(base64.StdEncoding.EncodeToString(data))
+}
+
+func newVirtualMachineIdentity(id *v1alpha3.VirtualMachineIdentity) *computemgmt.VirtualMachineIdentity {
+	if id == nil {
+		return nil
+	}
+	vid := &computemgmt.VirtualMachineIdentity{Type: computemgmt.ResourceIdentityType(id.Type)}
+	if id.Type == v1alpha3.IdentityTypeUserAssigned {
+		vid.UserAssignedIdentities = map[string]*computemgmt.VirtualMachineIdentityUserAssignedIdentitiesValue{
+			id.UserAssignedIdentityID: {},
+		}
+	}
+	return vid
+}
+
+// isIdentityUpToDate returns true if the supplied observed identity type and
+// user-assigned identity IDs match the desired identity. Azure may change the
+// case of resource IDs, so they are compared case-insensitively.
+func isIdentityUpToDate(id *v1alpha3.VirtualMachineIdentity, observedType string, observedIDs []string) bool {
+	if id == nil {
+		return observedType == "" || observedType == string(computemgmt.ResourceIdentityTypeNone)
+	}
+	if observedType != id.Type {
+		return false
+	}
+	if id.Type != v1alpha3.IdentityTypeUserAssigned {
+		return true
+	}
+	return len(observedIDs) == 1 && strings.EqualFold(observedIDs[0], id.UserAssignedIdentityID)
+}
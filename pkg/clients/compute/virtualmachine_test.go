@@ -0,0 +1,354 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	testVMName = "cool-vm"
+	testNIC    = "/subscriptions/cool/resourceGroups/cool-rg/providers/Microsoft.Network/networkInterfaces/cool-nic"
+	testSSHKey = "ssh-ed25519 AAAA cool@example.org"
+	testAdmin  = "cool"
+	testKeyDir = "/home/cool/.ssh/authorized_keys"
+)
+
+func TestNewVirtualMachine(t *testing.T) {
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}, Location: "westeurope"}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha3.VirtualMachineParameters
+		s      LinuxSecrets
+		want   computemgmt.VirtualMachine
+	}{
+		"Full": {
+			reason: "All fields should be converted, using the latest image version and deleting the disks along with the virtual machine.",
+			p: v1alpha3.VirtualMachineParameters{
+				Size:                testVMSize,
+				Image:               v1alpha3.ImageReference{Publisher: to.StringPtr("Canonical"), Offer: to.StringPtr("UbuntuServer"), SKU: to.StringPtr("18.04-LTS")},
+				OSDisk:              &v1alpha3.OSDisk{StorageAccountType: to.StringPtr("Premium_LRS"), DiskSizeGB: to.IntPtr(64)},
+				DataDisks:           []v1alpha3.DataDisk{{Lun: 0, DiskSizeGB: 128}},
+				NetworkInterfaceIDs: []string{testNIC},
+				AdminUsername:       testAdmin,
+				Identity:            &v1alpha3.VirtualMachineIdentity{Type: v1alpha3.IdentityTypeUserAssigned, UserAssignedIdentityID: testMSI},
+				Zones:               []string{"1"},
+			},
+			s: LinuxSecrets{SSHPublicKeys: []string{testSSHKey + "\n"}, CustomData: []byte("#cloud-config")},
+			want: computemgmt.VirtualMachine{
+				Location: to.StringPtr("westeurope"),
+				VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+					HardwareProfile: &computemgmt.HardwareProfile{VMSize: testVMSize},
+					StorageProfile: &computemgmt.StorageProfile{
+						ImageReference: &computemgmt.ImageReference{
+							Publisher: to.StringPtr("Canonical"),
+							Offer:     to.StringPtr("UbuntuServer"),
+							Sku:       to.StringPtr("18.04-LTS"),
+							Version:   to.StringPtr("latest"),
+						},
+						OsDisk: &computemgmt.OSDisk{
+							CreateOption: computemgmt.DiskCreateOptionTypesFromImage,
+							DeleteOption: computemgmt.DiskDeleteOptionTypesDelete,
+							DiskSizeGB:   to.Int32Ptr(64),
+							ManagedDisk:  &computemgmt.ManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypesPremiumLRS},
+						},
+						DataDisks: &[]computemgmt.DataDisk{{
+							Lun:          to.Int32Ptr(0),
+							DiskSizeGB:   to.Int32Ptr(128),
+							CreateOption: computemgmt.DiskCreateOptionTypesEmpty,
+							DeleteOption: computemgmt.DiskDeleteOptionTypesDelete,
+						}},
+					},
+					OsProfile: &computemgmt.OSProfile{
+						ComputerName:  to.StringPtr(testVMName),
+						AdminUsername: to.StringPtr(testAdmin),
+						CustomData:    to.StringPtr("I2Nsb3VkLWNvbmZpZw=="),
+						LinuxConfiguration: &computemgmt.LinuxConfiguration{
+							DisablePasswordAuthentication: to.BoolPtr(true),
+							SSH: &computemgmt.SSHConfiguration{PublicKeys: &[]computemgmt.SSHPublicKey{{
+								Path:    to.StringPtr(testKeyDir),
+								KeyData: to.StringPtr(testSSHKey),
+							}}},
+						},
+					},
+					NetworkProfile: &computemgmt.NetworkProfile{NetworkInterfaces: &[]computemgmt.NetworkInterfaceReference{{
+						ID:                                  to.StringPtr(testNIC),
+						NetworkInterfaceReferenceProperties: &computemgmt.NetworkInterfaceReferenceProperties{Primary: to.BoolPtr(true)},
+					}}},
+				},
+				Identity: &computemgmt.VirtualMachineIdentity{
+					Type:                   computemgmt.ResourceIdentityTypeUserAssigned,
+					UserAssignedIdentities: map[string]*computemgmt.VirtualMachineIdentityUserAssignedIdentitiesValue{testMSI: {}},
+				},
+				Zones: &[]string{"1"},
+				Tags:  map[string]*string{"owner": to.StringPtr("finance")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewVirtualMachine(testVMName, tc.p, tc.s, d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nNewVirtualMachine(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewVirtualMachineUpdate(t *testing.T) {
+	attached := computemgmt.DataDisk{
+		Lun:          to.Int32Ptr(0),
+		DiskSizeGB:   to.Int32Ptr(128),
+		CreateOption: computemgmt.DiskCreateOptionTypesEmpty,
+		ManagedDisk:  &computemgmt.ManagedDiskParameters{ID: to.StringPtr("cool-disk")},
+	}
+	observed := computemgmt.VirtualMachine{
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			HardwareProfile: &computemgmt.HardwareProfile{VMSize: testVMSize},
+			StorageProfile:  &computemgmt.StorageProfile{DataDisks: &[]computemgmt.DataDisk{attached}},
+		},
+		Identity: &computemgmt.VirtualMachineIdentity{Type: computemgmt.ResourceIdentityTypeSystemAssigned},
+		Tags:     map[string]*string{"policy": to.StringPtr("yes")},
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha3.VirtualMachineParameters
+		want   computemgmt.VirtualMachineUpdate
+	}{
+		"AttachAndResize": {
+			reason: "Attached disks should be kept and new disks created, retaining unmanaged tags.",
+			p: v1alpha3.VirtualMachineParameters{
+				Size:      "Standard_D4s_v3",
+				DataDisks: []v1alpha3.DataDisk{{Lun: 0, DiskSizeGB: 128}, {Lun: 1, DiskSizeGB: 64}},
+				Identity:  &v1alpha3.VirtualMachineIdentity{Type: v1alpha3.IdentityTypeSystemAssigned},
+				Tags:      map[string]string{"a": "b"},
+			},
+			want: computemgmt.VirtualMachineUpdate{
+				VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+					HardwareProfile: &computemgmt.HardwareProfile{VMSize: "Standard_D4s_v3"},
+					StorageProfile: &computemgmt.StorageProfile{DataDisks: &[]computemgmt.DataDisk{attached, {
+						Lun:          to.Int32Ptr(1),
+						DiskSizeGB:   to.Int32Ptr(64),
+						CreateOption: computemgmt.DiskCreateOptionTypesEmpty,
+						DeleteOption: computemgmt.DiskDeleteOptionTypesDelete,
+					}}},
+				},
+				Identity: &computemgmt.VirtualMachineIdentity{Type: computemgmt.ResourceIdentityTypeSystemAssigned},
+				Tags:     map[string]*string{"policy": to.StringPtr("yes"), "a": to.StringPtr("b")},
+			},
+		},
+		"DetachAll": {
+			reason: "Disks and identities that are no longer desired should be removed.",
+			p:      v1alpha3.VirtualMachineParameters{Size: testVMSize},
+			want: computemgmt.VirtualMachineUpdate{
+				VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+					HardwareProfile: &computemgmt.HardwareProfile{VMSize: testVMSize},
+					StorageProfile:  &computemgmt.StorageProfile{DataDisks: &[]computemgmt.DataDisk{}},
+				},
+				Identity: &computemgmt.VirtualMachineIdentity{Type: computemgmt.ResourceIdentityTypeNone},
+				Tags:     map[string]*string{"policy": to.StringPtr("yes")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewVirtualMachineUpdate(tc.p, observed, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nNewVirtualMachineUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVirtualMachine(t *testing.T) {
+	vm := computemgmt.VirtualMachine{
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			StorageProfile: &computemgmt.StorageProfile{
+				OsDisk: &computemgmt.OSDisk{
+					DiskSizeGB:  to.Int32Ptr(30),
+					Caching:     computemgmt.CachingTypesReadWrite,
+					ManagedDisk: &computemgmt.ManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypesStandardLRS},
+				},
+				DataDisks: &[]computemgmt.DataDisk{{
+					Lun:         to.Int32Ptr(0),
+					Caching:     computemgmt.CachingTypesNone,
+					ManagedDisk: &computemgmt.ManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypesStandardLRS},
+				}},
+			},
+		},
+		Zones: &[]string{"1"},
+		Tags:  map[string]*string{"owner": to.StringPtr("finance"), "a": to.StringPtr("b")},
+	}
+	p := v1alpha3.VirtualMachineParameters{DataDisks: []v1alpha3.DataDisk{{Lun: 0, DiskSizeGB: 64}}}
+	want := v1alpha3.VirtualMachineParameters{
+		OSDisk: &v1alpha3.OSDisk{
+			StorageAccountType: to.StringPtr("Standard_LRS"),
+			DiskSizeGB:         to.IntPtr(30),
+			Caching:            to.StringPtr("ReadWrite"),
+		},
+		DataDisks: []v1alpha3.DataDisk{{Lun: 0, DiskSizeGB: 64, StorageAccountType: to.StringPtr("Standard_LRS"), Caching: to.StringPtr("None")}},
+		Zones:     []string{"1"},
+		Tags:      map[string]string{"a": "b"},
+	}
+
+	LateInitializeVirtualMachine(&p, vm, azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeVirtualMachine(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsVirtualMachineUpToDate(t *testing.T) {
+	vm := computemgmt.VirtualMachine{
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			HardwareProfile: &computemgmt.HardwareProfile{VMSize: "standard_b2s"},
+			StorageProfile: &computemgmt.StorageProfile{DataDisks: &[]computemgmt.DataDisk{{
+				Lun:        to.Int32Ptr(0),
+				DiskSizeGB: to.Int32Ptr(128),
+				Caching:    computemgmt.CachingTypesNone,
+			}}},
+		},
+		Identity: &computemgmt.VirtualMachineIdentity{
+			Type:                   computemgmt.ResourceIdentityTypeUserAssigned,
+			UserAssignedIdentities: map[string]*computemgmt.VirtualMachineIdentityUserAssignedIdentitiesValue{testMSI: {}},
+		},
+		Tags: map[string]*string{"a": to.StringPtr("b")},
+	}
+	upToDate := v1alpha3.VirtualMachineParameters{
+		Size:      testVMSize,
+		DataDisks: []v1alpha3.DataDisk{{Lun: 0, DiskSizeGB: 128}},
+		Identity:  &v1alpha3.VirtualMachineIdentity{Type: v1alpha3.IdentityTypeUserAssigned, UserAssignedIdentityID: testMSI},
+		Tags:      map[string]string{"a": "b"},
+	}
+
+	cases := map[string]struct {
+		p    func(p *v1alpha3.VirtualMachineParameters)
+		want bool
+	}{
+		"UpToDate": {
+			p:    func(p *v1alpha3.VirtualMachineParameters) {},
+			want: true,
+		},
+		"Resized": {
+			p:    func(p *v1alpha3.VirtualMachineParameters) { p.Size = "Standard_D4s_v3" },
+			want: false,
+		},
+		"DiskAdded": {
+			p: func(p *v1alpha3.VirtualMachineParameters) {
+				p.DataDisks = append(p.DataDisks, v1alpha3.DataDisk{Lun: 1, DiskSizeGB: 64})
+			},
+			want: false,
+		},
+		"DiskGrown": {
+			p:    func(p *v1alpha3.VirtualMachineParameters) { p.DataDisks[0].DiskSizeGB = 256 },
+			want: false,
+		},
+		"IdentityRemoved": {
+			p:    func(p *v1alpha3.VirtualMachineParameters) { p.Identity = nil },
+			want: false,
+		},
+		"TagsChanged": {
+			p:    func(p *v1alpha3.VirtualMachineParameters) { p.Tags = map[string]string{"a": "c"} },
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := *upToDate.DeepCopy()
+			tc.p(&p)
+			got := IsVirtualMachineUpToDate(p, vm, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsVirtualMachineUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetLinuxSecrets(t *testing.T) {
+	errBoom := errors.New("boom")
+	key := v1alpha3.SSHPublicKey{SecretRef: xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+		Key:             "id_ed25519.pub",
+	}}
+	cd := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+		Key:             "cloud-init",
+	}
+	secret := func(data map[string][]byte) client.Client {
+		return &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		})}
+	}
+
+	type want struct {
+		s   LinuxSecrets
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting a Secret should be returned.",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   want{err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errGetSSHPublicKey)},
+		},
+		"EmptyKey": {
+			reason: "Missing SSH public keys should be reported.",
+			kube:   secret(map[string][]byte{"cloud-init": []byte("#cloud-config")}),
+			want:   want{err: errors.Errorf(errFmtEmptySSHPublicKey, "cool-ns", "cool-secret", "id_ed25519.pub")},
+		},
+		"Success": {
+			reason: "The SSH public keys and custom data should be returned.",
+			kube:   secret(map[string][]byte{"id_ed25519.pub": []byte(testSSHKey), "cloud-init": []byte("#cloud-config")}),
+			want:   want{s: LinuxSecrets{SSHPublicKeys: []string{testSSHKey}, CustomData: []byte("#cloud-config")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetLinuxSecrets(context.Background(), tc.kube, []v1alpha3.SSHPublicKey{key}, cd)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetLinuxSecrets(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.s, got); diff != "" {
+				t.Errorf("\n%s\nGetLinuxSecrets(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"strings"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// DefaultScaleSetCapacity is the number of virtual machines of a scale set
+// that does not specify its capacity.
+const DefaultScaleSetCapacity = 1
+
+// A VirtualMachineScaleSetsClient handles CRUD operations for Azure virtual
+// machine scale sets.
+type VirtualMachineScaleSetsClient computeapi.VirtualMachineScaleSetsClientAPI
+
+// NewVirtualMachineScaleSet returns virtual machine scale set creation
+// parameters suitable for use with the Azure API.
+func NewVirtualMachineScaleSet(name string, p v1alpha3.VirtualMachineScaleSetParameters, s LinuxSecrets, d azure.ResourceDefaults) computemgmt.VirtualMachineScaleSet {
+	osDisk := &computemgmt.VirtualMachineScaleSetOSDisk{CreateOption: computemgmt.DiskCreateOptionTypesFromImage}
+	if o := p.OSDisk; o != nil {
+		osDisk.DiskSizeGB = azure.ToInt32(o.DiskSizeGB)
+		osDisk.Caching = computemgmt.CachingTypes(azure.ToString(o.Caching))
+		if o.StorageAccountType != nil {
+			osDisk.ManagedDisk = &computemgmt.VirtualMachineScaleSetManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypes(*o.StorageAccountType)}
+		}
+	}
+	var disks *[]computemgmt.VirtualMachineScaleSetDataDisk
+	if len(p.DataDisks) > 0 {
+		dd := make([]computemgmt.VirtualMachineScaleSetDataDisk, len(p.DataDisks))
+		for i, d := range p.DataDisks {
+			dd[i] = computemgmt.VirtualMachineScaleSetDataDisk{
+				Lun:          azure.ToInt32Ptr(d.Lun, azure.FieldRequired),
+				DiskSizeGB:   azure.ToInt32Ptr(d.DiskSizeGB, azure.FieldRequired),
+				Caching:      computemgmt.CachingTypes(azure.ToString(d.Caching)),
+				CreateOption: computemgmt.DiskCreateOptionTypesEmpty,
+			}
+			if d.StorageAccountType != nil {
+				dd[i].ManagedDisk = &computemgmt.VirtualMachineScaleSetManagedDiskParameters{StorageAccountType: computemgmt.StorageAccountTypes(*d.StorageAccountType)}
+			}
+		}
+		disks = &dd
+	}
+	prefix := name
+	if p.ComputerNamePrefix != nil {
+		prefix = *p.ComputerNamePrefix
+	}
+	nics := []computemgmt.VirtualMachineScaleSetNetworkConfiguration{{
+		Name: to.StringPtr(name),
+		VirtualMachineScaleSetNetworkConfigurationProperties: &computemgmt.VirtualMachineScaleSetNetworkConfigurationProperties{
+			Primary: to.BoolPtr(true),
+			IPConfigurations: &[]computemgmt.VirtualMachineScaleSetIPConfiguration{{
+				Name: to.StringPtr(name),
+				VirtualMachineScaleSetIPConfigurationProperties: &computemgmt.VirtualMachineScaleSetIPConfigurationProperties{
+					Primary: to.BoolPtr(true),
+					Subnet:  &computemgmt.APIEntityReference{ID: azure.ToStringPtr(p.SubnetID)},
+				},
+			}},
+		},
+	}}
+	return computemgmt.VirtualMachineScaleSet{
+		Location: to.StringPtr(d.LocationOr(p.Location)),
+		Sku:      &computemgmt.Sku{Name: to.StringPtr(p.Size), Capacity: to.Int64Ptr(int64(scaleSetCapacity(p)))},
+		VirtualMachineScaleSetProperties: &computemgmt.VirtualMachineScaleSetProperties{
+			UpgradePolicy: &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeMode(scaleSetUpgradeMode(p))},
+			VirtualMachineProfile: &computemgmt.VirtualMachineScaleSetVMProfile{
+				StorageProfile: &computemgmt.VirtualMachineScaleSetStorageProfile{
+					ImageReference: newImageReference(p.Image),
+					OsDisk:         osDisk,
+					DataDisks:      disks,
+				},
+				OsProfile: &computemgmt.VirtualMachineScaleSetOSProfile{
+					ComputerNamePrefix: to.StringPtr(prefix),
+					AdminUsername:      to.StringPtr(p.AdminUsername),
+					CustomData:         customData(s.CustomData),
+					LinuxConfiguration: newLinuxConfiguration(p.AdminUsername, s.SSHPublicKeys),
+				},
+				NetworkProfile: &computemgmt.VirtualMachineScaleSetNetworkProfile{NetworkInterfaceConfigurations: &nics},
+			},
+		},
+		Identity: newScaleSetIdentity(p.Identity),
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+}
+
+// NewVirtualMachineScaleSetUpdate returns an update of the supplied scale set
+// that applies the updatable fields of the supplied parameters.
+func NewVirtualMachineScaleSetUpdate(p v1alpha3.VirtualMachineScaleSetParameters, ss computemgmt.VirtualMachineScaleSet, d azure.ResourceDefaults) computemgmt.VirtualMachineScaleSetUpdate {
+	sku := &computemgmt.Sku{Name: to.StringPtr(p.Size), Capacity: to.Int64Ptr(int64(scaleSetCapacity(p)))}
+	if ss.Sku != nil {
+		sku.Tier = ss.Sku.Tier
+	}
+	id := newScaleSetIdentity(p.Identity)
+	if id == nil && ss.Identity != nil {
+		id = &computemgmt.VirtualMachineScaleSetIdentity{Type: computemgmt.ResourceIdentityTypeNone}
+	}
+	return computemgmt.VirtualMachineScaleSetUpdate{
+		Sku: sku,
+		VirtualMachineScaleSetUpdateProperties: &computemgmt.VirtualMachineScaleSetUpdateProperties{
+			UpgradePolicy: &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeMode(scaleSetUpgradeMode(p))},
+		},
+		Identity: id,
+		Tags:     d.UpdateTags(d.MergeTags(p.Tags), ss.Tags),
+	}
+}
+
+// LateInitializeVirtualMachineScaleSet fills the empty fields of the supplied
+// parameters with the values of the supplied scale set.
+func LateInitializeVirtualMachineScaleSet(p *v1alpha3.VirtualMachineScaleSetParameters, ss computemgmt.VirtualMachineScaleSet, d azure.ResourceDefaults) {
+	if ss.Sku != nil && p.Capacity == nil && ss.Sku.Capacity != nil {
+		p.Capacity = to.IntPtr(int(*ss.Sku.Capacity))
+	}
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, ss.Zones)
+	p.Tags = d.LateInitializeTags(p.Tags, ss.Tags)
+	if ss.VirtualMachineScaleSetProperties == nil {
+		return
+	}
+	if p.UpgradeMode == nil && ss.UpgradePolicy != nil && ss.UpgradePolicy.Mode != "" {
+		p.UpgradeMode = to.StringPtr(string(ss.UpgradePolicy.Mode))
+	}
+	vp := ss.VirtualMachineProfile
+	if vp == nil {
+		return
+	}
+	if vp.OsProfile != nil {
+		p.ComputerNamePrefix = azure.LateInitializeStringPtrFromPtr(p.ComputerNamePrefix, vp.OsProfile.ComputerNamePrefix)
+	}
+	if vp.StorageProfile == nil {
+		return
+	}
+	if o := vp.StorageProfile.OsDisk; o != nil {
+		var sat string
+		if o.ManagedDisk != nil {
+			sat = string(o.ManagedDisk.StorageAccountType)
+		}
+		p.OSDisk = lateInitializeOSDisk(p.OSDisk, o.DiskSizeGB, string(o.Caching), sat)
+	}
+	if vp.StorageProfile.DataDisks == nil {
+		return
+	}
+	for i := range p.DataDisks {
+		for _, o := range *vp.StorageProfile.DataDisks {
+			if azure.ToInt(o.Lun) != p.DataDisks[i].Lun {
+				continue
+			}
+			var sat string
+			if o.ManagedDisk != nil {
+				sat = string(o.ManagedDisk.StorageAccountType)
+			}
+			lateInitializeDataDisk(&p.DataDisks[i], string(o.Caching), sat)
+		}
+	}
+}
+
+// IsVirtualMachineScaleSetUpToDate returns true if the supplied scale set is
+// up to date with the updatable fields of the supplied parameters.
+func IsVirtualMachineScaleSetUpToDate(p v1alpha3.VirtualMachineScaleSetParameters, ss computemgmt.VirtualMachineScaleSet, d azure.ResourceDefaults) bool {
+	if ss.Sku == nil || ss.VirtualMachineScaleSetProperties == nil {
+		return false
+	}
+	if !strings.EqualFold(azure.ToString(ss.Sku.Name), p.Size) {
+		return false
+	}
+	if azure.Int64ToInt(ss.Sku.Capacity) != scaleSetCapacity(p) {
+		return false
+	}
+	if ss.UpgradePolicy == nil || string(ss.UpgradePolicy.Mode) != scaleSetUpgradeMode(p) {
+		return false
+	}
+	var idType string
+	var ids []string
+	if ss.Identity != nil {
+		idType = string(ss.Identity.Type)
+		for k := range ss.Identity.UserAssignedIdentities {
+			ids = append(ids, k)
+		}
+	}
+	if !isIdentityUpToDate(p.Identity, idType, ids) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), ss.Tags)
+}
+
+// ScaleSetPrincipalID returns the principal ID of the system-assigned identity
+// of the supplied scale set, if any.
+func ScaleSetPrincipalID(ss computemgmt.VirtualMachineScaleSet) string {
+	if ss.Identity == nil {
+		return ""
+	}
+	return azure.ToString(ss.Identity.PrincipalID)
+}
+
+func newScaleSetIdentity(id *v1alpha3.VirtualMachineIdentity) *computemgmt.VirtualMachineScaleSetIdentity {
+	if id == nil {
+		return nil
+	}
+	sid := &computemgmt.VirtualMachineScaleSetIdentity{Type: computemgmt.ResourceIdentityType(id.Type)}
+	if id.Type == v1alpha3.IdentityTypeUserAssigned {
+		sid.UserAssignedIdentities = map[string]*computemgmt.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{
+			id.UserAssignedIdentityID: {},
+		}
+	}
+	return sid
+}
+
+func scaleSetCapacity(p v1alpha3.VirtualMachineScaleSetParameters) int {
+	if p.Capacity == nil {
+		return DefaultScaleSetCapacity
+	}
+	return *p.Capacity
+}
+
+func scaleSetUpgradeMode(p v1alpha3.VirtualMachineScaleSetParameters) string {
+	if p.UpgradeMode == nil {
+		return v1alpha3.UpgradeModeManual
+	}
+	return *p.UpgradeMode
+}
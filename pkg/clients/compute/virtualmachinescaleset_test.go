@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const testScaleSetName = "cool-vmss"
+
+func TestNewVirtualMachineScaleSet(t *testing.T) {
+	d := azure.ResourceDefaults{Location: "westeurope"}
+	p := v1alpha3.VirtualMachineScaleSetParameters{
+		Size:          testVMSize,
+		Image:         v1alpha3.ImageReference{ID: to.StringPtr("cool-image")},
+		SubnetID:      testSubnet,
+		AdminUsername: testAdmin,
+		Identity:      &v1alpha3.VirtualMachineIdentity{Type: v1alpha3.IdentityTypeSystemAssigned},
+	}
+	want := computemgmt.VirtualMachineScaleSet{
+		Location: to.StringPtr("westeurope"),
+		Sku:      &computemgmt.Sku{Name: to.StringPtr(testVMSize), Capacity: to.Int64Ptr(1)},
+		VirtualMachineScaleSetProperties: &computemgmt.VirtualMachineScaleSetProperties{
+			UpgradePolicy: &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeModeManual},
+			VirtualMachineProfile: &computemgmt.VirtualMachineScaleSetVMProfile{
+				StorageProfile: &computemgmt.VirtualMachineScaleSetStorageProfile{
+					ImageReference: &computemgmt.ImageReference{ID: to.StringPtr("cool-image")},
+					OsDisk:         &computemgmt.VirtualMachineScaleSetOSDisk{CreateOption: computemgmt.DiskCreateOptionTypesFromImage},
+				},
+				OsProfile: &computemgmt.VirtualMachineScaleSetOSProfile{
+					ComputerNamePrefix: to.StringPtr(testScaleSetName),
+					AdminUsername:      to.StringPtr(testAdmin),
+					LinuxConfiguration: &computemgmt.LinuxConfiguration{
+						DisablePasswordAuthentication: to.BoolPtr(true),
+						SSH: &computemgmt.SSHConfiguration{PublicKeys: &[]computemgmt.SSHPublicKey{{
+							Path:    to.StringPtr(testKeyDir),
+							KeyData: to.StringPtr(testSSHKey),
+						}}},
+					},
+				},
+				NetworkProfile: &computemgmt.VirtualMachineScaleSetNetworkProfile{NetworkInterfaceConfigurations: &[]computemgmt.VirtualMachineScaleSetNetworkConfiguration{{
+					Name: to.StringPtr(testScaleSetName),
+					VirtualMachineScaleSetNetworkConfigurationProperties: &computemgmt.VirtualMachineScaleSetNetworkConfigurationProperties{
+						Primary: to.BoolPtr(true),
+						IPConfigurations: &[]computemgmt.VirtualMachineScaleSetIPConfiguration{{
+							Name: to.StringPtr(testScaleSetName),
+							VirtualMachineScaleSetIPConfigurationProperties: &computemgmt.VirtualMachineScaleSetIPConfigurationProperties{
+								Primary: to.BoolPtr(true),
+								Subnet:  &computemgmt.APIEntityReference{ID: to.StringPtr(testSubnet)},
+							},
+						}},
+					},
+				}}},
+			},
+		},
+		Identity: &computemgmt.VirtualMachineScaleSetIdentity{Type: computemgmt.ResourceIdentityTypeSystemAssigned},
+	}
+
+	got := NewVirtualMachineScaleSet(testScaleSetName, p, LinuxSecrets{SSHPublicKeys: []string{testSSHKey}}, d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewVirtualMachineScaleSet(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewVirtualMachineScaleSetUpdate(t *testing.T) {
+	ss := computemgmt.VirtualMachineScaleSet{
+		Sku:      &computemgmt.Sku{Name: to.StringPtr(testVMSize), Tier: to.StringPtr("Standard"), Capacity: to.Int64Ptr(2)},
+		Identity: &computemgmt.VirtualMachineScaleSetIdentity{Type: computemgmt.ResourceIdentityTypeSystemAssigned},
+		Tags:     map[string]*string{"policy": to.StringPtr("yes")},
+	}
+	p := v1alpha3.VirtualMachineScaleSetParameters{
+		Size:        testVMSize,
+		Capacity:    to.IntPtr(5),
+		UpgradeMode: to.StringPtr(v1alpha3.UpgradeModeAutomatic),
+		Tags:        map[string]string{"a": "b"},
+	}
+	want := computemgmt.VirtualMachineScaleSetUpdate{
+		Sku: &computemgmt.Sku{Name: to.StringPtr(testVMSize), Tier: to.StringPtr("Standard"), Capacity: to.Int64Ptr(5)},
+		VirtualMachineScaleSetUpdateProperties: &computemgmt.VirtualMachineScaleSetUpdateProperties{
+			UpgradePolicy: &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeModeAutomatic},
+		},
+		Identity: &computemgmt.VirtualMachineScaleSetIdentity{Type: computemgmt.ResourceIdentityTypeNone},
+		Tags:     map[string]*string{"policy": to.StringPtr("yes"), "a": to.StringPtr("b")},
+	}
+
+	got := NewVirtualMachineScaleSetUpdate(p, ss, azure.ResourceDefaults{})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewVirtualMachineScaleSetUpdate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsVirtualMachineScaleSetUpToDate(t *testing.T) {
+	ss := computemgmt.VirtualMachineScaleSet{
+		Sku: &computemgmt.Sku{Name: to.StringPtr(testVMSize), Capacity: to.Int64Ptr(1)},
+		VirtualMachineScaleSetProperties: &computemgmt.VirtualMachineScaleSetProperties{
+			UpgradePolicy: &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeModeManual},
+		},
+		Tags: map[string]*string{"a": to.StringPtr("b")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.VirtualMachineScaleSetParameters
+		want bool
+	}{
+		"Defaults": {
+			p:    v1alpha3.VirtualMachineScaleSetParameters{Size: testVMSize},
+			want: true,
+		},
+		"Scaled": {
+			p:    v1alpha3.VirtualMachineScaleSetParameters{Size: testVMSize, Capacity: to.IntPtr(3)},
+			want: false,
+		},
+		"UpgradeMode": {
+			p:    v1alpha3.VirtualMachineScaleSetParameters{Size: testVMSize, UpgradeMode: to.StringPtr(v1alpha3.UpgradeModeAutomatic)},
+			want: false,
+		},
+		"Identity": {
+			p:    v1alpha3.VirtualMachineScaleSetParameters{Size: testVMSize, Identity: &v1alpha3.VirtualMachineIdentity{Type: v1alpha3.IdentityTypeSystemAssigned}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVirtualMachineScaleSetUpToDate(tc.p, ss, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsVirtualMachineScaleSetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
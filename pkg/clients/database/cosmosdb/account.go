@@ -24,10 +24,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb/documentdbapi"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
@@ -37,21 +37,20 @@ type AccountClient documentdbapi.DatabaseAccountsClientAPI
 // NewDatabaseAccountClient create Azure DatabaseAccountsClient using provided
 // credentials data
 func NewDatabaseAccountClient(credentials []byte) (AccountClient, error) {
-	creds := &azure.Credentials{}
-	if err := json.Unmarshal(credentials, creds); err != nil {
+	creds := map[string]string{}
+	if err := json.Unmarshal(credentials, &creds); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
 	}
+	if err := azure.SetEnvironment(creds, v1beta1.ProviderConfigSpec{}); err != nil {
+		return nil, err
+	}
 
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := azure.NewAuthorizer(creds)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get authorizer from config")
 	}
 
-	client := documentdb.NewDatabaseAccountsClient(creds.SubscriptionID)
+	client := documentdb.NewDatabaseAccountsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], creds[azure.CredentialsKeySubscriptionID])
 	client.Authorizer = authorizer
 
 	if err := client.AddToUserAgent(azure.UserAgent); err != nil {
@@ -61,16 +60,17 @@ func NewDatabaseAccountClient(credentials []byte) (AccountClient, error) {
 	return client, nil
 }
 
-// ToDatabaseAccountCreateOrUpdate from CosmosDBAccountSpec
-func ToDatabaseAccountCreateOrUpdate(s *v1alpha3.CosmosDBAccountSpec) documentdb.DatabaseAccountCreateUpdateParameters {
+// ToDatabaseAccountCreateOrUpdate from CosmosDBAccountSpec, applying the
+// supplied defaults.
+func ToDatabaseAccountCreateOrUpdate(s *v1alpha3.CosmosDBAccountSpec, d azure.ResourceDefaults) documentdb.DatabaseAccountCreateUpdateParameters {
 	if s == nil {
 		return documentdb.DatabaseAccountCreateUpdateParameters{}
 	}
 
 	return documentdb.DatabaseAccountCreateUpdateParameters{
 		Kind:                                  s.ForProvider.Kind,
-		Location:                              azure.ToStringPtr(s.ForProvider.Location),
-		Tags:                                  azure.ToStringPtrMap(s.ForProvider.Tags),
+		Location:                              azure.ToStringPtr(d.LocationOr(s.ForProvider.Location)),
+		Tags:                                  azure.ToStringPtrMap(d.MergeTags(s.ForProvider.Tags)),
 		DatabaseAccountCreateUpdateProperties: toDatabaseProperties(&s.ForProvider.Properties),
 	}
 }
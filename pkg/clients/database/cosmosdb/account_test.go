@@ -81,7 +81,7 @@ func TestToDatabaseAccountCreateOrUpdate(t *testing.T) {
 	consistency := documentdb.DefaultConsistencyLevel("Eventual")
 
 	t.Run("Nil", func(t *testing.T) {
-		diff := cmp.Diff(documentdb.DatabaseAccountCreateUpdateParameters{}, ToDatabaseAccountCreateOrUpdate(nil))
+		diff := cmp.Diff(documentdb.DatabaseAccountCreateUpdateParameters{}, ToDatabaseAccountCreateOrUpdate(nil, azure.ResourceDefaults{}))
 		if diff != "" {
 			t.Errorf("ToDatabaseAccountCreateOrUpdate() diff:\n%s", diff)
 		}
@@ -122,7 +122,23 @@ func TestToDatabaseAccountCreateOrUpdate(t *testing.T) {
 					},
 				},
 			},
-		}))
+		}, azure.ResourceDefaults{}))
+		if diff != "" {
+			t.Errorf("ToDatabaseAccountCreateOrUpdate() diff:\n%s", diff)
+		}
+	})
+	t.Run("Defaults", func(t *testing.T) {
+		diff := cmp.Diff(documentdb.DatabaseAccountCreateUpdateParameters{
+			Kind:                                  kind,
+			Location:                              &location,
+			Tags:                                  map[string]*string{"owner": azure.ToStringPtr("me"), "team": azure.ToStringPtr("data")},
+			DatabaseAccountCreateUpdateProperties: &documentdb.DatabaseAccountCreateUpdateProperties{Locations: &[]documentdb.Location{}},
+		}, ToDatabaseAccountCreateOrUpdate(&v1alpha3.CosmosDBAccountSpec{
+			ForProvider: v1alpha3.CosmosDBAccountParameters{
+				Kind: kind,
+				Tags: map[string]string{"owner": "me"},
+			},
+		}, azure.ResourceDefaults{Tags: map[string]string{"owner": "finance", "team": "data"}, Location: location}))
 		if diff != "" {
 			t.Errorf("ToDatabaseAccountCreateOrUpdate() diff:\n%s", diff)
 		}
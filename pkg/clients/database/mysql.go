@@ -64,12 +64,15 @@ type MySQLServerAPI interface {
 // interface for MySQL that calls Azure API.
 type MySQLServerClient struct {
 	mysql.ServersClient
+	defaults azure.ResourceDefaults
 }
 
-// NewMySQLServerClient creates and initializes a MySQLServerClient instance.
-func NewMySQLServerClient(cl mysql.ServersClient) *MySQLServerClient {
+// NewMySQLServerClient creates and initializes a MySQLServerClient instance that
+// applies the supplied defaults to the servers it creates and updates.
+func NewMySQLServerClient(cl mysql.ServersClient, d azure.ResourceDefaults) *MySQLServerClient {
 	return &MySQLServerClient{
 		ServersClient: cl,
+		defaults:      d,
 	}
 }
 
@@ -164,8 +167,8 @@ func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1
 	createParams := mysql.ServerForCreate{
 		Sku:        sku,
 		Properties: toMySQLProperties(s, adminPassword),
-		Location:   &s.Location,
-		Tags:       azure.ToStringPtrMap(s.Tags),
+		Location:   azure.ToStringPtr(c.defaults.LocationOr(s.Location), azure.FieldRequired),
+		Tags:       azure.ToStringPtrMap(c.defaults.MergeTags(s.Tags)),
 	}
 	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), createParams)
 	if err != nil {
@@ -202,7 +205,7 @@ func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1
 	updateParams := mysql.ServerUpdateParameters{
 		Sku:                              sku,
 		ServerUpdateParametersProperties: properties,
-		Tags:                             azure.ToStringPtrMap(s.Tags),
+		Tags:                             azure.ToStringPtrMap(c.defaults.MergeTags(s.Tags)),
 	}
 	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), updateParams)
 	if err != nil {
@@ -309,12 +312,13 @@ func UpdateMySQLObservation(o *azuredbv1beta1.SQLServerObservation, in mysql.Ser
 }
 
 // LateInitializeMySQL fills the empty values of SQLServerParameters with the
-// ones that are retrieved from the Azure API.
-func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server) {
+// ones that are retrieved from the Azure API. The supplied default tags are not
+// late-initialized.
+func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server, d azure.ResourceDefaults) {
 	if in.Sku != nil {
 		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.Sku.Size)
 	}
-	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	p.Tags = d.LateInitializeTags(p.Tags, in.Tags)
 	if in.StorageProfile != nil {
 		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
 		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, string(in.StorageProfile.GeoRedundantBackup))
@@ -332,8 +336,9 @@ func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server)
 }
 
 // IsMySQLUpToDate is used to report whether given mysql.Server is in
-// sync with the SQLServerParameters that user desires.
-func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool { // nolint:gocyclo
+// sync with the SQLServerParameters that user desires, merged with the supplied
+// defaults.
+func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server, d azure.ResourceDefaults) bool { // nolint:gocyclo
 	if in.StorageProfile == nil || in.Sku == nil {
 		return false
 	}
@@ -344,7 +349,7 @@ func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool
 		return false
 	case p.Version != string(in.Version):
 		return false
-	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+	case !d.TagsUpToDate(d.MergeTags(p.Tags), in.Tags):
 		return false
 	case p.SKU.Tier != string(in.Sku.Tier):
 		return false
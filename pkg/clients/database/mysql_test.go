@@ -542,7 +542,7 @@ func TestIsMysqlUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsMySQLUpToDate(tc.args.p, tc.args.in)
+			got := IsMySQLUpToDate(tc.args.p, tc.args.in, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("IsMySQLUpToDate(...): -want, +got\n%s", diff)
 			}
@@ -576,7 +576,7 @@ func TestLateInitializeMySQL(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			LateInitializeMySQL(tc.args.p, tc.args.in)
+			LateInitializeMySQL(tc.args.p, tc.args.in, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
 				t.Errorf("TestLateInitializeMySQL(...): -want, +got\n%s", diff)
 			}
@@ -56,12 +56,15 @@ type PostgreSQLServerAPI interface {
 // PostgreSQLServerClient is the concreate implementation of the SQLServerAPI interface for PostgreSQL that calls Azure API.
 type PostgreSQLServerClient struct {
 	postgresql.ServersClient
+	defaults azure.ResourceDefaults
 }
 
-// NewPostgreSQLServerClient creates and initializes a PostgreSQLServerClient instance.
-func NewPostgreSQLServerClient(cl postgresql.ServersClient) *PostgreSQLServerClient {
+// NewPostgreSQLServerClient creates and initializes a PostgreSQLServerClient instance that
+// applies the supplied defaults to the servers it creates and updates.
+func NewPostgreSQLServerClient(cl postgresql.ServersClient, d azure.ResourceDefaults) *PostgreSQLServerClient {
 	return &PostgreSQLServerClient{
 		ServersClient: cl,
+		defaults:      d,
 	}
 }
 
@@ -156,8 +159,8 @@ func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1
 	createParams := postgresql.ServerForCreate{
 		Sku:        sku,
 		Properties: toPGSQLProperties(s, adminPassword),
-		Location:   &s.Location,
-		Tags:       azure.ToStringPtrMap(s.Tags),
+		Location:   azure.ToStringPtr(c.defaults.LocationOr(s.Location), azure.FieldRequired),
+		Tags:       azure.ToStringPtrMap(c.defaults.MergeTags(s.Tags)),
 	}
 	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), createParams)
 	if err != nil {
@@ -194,7 +197,7 @@ func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1
 	updateParams := postgresql.ServerUpdateParameters{
 		Sku:                              sku,
 		ServerUpdateParametersProperties: properties,
-		Tags:                             azure.ToStringPtrMap(s.Tags),
+		Tags:                             azure.ToStringPtrMap(c.defaults.MergeTags(s.Tags)),
 	}
 	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), updateParams)
 	if err != nil {
@@ -301,12 +304,13 @@ func UpdatePostgreSQLObservation(o *azuredbv1beta1.SQLServerObservation, in post
 }
 
 // LateInitializePostgreSQL fills the empty values of SQLServerParameters with the
-// ones that are retrieved from the Azure API.
-func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgresql.Server) {
+// ones that are retrieved from the Azure API. The supplied default tags are not
+// late-initialized.
+func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgresql.Server, d azure.ResourceDefaults) {
 	if in.Sku != nil {
 		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.Sku.Size)
 	}
-	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	p.Tags = d.LateInitializeTags(p.Tags, in.Tags)
 	if in.StorageProfile != nil {
 		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
 		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, string(in.StorageProfile.GeoRedundantBackup))
@@ -325,8 +329,9 @@ func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgres
 }
 
 // IsPostgreSQLUpToDate is used to report whether given postgresql.Server is in
-// sync with the SQLServerParameters that user desires.
-func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Server) bool { // nolint:gocyclo
+// sync with the SQLServerParameters that user desires, merged with the supplied
+// defaults.
+func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Server, d azure.ResourceDefaults) bool { // nolint:gocyclo
 	if in.StorageProfile == nil || in.Sku == nil {
 		return false
 	}
@@ -337,7 +342,7 @@ func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Se
 		return false
 	case p.Version != string(in.Version):
 		return false
-	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+	case !d.TagsUpToDate(d.MergeTags(p.Tags), in.Tags):
 		return false
 	case p.SKU.Tier != string(in.Sku.Tier):
 		return false
@@ -530,7 +530,7 @@ func TestIsPostgreSQLUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsPostgreSQLUpToDate(tc.args.p, tc.args.in)
+			got := IsPostgreSQLUpToDate(tc.args.p, tc.args.in, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("IsPostgreSQLUpToDate(...): -want, +got\n%s", diff)
 			}
@@ -564,7 +564,7 @@ func TestLateInitializePostgreSQL(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			LateInitializePostgreSQL(tc.args.p, tc.args.in)
+			LateInitializePostgreSQL(tc.args.p, tc.args.in, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
 				t.Errorf("LateInitializePostgreSQL(...): -want, +got\n%s", diff)
 			}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+// AnnotationKeyManagedTags is the annotation under which the keys of the tags
+// the provider applied to a resource are recorded, as a JSON array.
+const AnnotationKeyManagedTags = "azure.crossplane.io/managed-tags"
+
+// ResourceDefaults are the tags and location a ProviderConfig applies to the
+// managed resources that use it.
+type ResourceDefaults struct {
+	// Tags are added to the tags of every resource. Tags of the resource
+	// take precedence.
+	Tags map[string]string
+
+	// Location is used when a resource does not specify its location.
+	Location string
+
+	// ManagedTags are the keys of the tags the provider applied to the
+	// resource when it was last up to date.
+	ManagedTags []string
+}
+
+// GetResourceDefaults returns the defaults of the ProviderConfig referenced by
+// the supplied managed resource, and the keys of the tags the provider manages
+// on it. Resources that reference a Provider have no defaults.
+func GetResourceDefaults(ctx context.Context, c client.Client, mg resource.Managed) (ResourceDefaults, error) {
+	d := ResourceDefaults{ManagedTags: managedTags(mg)}
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return d, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return ResourceDefaults{}, errors.Wrap(err, errGetProviderConfig)
+	}
+	d.Tags = pc.Spec.DefaultTags
+	d.Location = pc.Spec.DefaultLocation
+	return d, nil
+}
+
+// SetManagedTags records the keys of the supplied tags as the tags the
+// provider manages on the supplied object. It returns true if the recorded
+// keys changed, in which case the object must be persisted.
+func SetManagedTags(o metav1.Object, tags map[string]string) bool {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	old, ok := o.GetAnnotations()[AnnotationKeyManagedTags]
+	if len(keys) == 0 {
+		meta.RemoveAnnotations(o, AnnotationKeyManagedTags)
+		return ok
+	}
+	// Marshalling a slice of strings cannot fail.
+	b, _ := json.Marshal(keys)
+	meta.AddAnnotations(o, map[string]string{AnnotationKeyManagedTags: string(b)})
+	return old != string(b)
+}
+
+func (d ResourceDefaults) isManagedTag(k string) bool {
+	for _, m := range d.ManagedTags {
+		if m == k {
+			return true
+		}
+	}
+	return false
+}
+
+func managedTags(o metav1.Object) []string {
+	var keys []string
+	if err := json.Unmarshal([]byte(o.GetAnnotations()[AnnotationKeyManagedTags]), &keys); err != nil {
+		return nil
+	}
+	return keys
+}
+
+// MergeTags returns the default tags overridden by the supplied tags, or nil
+// if there are neither.
+func (d ResourceDefaults) MergeTags(tags map[string]string) map[string]string {
+	if len(d.Tags) == 0 {
+		return tags
+	}
+	m := make(map[string]string, len(d.Tags)+len(tags))
+	for k, v := range d.Tags {
+		m[k] = v
+	}
+	for k, v := range tags {
+		m[k] = v
+	}
+	return m
+}
+
+// LocationOr returns the supplied location, or the default location if it is
+// empty.
+func (d ResourceDefaults) LocationOr(l string) string {
+	if l != "" {
+		return l
+	}
+	return d.Location
+}
+
+// LateInitializeTags late-inits tags from the supplied Azure tags, except for
+// default and managed tags. These are not copied to resources so that changes
+// to them take effect.
+func (d ResourceDefaults) LateInitializeTags(in map[string]string, from map[string]*string) map[string]string {
+	if in != nil || from == nil {
+		return in
+	}
+	m := map[string]string{}
+	for k, v := range from {
+		if _, ok := d.Tags[k]; ok {
+			continue
+		}
+		if d.isManagedTag(k) {
+			continue
+		}
+		m[k] = ToString(v)
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// TagsUpToDate returns true if the supplied Azure tags include all of the
+// desired tags, and none of the managed tags that are no longer desired. Tags
+// that were set outside of Crossplane, for example by an Azure Policy, are
+// ignored.
+func (d ResourceDefaults) TagsUpToDate(desired map[string]string, observed map[string]*string) bool {
+	for k, v := range desired {
+		o, ok := observed[k]
+		if !ok || ToString(o) != v {
+			return false
+		}
+	}
+	for _, k := range d.ManagedTags {
+		if _, ok := desired[k]; ok {
+			continue
+		}
+		if _, ok := observed[k]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateTags returns the supplied Azure tags with the desired tags applied and
+// the managed tags that are no longer desired removed. Tags that were set
+// outside of Crossplane are retained, so that resources can be updated with
+// the returned tags without fighting other tools over them.
+func (d ResourceDefaults) UpdateTags(desired map[string]string, observed map[string]*string) map[string]*string {
+	m := make(map[string]*string, len(desired)+len(observed))
+	for k, v := range observed {
+		m[k] = v
+	}
+	for _, k := range d.ManagedTags {
+		delete(m, k)
+	}
+	for k, v := range desired {
+		m[k] = ToStringPtr(v, FieldRequired)
+	}
+	return m
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+func TestGetResourceDefaults(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		d   ResourceDefaults
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     *fake.Managed
+		want   want
+	}{
+		"NoProviderConfig": {
+			reason: "Resources that do not reference a ProviderConfig should have no defaults.",
+			mg:     &fake.Managed{},
+			want:   want{},
+		},
+		"GetError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:     &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "cool"}}},
+			want:   want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"Success": {
+			reason: "The defaults of the referenced ProviderConfig should be returned.",
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				pc := obj.(*v1beta1.ProviderConfig)
+				pc.Spec.DefaultTags = map[string]string{"owner": "finance"}
+				pc.Spec.DefaultLocation = "westeurope"
+				return nil
+			})},
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "cool"}}},
+			want: want{d: ResourceDefaults{
+				Tags:     map[string]string{"owner": "finance"},
+				Location: "westeurope",
+			}},
+		},
+		"ManagedTags": {
+			reason: "The keys of the tags the provider manages should be returned.",
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyManagedTags: `["a","owner"]`,
+			}}},
+			want: want{d: ResourceDefaults{ManagedTags: []string{"a", "owner"}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetResourceDefaults(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetResourceDefaults(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.d, got); diff != "" {
+				t.Errorf("\n%s\nGetResourceDefaults(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	cases := map[string]struct {
+		d    ResourceDefaults
+		tags map[string]string
+		want map[string]string
+	}{
+		"NoDefaults": {
+			tags: map[string]string{"a": "b"},
+			want: map[string]string{"a": "b"},
+		},
+		"NoTags": {
+			d:    ResourceDefaults{Tags: map[string]string{"a": "b"}},
+			want: map[string]string{"a": "b"},
+		},
+		"TagsWin": {
+			d:    ResourceDefaults{Tags: map[string]string{"a": "default", "c": "d"}},
+			tags: map[string]string{"a": "b"},
+			want: map[string]string{"a": "b", "c": "d"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.d.MergeTags(tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MergeTags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocationOr(t *testing.T) {
+	d := ResourceDefaults{Location: "westeurope"}
+	if diff := cmp.Diff("eastus", d.LocationOr("eastus")); diff != "" {
+		t.Errorf("LocationOr(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("westeurope", d.LocationOr("")); diff != "" {
+		t.Errorf("LocationOr(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSetManagedTags(t *testing.T) {
+	type want struct {
+		changed     bool
+		annotations map[string]string
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		tags        map[string]string
+		want        want
+	}{
+		"Added": {
+			tags: map[string]string{"owner": "finance", "a": "b"},
+			want: want{changed: true, annotations: map[string]string{AnnotationKeyManagedTags: `["a","owner"]`}},
+		},
+		"Unchanged": {
+			annotations: map[string]string{AnnotationKeyManagedTags: `["a","owner"]`},
+			tags:        map[string]string{"owner": "finance", "a": "b"},
+			want:        want{annotations: map[string]string{AnnotationKeyManagedTags: `["a","owner"]`}},
+		},
+		"Removed": {
+			annotations: map[string]string{AnnotationKeyManagedTags: `["a"]`},
+			want:        want{changed: true, annotations: map[string]string{}},
+		},
+		"NoTags": {
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{Annotations: tc.annotations}
+			got := SetManagedTags(o, tc.tags)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("SetManagedTags(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.annotations, o.GetAnnotations()); diff != "" {
+				t.Errorf("SetManagedTags(...): -want annotations, +got annotations:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTags(t *testing.T) {
+	d := ResourceDefaults{Tags: map[string]string{"owner": "finance"}, ManagedTags: []string{"a"}}
+
+	cases := map[string]struct {
+		in   map[string]string
+		from map[string]*string
+		want map[string]string
+	}{
+		"AlreadySet": {
+			in:   map[string]string{"a": "b"},
+			from: map[string]*string{"c": to.StringPtr("d")},
+			want: map[string]string{"a": "b"},
+		},
+		"OnlyDefaults": {
+			from: map[string]*string{"owner": to.StringPtr("finance")},
+			want: nil,
+		},
+		"SkipDefaults": {
+			from: map[string]*string{"owner": to.StringPtr("finance"), "c": to.StringPtr("d")},
+			want: map[string]string{"c": "d"},
+		},
+		"SkipManaged": {
+			from: map[string]*string{"a": to.StringPtr("b"), "c": to.StringPtr("d")},
+			want: map[string]string{"c": "d"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := d.LateInitializeTags(tc.in, tc.from)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LateInitializeTags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTagsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		managed  []string
+		desired  map[string]string
+		observed map[string]*string
+		want     bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"Missing": {
+			desired: map[string]string{"a": "b"},
+			want:    false,
+		},
+		"Different": {
+			desired:  map[string]string{"a": "b"},
+			observed: map[string]*string{"a": to.StringPtr("c")},
+			want:     false,
+		},
+		"UnmanagedTags": {
+			desired:  map[string]string{"a": "b"},
+			observed: map[string]*string{"a": to.StringPtr("b"), "c": to.StringPtr("d")},
+			want:     true,
+		},
+		"RemovedManagedTag": {
+			managed:  []string{"a", "c"},
+			desired:  map[string]string{"a": "b"},
+			observed: map[string]*string{"a": to.StringPtr("b"), "c": to.StringPtr("d")},
+			want:     false,
+		},
+		"ForeignTagIgnored": {
+			managed:  []string{"a", "c"},
+			desired:  map[string]string{"a": "b"},
+			observed: map[string]*string{"a": to.StringPtr("b"), "e": to.StringPtr("f")},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResourceDefaults{ManagedTags: tc.managed}.TagsUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TagsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateTags(t *testing.T) {
+	d := ResourceDefaults{ManagedTags: []string{"a", "e"}}
+	got := d.UpdateTags(map[string]string{"a": "b"}, map[string]*string{"a": to.StringPtr("old"), "c": to.StringPtr("d"), "e": to.StringPtr("f")})
+	want := map[string]*string{"a": to.StringPtr("b"), "c": to.StringPtr("d")}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UpdateTags(...): -want, +got:\n%s", diff)
+	}
+}
@@ -31,12 +31,15 @@ type ZoneAPI interface {
 // ZoneClient is the concrete implementation of the ZoneAP interface for DNS Zone that calls Azure API.
 type ZoneClient struct {
 	dns.ZonesClient
+	defaults azure.ResourceDefaults
 }
 
-// NewZoneClient creates and initializes a ZoneClient instance.
-func NewZoneClient(cl dns.ZonesClient) *ZoneClient {
+// NewZoneClient creates and initializes a ZoneClient instance that applies the
+// supplied default tags to the zones it creates and updates.
+func NewZoneClient(cl dns.ZonesClient, d azure.ResourceDefaults) *ZoneClient {
 	return &ZoneClient{
 		ZonesClient: cl,
+		defaults:    d,
 	}
 }
 
@@ -48,7 +51,7 @@ func (c *ZoneClient) Get(ctx context.Context, z *v1alpha1.Zone) (dns.Zone, error
 // CreateOrUpdate creates or updates a DNS Zone
 func (c *ZoneClient) CreateOrUpdate(ctx context.Context, z *v1alpha1.Zone) error {
 	_, err := c.ZonesClient.CreateOrUpdate(ctx, z.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(z),
-		NewZoneParameters(z), "", "")
+		NewZoneParameters(z, c.defaults), "", "")
 
 	return err
 }
@@ -72,8 +75,9 @@ func UpdateZoneStatusFromAzure(v *v1alpha1.Zone, az dns.Zone) {
 	v.Status.AtProvider.NumberOfRecordSets = azure.Int64ToInt(az.NumberOfRecordSets)
 }
 
-// NewZoneParameters returns an Azure DNS Zone object.
-func NewZoneParameters(r *v1alpha1.Zone) dns.Zone {
+// NewZoneParameters returns an Azure DNS Zone object. The supplied default tags
+// are applied. DNS zones are global, so the default location is not.
+func NewZoneParameters(r *v1alpha1.Zone, d azure.ResourceDefaults) dns.Zone {
 	res := dns.Zone{
 		Name:           azure.ToStringPtr(meta.GetExternalName(r)),
 		ZoneProperties: &dns.ZoneProperties{},
@@ -90,8 +94,8 @@ func NewZoneParameters(r *v1alpha1.Zone) dns.Zone {
 
 	// This empty initialization necessary because, Azure SDK returns an empty struct when this field is set to nil.
 	res.Tags = map[string]*string{}
-	if r.Spec.ForProvider.Tags != nil {
-		res.Tags = r.Spec.ForProvider.Tags
+	if tags := d.MergeTags(azure.ToStringMap(r.Spec.ForProvider.Tags)); tags != nil {
+		res.Tags = azure.ToStringPtrMap(tags)
 	}
 
 	return res
@@ -114,9 +118,9 @@ func newSubResourceParameters(e []v1alpha1.SubResource) *[]dns.SubResource {
 }
 
 // ZoneIsUpToDate decides if an upgrade is needed.
-func ZoneIsUpToDate(r *v1alpha1.Zone, az dns.Zone) bool {
-	up := NewZoneParameters(r)
-	if !cmp.Equal(up.Tags, az.Tags) || !cmp.Equal(up.RegistrationVirtualNetworks, az.RegistrationVirtualNetworks) ||
+func ZoneIsUpToDate(r *v1alpha1.Zone, az dns.Zone, d azure.ResourceDefaults) bool {
+	up := NewZoneParameters(r, d)
+	if !d.TagsUpToDate(azure.ToStringMap(up.Tags), az.Tags) || !cmp.Equal(up.RegistrationVirtualNetworks, az.RegistrationVirtualNetworks) ||
 		!cmp.Equal(up.ResolutionVirtualNetworks, az.ResolutionVirtualNetworks) {
 		return false
 	}
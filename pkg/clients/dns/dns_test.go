@@ -155,7 +155,7 @@ func TestNewZoneParameters(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewZoneParameters(tc.r)
+			got := NewZoneParameters(tc.r, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewZoneParameters(...): -want, +got\n%s", diff)
 			}
@@ -227,7 +227,7 @@ func TestZoneIsUpToDate(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := ZoneIsUpToDate(tc.kube, tc.az)
+			got := ZoneIsUpToDate(tc.kube, tc.az, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("SubnetNeedsUpdate(...): -want, +got\n%s", diff)
 			}
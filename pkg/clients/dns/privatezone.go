@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// privateZoneLocation is the only location Azure accepts for private DNS zones.
+const privateZoneLocation = "global"
+
+// PrivateZoneAPI represents the API interface for a Private DNS Zone client
+type PrivateZoneAPI interface {
+	Get(ctx context.Context, z *v1alpha1.PrivateZone) (privatedns.PrivateZone, error)
+	CreateOrUpdate(ctx context.Context, z *v1alpha1.PrivateZone) error
+	Delete(ctx context.Context, z *v1alpha1.PrivateZone) error
+}
+
+// PrivateZoneClient is the concrete implementation of the PrivateZoneAPI
+// interface that calls Azure API.
+type PrivateZoneClient struct {
+	privatedns.PrivateZonesClient
+	defaults azure.ResourceDefaults
+}
+
+// NewPrivateZoneClient creates and initializes a PrivateZoneClient instance
+// that applies the supplied default tags to the zones it creates and updates.
+func NewPrivateZoneClient(cl privatedns.PrivateZonesClient, d azure.ResourceDefaults) *PrivateZoneClient {
+	return &PrivateZoneClient{
+		PrivateZonesClient: cl,
+		defaults:           d,
+	}
+}
+
+// Get retrieves the requested Private DNS Zone
+func (c *PrivateZoneClient) Get(ctx context.Context, z *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+	return c.PrivateZonesClient.Get(ctx, z.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(z))
+}
+
+// CreateOrUpdate creates or updates a Private DNS Zone
+func (c *PrivateZoneClient) CreateOrUpdate(ctx context.Context, z *v1alpha1.PrivateZone) error {
+	_, err := c.PrivateZonesClient.CreateOrUpdate(ctx, z.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(z),
+		NewPrivateZoneParameters(z, c.defaults), "", "")
+
+	return err
+}
+
+// Delete deletes the given Private DNS Zone
+func (c *PrivateZoneClient) Delete(ctx context.Context, z *v1alpha1.PrivateZone) error {
+	_, err := c.PrivateZonesClient.Delete(ctx, z.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(z), z.Status.AtProvider.Etag)
+
+	return err
+}
+
+// UpdatePrivateZoneStatusFromAzure updates the status related to the external
+// Azure Private DNS Zone in the PrivateZoneStatus
+func UpdatePrivateZoneStatusFromAzure(v *v1alpha1.PrivateZone, az privatedns.PrivateZone) {
+	v.Status.AtProvider.ID = azure.ToString(az.ID)
+	v.Status.AtProvider.Etag = azure.ToString(az.Etag)
+	if az.PrivateZoneProperties == nil {
+		return
+	}
+	v.Status.AtProvider.ProvisioningState = string(az.ProvisioningState)
+	v.Status.AtProvider.MaxNumberOfRecordSets = azure.Int64ToInt(az.MaxNumberOfRecordSets)
+	v.Status.AtProvider.NumberOfRecordSets = azure.Int64ToInt(az.NumberOfRecordSets)
+	v.Status.AtProvider.NumberOfVirtualNetworkLinks = azure.Int64ToInt(az.NumberOfVirtualNetworkLinks)
+}
+
+// NewPrivateZoneParameters returns an Azure Private DNS Zone object. The
+// supplied default tags are applied.
+func NewPrivateZoneParameters(r *v1alpha1.PrivateZone, d azure.ResourceDefaults) privatedns.PrivateZone {
+	res := privatedns.PrivateZone{
+		Location: azure.ToStringPtr(privateZoneLocation),
+		// Azure keeps the existing tags when this field is nil.
+		Tags: map[string]*string{},
+	}
+	if tags := d.MergeTags(azure.ToStringMap(r.Spec.ForProvider.Tags)); tags != nil {
+		res.Tags = azure.ToStringPtrMap(tags)
+	}
+
+	return res
+}
+
+// PrivateZoneIsUpToDate decides if an update is needed.
+func PrivateZoneIsUpToDate(r *v1alpha1.PrivateZone, az privatedns.PrivateZone, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(d.MergeTags(azure.ToStringMap(r.Spec.ForProvider.Tags)), az.Tags)
+}
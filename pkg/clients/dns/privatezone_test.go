@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var numberOfVirtualNetworkLinks = 2
+
+func TestUpdatePrivateZoneStatusFromAzure(t *testing.T) {
+	cases := []struct {
+		name string
+		r    privatedns.PrivateZone
+		want v1alpha1.PrivateZoneObservation
+	}{
+		{
+			name: "SuccessfulFull",
+			r: privatedns.PrivateZone{
+				ID:   azure.ToStringPtr(id),
+				Etag: azure.ToStringPtr(etag),
+				PrivateZoneProperties: &privatedns.PrivateZoneProperties{
+					ProvisioningState:           privatedns.Succeeded,
+					MaxNumberOfRecordSets:       azure.ToInt64(&maxNumberOfRecordSets),
+					NumberOfRecordSets:          azure.ToInt64(&numberOfRecordSets),
+					NumberOfVirtualNetworkLinks: azure.ToInt64(&numberOfVirtualNetworkLinks),
+				},
+			},
+			want: v1alpha1.PrivateZoneObservation{
+				ID:                          id,
+				Etag:                        etag,
+				ProvisioningState:           string(privatedns.Succeeded),
+				MaxNumberOfRecordSets:       maxNumberOfRecordSets,
+				NumberOfRecordSets:          numberOfRecordSets,
+				NumberOfVirtualNetworkLinks: numberOfVirtualNetworkLinks,
+			},
+		},
+		{
+			name: "NoProperties",
+			r: privatedns.PrivateZone{
+				ID: azure.ToStringPtr(id),
+			},
+			want: v1alpha1.PrivateZoneObservation{
+				ID: id,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			z := &v1alpha1.PrivateZone{}
+			UpdatePrivateZoneStatusFromAzure(z, tc.r)
+			if diff := cmp.Diff(tc.want, z.Status.AtProvider); diff != "" {
+				t.Errorf("UpdatePrivateZoneStatusFromAzure(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPrivateZoneParameters(t *testing.T) {
+	cases := []struct {
+		name string
+		r    *v1alpha1.PrivateZone
+		d    azure.ResourceDefaults
+		want privatedns.PrivateZone
+	}{
+		{
+			name: "NoTags",
+			r:    &v1alpha1.PrivateZone{},
+			want: privatedns.PrivateZone{
+				Location: azure.ToStringPtr("global"),
+				Tags:     map[string]*string{},
+			},
+		},
+		{
+			name: "DefaultTagsMerged",
+			r: &v1alpha1.PrivateZone{
+				Spec: v1alpha1.PrivateZoneSpec{
+					ForProvider: v1alpha1.PrivateZoneParameters{
+						Tags: azure.ToStringPtrMap(map[string]string{"one": "test"}),
+					},
+				},
+			},
+			d: azure.ResourceDefaults{Tags: map[string]string{"two": "test"}},
+			want: privatedns.PrivateZone{
+				Location: azure.ToStringPtr("global"),
+				Tags:     azure.ToStringPtrMap(tags),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewPrivateZoneParameters(tc.r, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewPrivateZoneParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPrivateZoneIsUpToDate(t *testing.T) {
+	cases := []struct {
+		name string
+		kube *v1alpha1.PrivateZone
+		az   privatedns.PrivateZone
+		want bool
+	}{
+		{
+			name: "NotUpToDate",
+			kube: &v1alpha1.PrivateZone{
+				Spec: v1alpha1.PrivateZoneSpec{
+					ForProvider: v1alpha1.PrivateZoneParameters{
+						Tags: azure.ToStringPtrMap(tags),
+					},
+				},
+			},
+			az:   privatedns.PrivateZone{},
+			want: false,
+		},
+		{
+			name: "UpToDate",
+			kube: &v1alpha1.PrivateZone{
+				Spec: v1alpha1.PrivateZoneSpec{
+					ForProvider: v1alpha1.PrivateZoneParameters{
+						Tags: azure.ToStringPtrMap(tags),
+					},
+				},
+			},
+			az: privatedns.PrivateZone{
+				Tags: azure.ToStringPtrMap(tags),
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := PrivateZoneIsUpToDate(tc.kube, tc.az, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("PrivateZoneIsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errNoCustomEnvironment = "environment Custom requires spec.customEnvironment"
+	errGetEnvironment      = "cannot get Azure environment"
+	errNoStorageSuffix     = "credentials do not specify a storage endpoint suffix"
+	errNoKeyVaultSuffix    = "credentials do not specify a Key Vault DNS suffix"
+)
+
+// SetEnvironment sets the endpoints of the Azure cloud configured by the
+// supplied ProviderConfig spec in the supplied credentials content. When the
+// spec configures no environment, only the endpoints the credentials content
+// omits are set, to those of the Azure public cloud.
+func SetEnvironment(creds map[string]string, spec v1beta1.ProviderConfigSpec) error {
+	switch spec.Environment {
+	case "":
+		setEndpoints(creds, environmentEndpoints(azure.PublicCloud), false)
+	case v1beta1.EnvironmentCustom:
+		if spec.CustomEnvironment == nil {
+			return errors.New(errNoCustomEnvironment)
+		}
+		setEndpoints(creds, *spec.CustomEnvironment, true)
+	default:
+		env, err := azure.EnvironmentFromName(spec.Environment)
+		if err != nil {
+			return errors.Wrap(err, errGetEnvironment)
+		}
+		setEndpoints(creds, environmentEndpoints(env), true)
+	}
+	return nil
+}
+
+func environmentEndpoints(env azure.Environment) v1beta1.EnvironmentEndpoints {
+	return v1beta1.EnvironmentEndpoints{
+		ResourceManagerEndpoint: env.ResourceManagerEndpoint,
+		ActiveDirectoryEndpoint: env.ActiveDirectoryEndpoint,
+		GraphEndpoint:           env.GraphEndpoint,
+		StorageEndpointSuffix:   env.StorageEndpointSuffix,
+		KeyVaultDNSSuffix:       env.KeyVaultDNSSuffix,
+	}
+}
+
+// setEndpoints sets the supplied endpoints in the supplied credentials
+// content. Empty endpoints are never set. Endpoints that the content already
+// specifies are only set if override is true.
+func setEndpoints(creds map[string]string, e v1beta1.EnvironmentEndpoints, override bool) {
+	for k, v := range map[string]string{
+		CredentialsKeyResourceManagerEndpointURL:     e.ResourceManagerEndpoint,
+		CredentialsKeyActiveDirectoryEndpointURL:     e.ActiveDirectoryEndpoint,
+		CredentialsKeyActiveDirectoryGraphResourceID: e.GraphEndpoint,
+		CredentialsKeyStorageEndpointSuffix:          e.StorageEndpointSuffix,
+		CredentialsKeyKeyVaultDNSSuffix:              e.KeyVaultDNSSuffix,
+	} {
+		if v == "" || (creds[k] != "" && !override) {
+			continue
+		}
+		creds[k] = v
+	}
+}
+
+// BlobEndpoint returns the blob service endpoint of the supplied storage
+// account in the Azure cloud of the supplied credentials content.
+func BlobEndpoint(creds map[string]string, account string) (string, error) {
+	suffix := creds[CredentialsKeyStorageEndpointSuffix]
+	if suffix == "" {
+		return "", errors.New(errNoStorageSuffix)
+	}
+	return fmt.Sprintf("https://%s.blob.%s", account, suffix), nil
+}
+
+// KeyVaultResource returns the resource for which tokens must be acquired to
+// access Key Vaults in the Azure cloud of the supplied credentials content.
+func KeyVaultResource(creds map[string]string) (string, error) {
+	suffix := creds[CredentialsKeyKeyVaultDNSSuffix]
+	if suffix == "" {
+		return "", errors.New(errNoKeyVaultSuffix)
+	}
+	return "https://" + suffix, nil
+}
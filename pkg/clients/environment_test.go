@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+func TestSetEnvironment(t *testing.T) {
+	type args struct {
+		creds map[string]string
+		spec  v1beta1.ProviderConfigSpec
+	}
+	type want struct {
+		creds map[string]string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"DefaultToPublicCloud": {
+			reason: "Endpoints omitted by the credentials should default to those of the public cloud.",
+			args: args{
+				creds: map[string]string{
+					CredentialsKeySubscriptionID:             testSubscriptionID,
+					CredentialsKeyResourceManagerEndpointURL: "https://management.local.azurestack.external/",
+				},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeySubscriptionID:                 testSubscriptionID,
+				CredentialsKeyResourceManagerEndpointURL:     "https://management.local.azurestack.external/",
+				CredentialsKeyActiveDirectoryEndpointURL:     "https://login.microsoftonline.com/",
+				CredentialsKeyActiveDirectoryGraphResourceID: "https://graph.windows.net/",
+				CredentialsKeyStorageEndpointSuffix:          "core.windows.net",
+				CredentialsKeyKeyVaultDNSSuffix:              "vault.azure.net",
+			}},
+		},
+		"NamedEnvironment": {
+			reason: "The endpoints of a named environment should take precedence over those of the credentials.",
+			args: args{
+				creds: map[string]string{
+					CredentialsKeySubscriptionID:             testSubscriptionID,
+					CredentialsKeyResourceManagerEndpointURL: "https://management.azure.com/",
+				},
+				spec: v1beta1.ProviderConfigSpec{Environment: v1beta1.EnvironmentChinaCloud},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeySubscriptionID:                 testSubscriptionID,
+				CredentialsKeyResourceManagerEndpointURL:     "https://management.chinacloudapi.cn/",
+				CredentialsKeyActiveDirectoryEndpointURL:     "https://login.chinacloudapi.cn/",
+				CredentialsKeyActiveDirectoryGraphResourceID: "https://graph.chinacloudapi.cn/",
+				CredentialsKeyStorageEndpointSuffix:          "core.chinacloudapi.cn",
+				CredentialsKeyKeyVaultDNSSuffix:              "vault.azure.cn",
+			}},
+		},
+		"CustomEnvironment": {
+			reason: "The endpoints of a custom environment should take precedence over those of the credentials.",
+			args: args{
+				creds: map[string]string{
+					CredentialsKeyResourceManagerEndpointURL:     "https://management.azure.com/",
+					CredentialsKeyActiveDirectoryGraphResourceID: "https://graph.windows.net/",
+				},
+				spec: v1beta1.ProviderConfigSpec{
+					Environment: v1beta1.EnvironmentCustom,
+					CustomEnvironment: &v1beta1.EnvironmentEndpoints{
+						ResourceManagerEndpoint: "https://management.local.azurestack.external/",
+						ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/",
+						StorageEndpointSuffix:   "local.azurestack.external",
+					},
+				},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeyResourceManagerEndpointURL:     "https://management.local.azurestack.external/",
+				CredentialsKeyActiveDirectoryEndpointURL:     "https://adfs.local.azurestack.external/",
+				CredentialsKeyActiveDirectoryGraphResourceID: "https://graph.windows.net/",
+				CredentialsKeyStorageEndpointSuffix:          "local.azurestack.external",
+			}},
+		},
+		"CustomEnvironmentMissing": {
+			reason: "A custom environment requires its endpoints.",
+			args: args{
+				creds: map[string]string{},
+				spec:  v1beta1.ProviderConfigSpec{Environment: v1beta1.EnvironmentCustom},
+			},
+			want: want{creds: map[string]string{}, err: errors.New(errNoCustomEnvironment)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := SetEnvironment(tc.args.creds, tc.args.spec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSetEnvironment(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, tc.args.creds); diff != "" {
+				t.Errorf("\n%s\nSetEnvironment(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBlobEndpoint(t *testing.T) {
+	type want struct {
+		endpoint string
+		err      error
+	}
+
+	cases := map[string]struct {
+		creds map[string]string
+		want  want
+	}{
+		"Suffix": {
+			creds: map[string]string{CredentialsKeyStorageEndpointSuffix: "core.usgovcloudapi.net"},
+			want:  want{endpoint: "https://account.blob.core.usgovcloudapi.net"},
+		},
+		"NoSuffix": {
+			creds: map[string]string{},
+			want:  want{err: errors.New(errNoStorageSuffix)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := BlobEndpoint(tc.creds, "account")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("BlobEndpoint(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoint, got); diff != "" {
+				t.Errorf("BlobEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+// Environment variables injected into pods by Azure AD workload identity.
+const (
+	EnvClientID           = "AZURE_CLIENT_ID"
+	EnvTenantID           = "AZURE_TENANT_ID"
+	EnvFederatedTokenFile = "AZURE_FEDERATED_TOKEN_FILE"
+	EnvAuthorityHost      = "AZURE_AUTHORITY_HOST"
+)
+
+const (
+	// DefaultIMDSTokenEndpoint is the token endpoint of the Azure instance
+	// metadata service.
+	DefaultIMDSTokenEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+	imdsAPIVersion      = "2018-02-01"
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// Error strings.
+const (
+	errNoIdentity             = "credentials source requires spec.credentials.identity"
+	errNoClientID             = "identity does not specify a client ID"
+	errNoTenantID             = "identity does not specify a tenant ID"
+	errNoTokenFile            = "identity does not specify a federated token file"
+	errNoCredentials          = "credentials specify neither a client secret, a client certificate, a federated token file, nor a managed identity endpoint"
+	errNewOAuthConfig         = "cannot create OAuth configuration"
+	errNewToken               = "cannot create service principal token"
+	errReadFederatedToken     = "cannot read federated token file"
+	errRequestIMDSToken       = "cannot request token from instance metadata service"
+	errUnmarshalIMDSToken     = "cannot unmarshal token returned by instance metadata service"
+	errFmtIMDSStatus          = "instance metadata service returned status code %d: %s"
+	errFmtUnsupportedIdentity = "unsupported identity credentials source %q"
+)
+
+// IsIdentitySource returns true if the supplied credentials source
+// authenticates using an Azure AD identity rather than a credentials blob.
+func IsIdentitySource(s xpv1.CredentialsSource) bool {
+	switch s { // nolint:exhaustive
+	case v1beta1.CredentialsSourceWorkloadIdentity,
+		v1beta1.CredentialsSourceUserAssignedManagedIdentity,
+		v1beta1.CredentialsSourceSystemAssignedManagedIdentity:
+		return true
+	}
+	return false
+}
+
+// IdentityCredentials returns credentials content, keyed like the JSON
+// credentials blob, that authenticates as the supplied identity. The content
+// does not include the endpoints of the Azure cloud; see SetEnvironment. Unset
+// workload identity fields are defaulted from the environment variables
+// injected by the Azure AD workload identity webhook.
+func IdentityCredentials(s xpv1.CredentialsSource, id *v1beta1.IdentityCredentials) (map[string]string, error) {
+	if id == nil {
+		return nil, errors.New(errNoIdentity)
+	}
+	m := map[string]string{
+		CredentialsKeySubscriptionID: id.SubscriptionID,
+		CredentialsKeyClientID:       id.ClientID,
+		CredentialsKeyTenantID:       id.TenantID,
+	}
+
+	switch s { // nolint:exhaustive
+	case v1beta1.CredentialsSourceWorkloadIdentity:
+		m[CredentialsKeyClientID] = valueOrEnv(id.ClientID, EnvClientID)
+		m[CredentialsKeyTenantID] = valueOrEnv(id.TenantID, EnvTenantID)
+		m[CredentialsKeyFederatedTokenFile] = valueOrEnv(id.TokenFilePath, EnvFederatedTokenFile)
+		m[CredentialsKeyActiveDirectoryEndpointURL] = valueOrEnv(id.Endpoint, EnvAuthorityHost)
+		switch {
+		case m[CredentialsKeyClientID] == "":
+			return nil, errors.New(errNoClientID)
+		case m[CredentialsKeyTenantID] == "":
+			return nil, errors.New(errNoTenantID)
+		case m[CredentialsKeyFederatedTokenFile] == "":
+			return nil, errors.New(errNoTokenFile)
+		}
+	case v1beta1.CredentialsSourceUserAssignedManagedIdentity:
+		if id.ClientID == "" {
+			return nil, errors.New(errNoClientID)
+		}
+		m[CredentialsKeyMSIEndpoint] = valueOrDefault(id.Endpoint, DefaultIMDSTokenEndpoint)
+	case v1beta1.CredentialsSourceSystemAssignedManagedIdentity:
+		// A client ID would select a user-assigned identity.
+		m[CredentialsKeyClientID] = ""
+		m[CredentialsKeyMSIEndpoint] = valueOrDefault(id.Endpoint, DefaultIMDSTokenEndpoint)
+	default:
+		return nil, errors.Errorf(errFmtUnsupportedIdentity, s)
+	}
+	return m, nil
+}
+
+// NewAuthorizer returns an authorizer for the Azure Resource Manager API that
+// authenticates using the supplied credentials content.
+func NewAuthorizer(creds map[string]string) (autorest.Authorizer, error) {
+	t, err := NewServicePrincipalToken(creds, creds[CredentialsKeyResourceManagerEndpointURL])
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(t), nil
+}
+
+// NewServicePrincipalToken returns a token for the supplied resource that
+// authenticates using the supplied credentials content. The content may
+// describe a service principal with a client secret, a client certificate or a
+// federated token file, or a managed identity.
+func NewServicePrincipalToken(creds map[string]string, resource string) (*adal.ServicePrincipalToken, error) {
+	resource = valueOrDefault(resource, azure.PublicCloud.ResourceManagerEndpoint)
+
+	if ep := creds[CredentialsKeyMSIEndpoint]; ep != "" {
+		u, err := url.Parse(ep)
+		if err != nil {
+			return nil, errors.Wrap(err, errNewOAuthConfig)
+		}
+		// The instance metadata service does not speak the OAuth client
+		// credentials flow, so we acquire its tokens ourselves. The token
+		// requires a client ID even though it never sends it, so we use a
+		// placeholder for the system-assigned identity.
+		cfg := adal.OAuthConfig{TokenEndpoint: *u}
+		t, err := adal.NewServicePrincipalTokenWithSecret(cfg, valueOrDefault(creds[CredentialsKeyClientID], "system"), resource, &adal.ServicePrincipalNoSecret{})
+		if err != nil {
+			return nil, errors.Wrap(err, errNewToken)
+		}
+		t.SetCustomRefreshFunc(imdsTokenRefresher(ep, creds[CredentialsKeyClientID]))
+		return t, nil
+	}
+
+	cfg, err := adal.NewOAuthConfig(valueOrDefault(creds[CredentialsKeyActiveDirectoryEndpointURL], azure.PublicCloud.ActiveDirectoryEndpoint), creds[CredentialsKeyTenantID])
+	if err != nil {
+		return nil, errors.Wrap(err, errNewOAuthConfig)
+	}
+
+	var secret adal.ServicePrincipalSecret
+	switch {
+	case creds[CredentialsKeyFederatedTokenFile] != "":
+		secret = &federatedTokenSecret{path: creds[CredentialsKeyFederatedTokenFile]}
+	case creds[CredentialsKeyClientCertificate] != "":
+		cert, key, err := ParseClientCertificate([]byte(creds[CredentialsKeyClientCertificate]), creds[CredentialsKeyClientCertificatePassword])
+		if err != nil {
+			return nil, err
+		}
+		secret = &adal.ServicePrincipalCertificateSecret{Certificate: cert, PrivateKey: key}
+	case creds[CredentialsKeyClientSecret] != "":
+		secret = &adal.ServicePrincipalTokenSecret{ClientSecret: creds[CredentialsKeyClientSecret]}
+	default:
+		return nil, errors.New(errNoCredentials)
+	}
+
+	t, err := adal.NewServicePrincipalTokenWithSecret(*cfg, creds[CredentialsKeyClientID], resource, secret)
+	return t, errors.Wrap(err, errNewToken)
+}
+
+// A federatedTokenSecret authenticates a service principal using a token read
+// from a file, for example a projected Kubernetes service account token. The
+// file is read each time a token is requested because the kubelet rotates it.
+type federatedTokenSecret struct {
+	path string
+}
+
+// SetAuthenticationValues adds the federated token to the token request as a
+// client assertion.
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return errors.Wrap(err, errReadFederatedToken)
+	}
+	v.Set("client_assertion", strings.TrimSpace(string(b)))
+	v.Set("client_assertion_type", clientAssertionType)
+	return nil
+}
+
+// imdsTokenRefresher returns a function that requests a token for a managed
+// identity from the supplied instance metadata service endpoint. The
+// system-assigned identity is used if clientID is empty.
+func imdsTokenRefresher(endpoint, clientID string) adal.TokenRefresh {
+	return func(ctx context.Context, resource string) (*adal.Token, error) {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, errRequestIMDSToken)
+		}
+		q := u.Query()
+		q.Set("api-version", imdsAPIVersion)
+		q.Set("resource", resource)
+		if clientID != "" {
+			q.Set("client_id", clientID)
+		}
+		u.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, errors.Wrap(err, errRequestIMDSToken)
+		}
+		req.Header.Set("Metadata", "true")
+
+		rsp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, errors.Wrap(err, errRequestIMDSToken)
+		}
+		defer rsp.Body.Close() // nolint:errcheck
+		b, err := ioutil.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, errRequestIMDSToken)
+		}
+		if rsp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf(errFmtIMDSStatus, rsp.StatusCode, string(b))
+		}
+
+		t := &adal.Token{}
+		return t, errors.Wrap(json.Unmarshal(b, t), errUnmarshalIMDSToken)
+	}
+}
+
+func valueOrEnv(v, env string) string {
+	return valueOrDefault(v, os.Getenv(env))
+}
+
+func valueOrDefault(v, def string) string {
+	if v != "" {
+		return v
+	}
+	return def
+}
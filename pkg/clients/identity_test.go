@@ -0,0 +1,360 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+const (
+	testSubscriptionID = "bf1b0e59-93da-42e0-82c6-5a1d94227911"
+	testTenantID       = "302de427-dba9-4452-8583-a4268e46de6b"
+	testClientID       = "0f32e96b-b9a4-49ce-a857-243a33b20e5c"
+	testAccessToken    = "cool-token"
+	testFederatedToken = "projected-service-account-token"
+)
+
+func TestIdentityCredentials(t *testing.T) {
+	type args struct {
+		source xpv1.CredentialsSource
+		id     *v1beta1.IdentityCredentials
+	}
+	type want struct {
+		creds map[string]string
+		err   error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoIdentity": {
+			args: args{source: v1beta1.CredentialsSourceWorkloadIdentity},
+			want: want{err: errors.New(errNoIdentity)},
+		},
+		"UnsupportedSource": {
+			args: args{
+				source: xpv1.CredentialsSourceSecret,
+				id:     &v1beta1.IdentityCredentials{SubscriptionID: testSubscriptionID},
+			},
+			want: want{err: errors.Errorf(errFmtUnsupportedIdentity, xpv1.CredentialsSourceSecret)},
+		},
+		"WorkloadIdentity": {
+			args: args{
+				source: v1beta1.CredentialsSourceWorkloadIdentity,
+				id: &v1beta1.IdentityCredentials{
+					SubscriptionID: testSubscriptionID,
+					TenantID:       testTenantID,
+					ClientID:       testClientID,
+					TokenFilePath:  "/var/run/secrets/token",
+					Endpoint:       "https://login.example.org/",
+				},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeySubscriptionID:             testSubscriptionID,
+				CredentialsKeyTenantID:                   testTenantID,
+				CredentialsKeyClientID:                   testClientID,
+				CredentialsKeyFederatedTokenFile:         "/var/run/secrets/token",
+				CredentialsKeyActiveDirectoryEndpointURL: "https://login.example.org/",
+			}},
+		},
+		"WorkloadIdentityNoTokenFile": {
+			args: args{
+				source: v1beta1.CredentialsSourceWorkloadIdentity,
+				id: &v1beta1.IdentityCredentials{
+					SubscriptionID: testSubscriptionID,
+					TenantID:       testTenantID,
+					ClientID:       testClientID,
+				},
+			},
+			want: want{err: errors.New(errNoTokenFile)},
+		},
+		"UserAssignedManagedIdentity": {
+			args: args{
+				source: v1beta1.CredentialsSourceUserAssignedManagedIdentity,
+				id: &v1beta1.IdentityCredentials{
+					SubscriptionID: testSubscriptionID,
+					ClientID:       testClientID,
+				},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeySubscriptionID: testSubscriptionID,
+				CredentialsKeyTenantID:       "",
+				CredentialsKeyClientID:       testClientID,
+				CredentialsKeyMSIEndpoint:    DefaultIMDSTokenEndpoint,
+			}},
+		},
+		"UserAssignedManagedIdentityNoClientID": {
+			args: args{
+				source: v1beta1.CredentialsSourceUserAssignedManagedIdentity,
+				id:     &v1beta1.IdentityCredentials{SubscriptionID: testSubscriptionID},
+			},
+			want: want{err: errors.New(errNoClientID)},
+		},
+		"SystemAssignedManagedIdentity": {
+			args: args{
+				source: v1beta1.CredentialsSourceSystemAssignedManagedIdentity,
+				id: &v1beta1.IdentityCredentials{
+					SubscriptionID: testSubscriptionID,
+					ClientID:       testClientID,
+					Endpoint:       "http://127.0.0.1/token",
+				},
+			},
+			want: want{creds: map[string]string{
+				CredentialsKeySubscriptionID: testSubscriptionID,
+				CredentialsKeyTenantID:       "",
+				CredentialsKeyClientID:       "",
+				CredentialsKeyMSIEndpoint:    "http://127.0.0.1/token",
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IdentityCredentials(tc.args.source, tc.args.id)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("IdentityCredentials(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, got); diff != "" {
+				t.Errorf("IdentityCredentials(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIdentityCredentialsFromEnvironment(t *testing.T) {
+	t.Setenv(EnvClientID, testClientID)
+	t.Setenv(EnvTenantID, testTenantID)
+	t.Setenv(EnvFederatedTokenFile, "/var/run/secrets/azure/tokens/azure-identity-token")
+	t.Setenv(EnvAuthorityHost, "https://login.microsoftonline.us/")
+
+	got, err := IdentityCredentials(v1beta1.CredentialsSourceWorkloadIdentity, &v1beta1.IdentityCredentials{SubscriptionID: testSubscriptionID})
+	if err != nil {
+		t.Fatalf("IdentityCredentials(...): %s", err)
+	}
+	want := map[string]string{
+		CredentialsKeySubscriptionID:             testSubscriptionID,
+		CredentialsKeyTenantID:                   testTenantID,
+		CredentialsKeyClientID:                   testClientID,
+		CredentialsKeyFederatedTokenFile:         "/var/run/secrets/azure/tokens/azure-identity-token",
+		CredentialsKeyActiveDirectoryEndpointURL: "https://login.microsoftonline.us/",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("IdentityCredentials(...): -want, +got:\n%s", diff)
+	}
+}
+
+// tokenResponse writes an access token in the format used by both Azure AD
+// and the instance metadata service.
+func tokenResponse(w http.ResponseWriter, resource string) {
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"access_token": testAccessToken,
+		"expires_in":   "3600",
+		"expires_on":   strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+		"resource":     resource,
+		"token_type":   "Bearer",
+	})
+}
+
+// fakeAAD returns a handler that issues tokens if the client credentials
+// grant contains the supplied form values.
+func fakeAAD(t *testing.T, want map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != fmt.Sprintf("/%s/oauth2/token", testTenantID) {
+			t.Errorf("fake AAD: unexpected path %q", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("fake AAD: cannot parse form: %s", err)
+		}
+		for k, v := range want {
+			if diff := cmp.Diff(v, r.PostForm.Get(k)); diff != "" {
+				t.Errorf("fake AAD: form value %q: -want, +got:\n%s", k, diff)
+			}
+		}
+		tokenResponse(w, r.PostForm.Get("resource"))
+	}
+}
+
+// fakeIMDS returns a handler that issues tokens if the request is a valid
+// instance metadata service request for the supplied client ID.
+func fakeIMDS(t *testing.T, clientID string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("fake IMDS: unexpected method %q", r.Method)
+		}
+		if r.Header.Get("Metadata") != "true" {
+			t.Errorf("fake IMDS: missing Metadata header")
+		}
+		q := r.URL.Query()
+		if diff := cmp.Diff(clientID, q.Get("client_id")); diff != "" {
+			t.Errorf("fake IMDS: client_id: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(imdsAPIVersion, q.Get("api-version")); diff != "" {
+			t.Errorf("fake IMDS: api-version: -want, +got:\n%s", diff)
+		}
+		tokenResponse(w, q.Get("resource"))
+	}
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte(testFederatedToken+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		handler http.HandlerFunc
+		creds   func(endpoint string) map[string]string
+	}
+	type want struct {
+		header string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ClientSecret": {
+			args: args{
+				handler: fakeAAD(t, map[string]string{
+					"grant_type":    "client_credentials",
+					"client_id":     testClientID,
+					"client_secret": "cool-secret",
+				}),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{
+						CredentialsKeyClientID:                   testClientID,
+						CredentialsKeyClientSecret:               "cool-secret",
+						CredentialsKeyTenantID:                   testTenantID,
+						CredentialsKeyActiveDirectoryEndpointURL: endpoint,
+					}
+				},
+			},
+			want: want{header: "Bearer " + testAccessToken},
+		},
+		"ClientCertificate": {
+			args: args{
+				handler: fakeAAD(t, map[string]string{
+					"grant_type":            "client_credentials",
+					"client_id":             testClientID,
+					"client_assertion_type": clientAssertionType,
+				}),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{
+						CredentialsKeyClientID:                   testClientID,
+						CredentialsKeyClientCertificate:          string(readTestData(t, "client.pem")),
+						CredentialsKeyTenantID:                   testTenantID,
+						CredentialsKeyActiveDirectoryEndpointURL: endpoint,
+					}
+				},
+			},
+			want: want{header: "Bearer " + testAccessToken},
+		},
+		"WorkloadIdentity": {
+			args: args{
+				handler: fakeAAD(t, map[string]string{
+					"grant_type":            "client_credentials",
+					"client_id":             testClientID,
+					"client_assertion":      testFederatedToken,
+					"client_assertion_type": clientAssertionType,
+					"resource":              azure.PublicCloud.ResourceManagerEndpoint,
+				}),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{
+						CredentialsKeyClientID:                   testClientID,
+						CredentialsKeyTenantID:                   testTenantID,
+						CredentialsKeyFederatedTokenFile:         tokenFile,
+						CredentialsKeyActiveDirectoryEndpointURL: endpoint,
+					}
+				},
+			},
+			want: want{header: "Bearer " + testAccessToken},
+		},
+		"UserAssignedManagedIdentity": {
+			args: args{
+				handler: fakeIMDS(t, testClientID),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{
+						CredentialsKeyClientID:    testClientID,
+						CredentialsKeyMSIEndpoint: endpoint,
+					}
+				},
+			},
+			want: want{header: "Bearer " + testAccessToken},
+		},
+		"SystemAssignedManagedIdentity": {
+			args: args{
+				handler: fakeIMDS(t, ""),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{CredentialsKeyMSIEndpoint: endpoint}
+				},
+			},
+			want: want{header: "Bearer " + testAccessToken},
+		},
+		"NoCredentials": {
+			args: args{
+				handler: fakeAAD(t, nil),
+				creds: func(endpoint string) map[string]string {
+					return map[string]string{CredentialsKeyTenantID: testTenantID}
+				},
+			},
+			want: want{err: errors.New(errNoCredentials)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(tc.args.handler)
+			defer srv.Close()
+
+			a, err := NewAuthorizer(tc.args.creds(srv.URL))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("NewAuthorizer(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			r, err := autorest.Prepare(&http.Request{}, a.WithAuthorization())
+			if err != nil {
+				t.Fatalf("WithAuthorization(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.header, r.Header.Get("Authorization")); diff != "" {
+				t.Errorf("WithAuthorization(...): -want Authorization header, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package secret
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
@@ -40,6 +41,16 @@ const (
 	errCheckUpToDate = "unable to determine if external resource is up to date"
 )
 
+// VaultBaseURL returns the base URL of the supplied vault. A vault that is
+// specified by name rather than URL is assumed to be reachable at the supplied
+// Key Vault DNS suffix.
+func VaultBaseURL(vault, dnsSuffix string) string {
+	if strings.Contains(vault, "://") {
+		return vault
+	}
+	return "https://" + vault + "." + dnsSuffix
+}
+
 // GenerateObservation produces a KeyVaultSecretObservation object from the keyvault.SecretBundle
 // received from Azure.
 func GenerateObservation(az keyvault.SecretBundle) v1alpha1.KeyVaultSecretObservation {
@@ -113,6 +113,35 @@ func TestGenerateObservation(t *testing.T) {
 	}
 }
 
+func TestVaultBaseURL(t *testing.T) {
+	type args struct {
+		vault     string
+		dnsSuffix string
+	}
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"URL": {
+			args: args{vault: "https://myvault.vault.azure.net/", dnsSuffix: "vault.azure.cn"},
+			want: "https://myvault.vault.azure.net/",
+		},
+		"Name": {
+			args: args{vault: "myvault", dnsSuffix: "vault.azure.cn"},
+			want: "https://myvault.vault.azure.cn",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VaultBaseURL(tc.args.vault, tc.args.dnsSuffix)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VaultBaseURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateAttributes(t *testing.T) {
 	cases := map[string]struct {
 		arg  *v1alpha1.KeyVaultSecretAttributesParameters
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi/msiapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ msiapi.UserAssignedIdentitiesClientAPI = &MockUserAssignedIdentitiesClient{}
+
+// MockUserAssignedIdentitiesClient is a fake implementation of the Azure
+// user-assigned identities client.
+type MockUserAssignedIdentitiesClient struct {
+	msiapi.UserAssignedIdentitiesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.Identity) (msi.Identity, error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.IdentityUpdate) (msi.Identity, error)
+	MockGet            func(ctx context.Context, resourceGroupName string, resourceName string) (msi.Identity, error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, resourceName string) (autorest.Response, error)
+}
+
+// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
+func (m *MockUserAssignedIdentitiesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.Identity) (msi.Identity, error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, resourceName, parameters)
+}
+
+// Update calls the underlying MockUpdate method.
+func (m *MockUserAssignedIdentitiesClient) Update(ctx context.Context, resourceGroupName string, resourceName string, parameters msi.IdentityUpdate) (msi.Identity, error) {
+	return m.MockUpdate(ctx, resourceGroupName, resourceName, parameters)
+}
+
+// Get calls the underlying MockGet method.
+func (m *MockUserAssignedIdentitiesClient) Get(ctx context.Context, resourceGroupName string, resourceName string) (msi.Identity, error) {
+	return m.MockGet(ctx, resourceGroupName, resourceName)
+}
+
+// Delete calls the underlying MockDelete method.
+func (m *MockUserAssignedIdentitiesClient) Delete(ctx context.Context, resourceGroupName string, resourceName string) (autorest.Response, error) {
+	return m.MockDelete(ctx, resourceGroupName, resourceName)
+}
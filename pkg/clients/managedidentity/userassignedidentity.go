@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedidentity
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi/msiapi"
+	"github.com/gofrs/uuid"
+
+	"github.com/crossplane-contrib/provider-azure/apis/managedidentity/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// A UserAssignedIdentitiesClient handles CRUD operations for Azure
+// user-assigned managed identities.
+type UserAssignedIdentitiesClient msiapi.UserAssignedIdentitiesClientAPI
+
+// NewIdentity returns user-assigned identity creation parameters suitable for
+// use with the Azure API.
+func NewIdentity(p v1alpha1.UserAssignedIdentityParameters, d azure.ResourceDefaults) msi.Identity {
+	return msi.Identity{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location)),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+}
+
+// NewIdentityUpdate returns user-assigned identity update parameters suitable
+// for use with the Azure API.
+func NewIdentityUpdate(p v1alpha1.UserAssignedIdentityParameters, i msi.Identity, d azure.ResourceDefaults) msi.IdentityUpdate {
+	return msi.IdentityUpdate{Tags: d.UpdateTags(d.MergeTags(p.Tags), i.Tags)}
+}
+
+// LateInitializeIdentity fills the empty fields of the supplied parameters
+// with the values of the supplied user-assigned identity.
+func LateInitializeIdentity(p *v1alpha1.UserAssignedIdentityParameters, i msi.Identity, d azure.ResourceDefaults) {
+	if p.Location == "" {
+		p.Location = azure.ToString(i.Location)
+	}
+	p.Tags = d.LateInitializeTags(p.Tags, i.Tags)
+}
+
+// IsIdentityUpToDate returns true if the supplied user-assigned identity is up
+// to date with the supplied parameters.
+func IsIdentityUpToDate(p v1alpha1.UserAssignedIdentityParameters, i msi.Identity, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(d.MergeTags(p.Tags), i.Tags)
+}
+
+// GenerateObservation produces a UserAssignedIdentityObservation from the
+// supplied user-assigned identity.
+func GenerateObservation(i msi.Identity) v1alpha1.UserAssignedIdentityObservation {
+	o := v1alpha1.UserAssignedIdentityObservation{ID: azure.ToString(i.ID)}
+	if i.UserAssignedIdentityProperties != nil {
+		o.PrincipalID = uuidString(i.PrincipalID)
+		o.ClientID = uuidString(i.ClientID)
+		o.TenantID = uuidString(i.TenantID)
+	}
+	return o
+}
+
+func uuidString(u *uuid.UUID) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedidentity
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/managedidentity/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var (
+	id       = "/subscriptions/sub/resourceGroups/cool-group/providers/Microsoft.ManagedIdentity/userAssignedIdentities/cool-identity"
+	location = "westeurope"
+	uid      = uuid.Must(uuid.FromString("3f1f2a3b-5c4d-4e6f-8a9b-0c1d2e3f4a5b"))
+	defaults = azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}, Location: "northeurope"}
+)
+
+func TestNewIdentity(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.UserAssignedIdentityParameters
+		want msi.Identity
+	}{
+		"Defaults": {
+			p: v1alpha1.UserAssignedIdentityParameters{},
+			want: msi.Identity{
+				Location: to.StringPtr("northeurope"),
+				Tags:     map[string]*string{"owner": to.StringPtr("finance")},
+			},
+		},
+		"Full": {
+			p: v1alpha1.UserAssignedIdentityParameters{Location: location, Tags: map[string]string{"owner": "ops"}},
+			want: msi.Identity{
+				Location: to.StringPtr(location),
+				Tags:     map[string]*string{"owner": to.StringPtr("ops")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewIdentity(tc.p, defaults)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewIdentity(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeIdentity(t *testing.T) {
+	i := msi.Identity{
+		Location: to.StringPtr(location),
+		Tags:     map[string]*string{"owner": to.StringPtr("finance"), "team": to.StringPtr("cool")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.UserAssignedIdentityParameters
+		want v1alpha1.UserAssignedIdentityParameters
+	}{
+		"Empty": {
+			p:    v1alpha1.UserAssignedIdentityParameters{},
+			want: v1alpha1.UserAssignedIdentityParameters{Location: location, Tags: map[string]string{"team": "cool"}},
+		},
+		"AlreadySet": {
+			p:    v1alpha1.UserAssignedIdentityParameters{Location: "eastus", Tags: map[string]string{"a": "b"}},
+			want: v1alpha1.UserAssignedIdentityParameters{Location: "eastus", Tags: map[string]string{"a": "b"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeIdentity(&tc.p, i, defaults)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeIdentity(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIdentityUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.UserAssignedIdentityParameters
+		i    msi.Identity
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.UserAssignedIdentityParameters{Tags: map[string]string{"team": "cool"}},
+			i:    msi.Identity{Tags: map[string]*string{"owner": to.StringPtr("finance"), "team": to.StringPtr("cool"), "extra": to.StringPtr("x")}},
+			want: true,
+		},
+		"MissingDefaultTags": {
+			p:    v1alpha1.UserAssignedIdentityParameters{},
+			i:    msi.Identity{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsIdentityUpToDate(tc.p, tc.i, defaults)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsIdentityUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	i := msi.Identity{
+		ID: to.StringPtr(id),
+		UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{
+			PrincipalID: &uid,
+			ClientID:    &uid,
+			TenantID:    &uid,
+		},
+	}
+	want := v1alpha1.UserAssignedIdentityObservation{ID: id, PrincipalID: uid.String(), ClientID: uid.String(), TenantID: uid.String()}
+	if diff := cmp.Diff(want, GenerateObservation(i)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
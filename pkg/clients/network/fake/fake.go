@@ -21,6 +21,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	network2021 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	networkapi2021 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network/networkapi"
 )
 
 var _ networkapi.VirtualNetworksClientAPI = &MockVirtualNetworksClient{}
@@ -118,3 +120,304 @@ func (c *MockPublicIPAddressClient) Get(ctx context.Context, resourceGroupName s
 func (c *MockPublicIPAddressClient) List(ctx context.Context, resourceGroupName string) (result network.PublicIPAddressListResultPage, err error) {
 	return c.MockList(ctx, resourceGroupName)
 }
+
+var _ networkapi.SecurityGroupsClientAPI = &MockSecurityGroupsClient{}
+
+// MockSecurityGroupsClient is a fake implementation of network.SecurityGroupsClient.
+type MockSecurityGroupsClient struct {
+	networkapi.SecurityGroupsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.SecurityGroup) (result network.SecurityGroupsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string) (result network.SecurityGroupsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, expand string) (result network.SecurityGroup, err error)
+	MockUpdateTags     func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.TagsObject) (result network.SecurityGroupsUpdateTagsFuture, err error)
+}
+
+// CreateOrUpdate calls the MockSecurityGroupsClient's MockCreateOrUpdate method.
+func (c *MockSecurityGroupsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.SecurityGroup) (result network.SecurityGroupsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, networkSecurityGroupName, parameters)
+}
+
+// Delete calls the MockSecurityGroupsClient's MockDelete method.
+func (c *MockSecurityGroupsClient) Delete(ctx context.Context, resourceGroupName string, networkSecurityGroupName string) (result network.SecurityGroupsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, networkSecurityGroupName)
+}
+
+// Get calls the MockSecurityGroupsClient's MockGet method.
+func (c *MockSecurityGroupsClient) Get(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, expand string) (result network.SecurityGroup, err error) {
+	return c.MockGet(ctx, resourceGroupName, networkSecurityGroupName, expand)
+}
+
+// UpdateTags calls the MockSecurityGroupsClient's MockUpdateTags method.
+func (c *MockSecurityGroupsClient) UpdateTags(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.TagsObject) (result network.SecurityGroupsUpdateTagsFuture, err error) {
+	return c.MockUpdateTags(ctx, resourceGroupName, networkSecurityGroupName, parameters)
+}
+
+var _ networkapi.SecurityRulesClientAPI = &MockSecurityRulesClient{}
+
+// MockSecurityRulesClient is a fake implementation of network.SecurityRulesClient.
+type MockSecurityRulesClient struct {
+	networkapi.SecurityRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string, securityRuleParameters network.SecurityRule) (result network.SecurityRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string) (result network.SecurityRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string) (result network.SecurityRule, err error)
+}
+
+// CreateOrUpdate calls the MockSecurityRulesClient's MockCreateOrUpdate method.
+func (c *MockSecurityRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string, securityRuleParameters network.SecurityRule) (result network.SecurityRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, networkSecurityGroupName, securityRuleName, securityRuleParameters)
+}
+
+// Delete calls the MockSecurityRulesClient's MockDelete method.
+func (c *MockSecurityRulesClient) Delete(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string) (result network.SecurityRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, networkSecurityGroupName, securityRuleName)
+}
+
+// Get calls the MockSecurityRulesClient's MockGet method.
+func (c *MockSecurityRulesClient) Get(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, securityRuleName string) (result network.SecurityRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, networkSecurityGroupName, securityRuleName)
+}
+
+var _ networkapi.RouteTablesClientAPI = &MockRouteTablesClient{}
+
+// MockRouteTablesClient is a fake implementation of network.RouteTablesClient.
+type MockRouteTablesClient struct {
+	networkapi.RouteTablesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, routeTableName string, parameters network.RouteTable) (result network.RouteTablesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, routeTableName string) (result network.RouteTablesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, routeTableName string, expand string) (result network.RouteTable, err error)
+}
+
+// CreateOrUpdate calls the MockRouteTablesClient's MockCreateOrUpdate method.
+func (c *MockRouteTablesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, routeTableName string, parameters network.RouteTable) (result network.RouteTablesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, routeTableName, parameters)
+}
+
+// Delete calls the MockRouteTablesClient's MockDelete method.
+func (c *MockRouteTablesClient) Delete(ctx context.Context, resourceGroupName string, routeTableName string) (result network.RouteTablesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, routeTableName)
+}
+
+// Get calls the MockRouteTablesClient's MockGet method.
+func (c *MockRouteTablesClient) Get(ctx context.Context, resourceGroupName string, routeTableName string, expand string) (result network.RouteTable, err error) {
+	return c.MockGet(ctx, resourceGroupName, routeTableName, expand)
+}
+
+var _ networkapi.RoutesClientAPI = &MockRoutesClient{}
+
+// MockRoutesClient is a fake implementation of network.RoutesClient.
+type MockRoutesClient struct {
+	networkapi.RoutesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, routeTableName string, routeName string, routeParameters network.Route) (result network.RoutesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, routeTableName string, routeName string) (result network.RoutesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, routeTableName string, routeName string) (result network.Route, err error)
+}
+
+// CreateOrUpdate calls the MockRoutesClient's MockCreateOrUpdate method.
+func (c *MockRoutesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, routeTableName string, routeName string, routeParameters network.Route) (result network.RoutesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, routeTableName, routeName, routeParameters)
+}
+
+// Delete calls the MockRoutesClient's MockDelete method.
+func (c *MockRoutesClient) Delete(ctx context.Context, resourceGroupName string, routeTableName string, routeName string) (result network.RoutesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, routeTableName, routeName)
+}
+
+// Get calls the MockRoutesClient's MockGet method.
+func (c *MockRoutesClient) Get(ctx context.Context, resourceGroupName string, routeTableName string, routeName string) (result network.Route, err error) {
+	return c.MockGet(ctx, resourceGroupName, routeTableName, routeName)
+}
+
+var _ networkapi.LoadBalancersClientAPI = &MockLoadBalancersClient{}
+
+// MockLoadBalancersClient is a fake implementation of network.LoadBalancersClient.
+type MockLoadBalancersClient struct {
+	networkapi.LoadBalancersClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, loadBalancerName string, parameters network.LoadBalancer) (result network.LoadBalancersCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, loadBalancerName string) (result network.LoadBalancersDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, loadBalancerName string, expand string) (result network.LoadBalancer, err error)
+}
+
+// CreateOrUpdate calls the MockLoadBalancersClient's MockCreateOrUpdate method.
+func (c *MockLoadBalancersClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, loadBalancerName string, parameters network.LoadBalancer) (result network.LoadBalancersCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, loadBalancerName, parameters)
+}
+
+// Delete calls the MockLoadBalancersClient's MockDelete method.
+func (c *MockLoadBalancersClient) Delete(ctx context.Context, resourceGroupName string, loadBalancerName string) (result network.LoadBalancersDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, loadBalancerName)
+}
+
+// Get calls the MockLoadBalancersClient's MockGet method.
+func (c *MockLoadBalancersClient) Get(ctx context.Context, resourceGroupName string, loadBalancerName string, expand string) (result network.LoadBalancer, err error) {
+	return c.MockGet(ctx, resourceGroupName, loadBalancerName, expand)
+}
+
+var _ networkapi.InterfacesClientAPI = &MockInterfacesClient{}
+
+// MockInterfacesClient is a fake implementation of network.InterfacesClient.
+type MockInterfacesClient struct {
+	networkapi.InterfacesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, networkInterfaceName string, parameters network.Interface) (result network.InterfacesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, networkInterfaceName string) (result network.InterfacesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, networkInterfaceName string, expand string) (result network.Interface, err error)
+}
+
+// CreateOrUpdate calls the MockInterfacesClient's MockCreateOrUpdate method.
+func (c *MockInterfacesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkInterfaceName string, parameters network.Interface) (result network.InterfacesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, networkInterfaceName, parameters)
+}
+
+// Delete calls the MockInterfacesClient's MockDelete method.
+func (c *MockInterfacesClient) Delete(ctx context.Context, resourceGroupName string, networkInterfaceName string) (result network.InterfacesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, networkInterfaceName)
+}
+
+// Get calls the MockInterfacesClient's MockGet method.
+func (c *MockInterfacesClient) Get(ctx context.Context, resourceGroupName string, networkInterfaceName string, expand string) (result network.Interface, err error) {
+	return c.MockGet(ctx, resourceGroupName, networkInterfaceName, expand)
+}
+
+var _ networkapi.PublicIPPrefixesClientAPI = &MockPublicIPPrefixesClient{}
+
+// MockPublicIPPrefixesClient is a fake implementation of network.PublicIPPrefixesClient.
+type MockPublicIPPrefixesClient struct {
+	networkapi.PublicIPPrefixesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, publicIPPrefixName string, parameters network.PublicIPPrefix) (result network.PublicIPPrefixesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, publicIPPrefixName string) (result network.PublicIPPrefixesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, publicIPPrefixName string, expand string) (result network.PublicIPPrefix, err error)
+	MockUpdateTags     func(ctx context.Context, resourceGroupName string, publicIPPrefixName string, parameters network.TagsObject) (result network.PublicIPPrefixesUpdateTagsFuture, err error)
+}
+
+// CreateOrUpdate calls the MockPublicIPPrefixesClient's MockCreateOrUpdate method.
+func (c *MockPublicIPPrefixesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, publicIPPrefixName string, parameters network.PublicIPPrefix) (result network.PublicIPPrefixesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, publicIPPrefixName, parameters)
+}
+
+// Delete calls the MockPublicIPPrefixesClient's MockDelete method.
+func (c *MockPublicIPPrefixesClient) Delete(ctx context.Context, resourceGroupName string, publicIPPrefixName string) (result network.PublicIPPrefixesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, publicIPPrefixName)
+}
+
+// Get calls the MockPublicIPPrefixesClient's MockGet method.
+func (c *MockPublicIPPrefixesClient) Get(ctx context.Context, resourceGroupName string, publicIPPrefixName string, expand string) (result network.PublicIPPrefix, err error) {
+	return c.MockGet(ctx, resourceGroupName, publicIPPrefixName, expand)
+}
+
+// UpdateTags calls the MockPublicIPPrefixesClient's MockUpdateTags method.
+func (c *MockPublicIPPrefixesClient) UpdateTags(ctx context.Context, resourceGroupName string, publicIPPrefixName string, parameters network.TagsObject) (result network.PublicIPPrefixesUpdateTagsFuture, err error) {
+	return c.MockUpdateTags(ctx, resourceGroupName, publicIPPrefixName, parameters)
+}
+
+var _ networkapi.NatGatewaysClientAPI = &MockNatGatewaysClient{}
+
+// MockNatGatewaysClient is a fake implementation of network.NatGatewaysClient.
+type MockNatGatewaysClient struct {
+	networkapi.NatGatewaysClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, natGatewayName string, parameters network.NatGateway) (result network.NatGatewaysCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, natGatewayName string) (result network.NatGatewaysDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, natGatewayName string, expand string) (result network.NatGateway, err error)
+}
+
+// CreateOrUpdate calls the MockNatGatewaysClient's MockCreateOrUpdate method.
+func (c *MockNatGatewaysClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, natGatewayName string, parameters network.NatGateway) (result network.NatGatewaysCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, natGatewayName, parameters)
+}
+
+// Delete calls the MockNatGatewaysClient's MockDelete method.
+func (c *MockNatGatewaysClient) Delete(ctx context.Context, resourceGroupName string, natGatewayName string) (result network.NatGatewaysDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, natGatewayName)
+}
+
+// Get calls the MockNatGatewaysClient's MockGet method.
+func (c *MockNatGatewaysClient) Get(ctx context.Context, resourceGroupName string, natGatewayName string, expand string) (result network.NatGateway, err error) {
+	return c.MockGet(ctx, resourceGroupName, natGatewayName, expand)
+}
+
+var _ networkapi2021.VirtualNetworkPeeringsClientAPI = &MockVirtualNetworkPeeringsClient{}
+
+// MockVirtualNetworkPeeringsClient is a fake implementation of
+// network2021.VirtualNetworkPeeringsClient.
+type MockVirtualNetworkPeeringsClient struct {
+	networkapi2021.VirtualNetworkPeeringsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string, virtualNetworkPeeringParameters network2021.VirtualNetworkPeering, syncRemoteAddressSpace network2021.SyncRemoteAddressSpace) (result network2021.VirtualNetworkPeeringsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string) (result network2021.VirtualNetworkPeeringsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string) (result network2021.VirtualNetworkPeering, err error)
+}
+
+// CreateOrUpdate calls the MockVirtualNetworkPeeringsClient's MockCreateOrUpdate method.
+func (c *MockVirtualNetworkPeeringsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string, virtualNetworkPeeringParameters network2021.VirtualNetworkPeering, syncRemoteAddressSpace network2021.SyncRemoteAddressSpace) (result network2021.VirtualNetworkPeeringsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, virtualNetworkName, virtualNetworkPeeringName, virtualNetworkPeeringParameters, syncRemoteAddressSpace)
+}
+
+// Delete calls the MockVirtualNetworkPeeringsClient's MockDelete method.
+func (c *MockVirtualNetworkPeeringsClient) Delete(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string) (result network2021.VirtualNetworkPeeringsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, virtualNetworkName, virtualNetworkPeeringName)
+}
+
+// Get calls the MockVirtualNetworkPeeringsClient's MockGet method.
+func (c *MockVirtualNetworkPeeringsClient) Get(ctx context.Context, resourceGroupName string, virtualNetworkName string, virtualNetworkPeeringName string) (result network2021.VirtualNetworkPeering, err error) {
+	return c.MockGet(ctx, resourceGroupName, virtualNetworkName, virtualNetworkPeeringName)
+}
+
+var _ networkapi2021.PrivateEndpointsClientAPI = &MockPrivateEndpointsClient{}
+
+// MockPrivateEndpointsClient is a fake implementation of
+// network2021.PrivateEndpointsClient.
+type MockPrivateEndpointsClient struct {
+	networkapi2021.PrivateEndpointsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, privateEndpointName string, parameters network2021.PrivateEndpoint) (result network2021.PrivateEndpointsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, privateEndpointName string) (result network2021.PrivateEndpointsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, privateEndpointName string, expand string) (result network2021.PrivateEndpoint, err error)
+}
+
+// CreateOrUpdate calls the MockPrivateEndpointsClient's MockCreateOrUpdate method.
+func (c *MockPrivateEndpointsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, privateEndpointName string, parameters network2021.PrivateEndpoint) (result network2021.PrivateEndpointsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, privateEndpointName, parameters)
+}
+
+// Delete calls the MockPrivateEndpointsClient's MockDelete method.
+func (c *MockPrivateEndpointsClient) Delete(ctx context.Context, resourceGroupName string, privateEndpointName string) (result network2021.PrivateEndpointsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, privateEndpointName)
+}
+
+// Get calls the MockPrivateEndpointsClient's MockGet method.
+func (c *MockPrivateEndpointsClient) Get(ctx context.Context, resourceGroupName string, privateEndpointName string, expand string) (result network2021.PrivateEndpoint, err error) {
+	return c.MockGet(ctx, resourceGroupName, privateEndpointName, expand)
+}
+
+var _ networkapi2021.PrivateDNSZoneGroupsClientAPI = &MockPrivateDNSZoneGroupsClient{}
+
+// MockPrivateDNSZoneGroupsClient is a fake implementation of
+// network2021.PrivateDNSZoneGroupsClient.
+type MockPrivateDNSZoneGroupsClient struct {
+	networkapi2021.PrivateDNSZoneGroupsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string, parameters network2021.PrivateDNSZoneGroup) (result network2021.PrivateDNSZoneGroupsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network2021.PrivateDNSZoneGroupsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network2021.PrivateDNSZoneGroup, err error)
+}
+
+// CreateOrUpdate calls the MockPrivateDNSZoneGroupsClient's MockCreateOrUpdate method.
+func (c *MockPrivateDNSZoneGroupsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string, parameters network2021.PrivateDNSZoneGroup) (result network2021.PrivateDNSZoneGroupsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName, parameters)
+}
+
+// Delete calls the MockPrivateDNSZoneGroupsClient's MockDelete method.
+func (c *MockPrivateDNSZoneGroupsClient) Delete(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network2021.PrivateDNSZoneGroupsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName)
+}
+
+// Get calls the MockPrivateDNSZoneGroupsClient's MockGet method.
+func (c *MockPrivateDNSZoneGroupsClient) Get(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network2021.PrivateDNSZoneGroup, err error) {
+	return c.MockGet(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName)
+}
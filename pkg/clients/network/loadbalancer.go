@@ -0,0 +1,516 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Paths of the elements of a load balancer, relative to its ID.
+const (
+	pathFrontendIPConfigurations = "/frontendIPConfigurations/"
+	pathBackendAddressPools      = "/backendAddressPools/"
+	pathProbes                   = "/probes/"
+)
+
+// NewLoadBalancerParameters returns an Azure LoadBalancer object from a load
+// balancer spec and the supplied defaults. Rules refer to the elements of the
+// load balancer by their IDs, which are derived from the supplied ID of the
+// load balancer.
+func NewLoadBalancerParameters(id string, p v1alpha3.LoadBalancerParameters, d azure.ResourceDefaults) networkmgmt.LoadBalancer {
+	lb := networkmgmt.LoadBalancer{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		LoadBalancerPropertiesFormat: &networkmgmt.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: newFrontendIPConfigurations(p.FrontendIPConfigurations),
+			BackendAddressPools:      newBackendAddressPools(p.BackendAddressPools),
+			Probes:                   newProbes(p.Probes),
+			LoadBalancingRules:       newLoadBalancingRules(id, p.LoadBalancingRules),
+			InboundNatRules:          newInboundNATRules(id, p.InboundNATRules),
+			OutboundRules:            newOutboundRules(id, p.OutboundRules),
+		},
+	}
+	if p.SKU != nil {
+		lb.Sku = &networkmgmt.LoadBalancerSku{Name: networkmgmt.LoadBalancerSkuName(p.SKU.Name)}
+	}
+	return lb
+}
+
+// NewLoadBalancerUpdate returns the desired state of the supplied Azure load
+// balancer. Its elements are replaced by the desired ones.
+func NewLoadBalancerUpdate(id string, p v1alpha3.LoadBalancerParameters, az networkmgmt.LoadBalancer, d azure.ResourceDefaults) networkmgmt.LoadBalancer {
+	lb := NewLoadBalancerParameters(id, p, d)
+	lb.Location = az.Location
+	lb.Sku = az.Sku
+	lb.Tags = d.UpdateTags(d.MergeTags(p.Tags), az.Tags)
+	return lb
+}
+
+// GenerateLoadBalancerObservation returns the observed state of the supplied
+// Azure load balancer.
+func GenerateLoadBalancerObservation(az networkmgmt.LoadBalancer) v1alpha3.LoadBalancerObservation {
+	o := v1alpha3.LoadBalancerObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	f := az.LoadBalancerPropertiesFormat
+	if f == nil {
+		return o
+	}
+	o.State = azure.ToString(f.ProvisioningState)
+	o.ResourceGUID = azure.ToString(f.ResourceGUID)
+	if f.FrontendIPConfigurations != nil {
+		for _, fic := range *f.FrontendIPConfigurations {
+			fo := v1alpha3.FrontendIPConfigurationObservation{
+				Name: azure.ToString(fic.Name),
+				ID:   azure.ToString(fic.ID),
+			}
+			if fic.FrontendIPConfigurationPropertiesFormat != nil {
+				fo.PrivateIPAddress = azure.ToString(fic.PrivateIPAddress)
+			}
+			o.FrontendIPConfigurations = append(o.FrontendIPConfigurations, fo)
+		}
+	}
+	if f.BackendAddressPools != nil {
+		for _, bap := range *f.BackendAddressPools {
+			o.BackendAddressPools = append(o.BackendAddressPools, v1alpha3.LoadBalancerSubResource{
+				Name: azure.ToString(bap.Name),
+				ID:   azure.ToString(bap.ID),
+			})
+		}
+	}
+	if f.InboundNatRules != nil {
+		for _, r := range *f.InboundNatRules {
+			o.InboundNATRules = append(o.InboundNATRules, v1alpha3.LoadBalancerSubResource{
+				Name: azure.ToString(r.Name),
+				ID:   azure.ToString(r.ID),
+			})
+		}
+	}
+	return o
+}
+
+// LateInitializeLoadBalancer late-initializes a LoadBalancer resource, except
+// for the supplied default tags.
+func LateInitializeLoadBalancer(p *v1alpha3.LoadBalancerParameters, az networkmgmt.LoadBalancer, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+	if p.SKU == nil && az.Sku != nil {
+		p.SKU = &v1alpha3.SKU{Name: string(az.Sku.Name)}
+	}
+}
+
+// IsLoadBalancerUpToDate returns true if the supplied Azure load balancer is
+// up to date with the supplied parameters, merged with the supplied defaults.
+// The elements of the load balancer are matched by name, since Azure does not
+// necessarily return them in the order they were supplied in, and only their
+// properties that are set in the parameters are compared.
+func IsLoadBalancerUpToDate(p v1alpha3.LoadBalancerParameters, az networkmgmt.LoadBalancer, d azure.ResourceDefaults) bool {
+	f := az.LoadBalancerPropertiesFormat
+	if f == nil {
+		return false
+	}
+	switch {
+	case !d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags):
+		return false
+	case !isFrontendIPConfigurationsUpToDate(p.FrontendIPConfigurations, f.FrontendIPConfigurations):
+		return false
+	case !isBackendAddressPoolsUpToDate(p.BackendAddressPools, f.BackendAddressPools):
+		return false
+	case !isProbesUpToDate(p.Probes, f.Probes):
+		return false
+	case !isLoadBalancingRulesUpToDate(p.LoadBalancingRules, f.LoadBalancingRules):
+		return false
+	case !isInboundNATRulesUpToDate(p.InboundNATRules, f.InboundNatRules):
+		return false
+	}
+	return isOutboundRulesUpToDate(p.OutboundRules, f.OutboundRules)
+}
+
+func newFrontendIPConfigurations(fics []v1alpha3.FrontendIPConfiguration) *[]networkmgmt.FrontendIPConfiguration {
+	r := make([]networkmgmt.FrontendIPConfiguration, len(fics))
+	for i, fic := range fics {
+		r[i] = networkmgmt.FrontendIPConfiguration{
+			Name:  azure.ToStringPtr(fic.Name),
+			Zones: azure.ToStringArrayPtr(fic.Zones),
+			FrontendIPConfigurationPropertiesFormat: &networkmgmt.FrontendIPConfigurationPropertiesFormat{
+				PrivateIPAddress:          fic.PrivateIPAddress,
+				PrivateIPAllocationMethod: networkmgmt.IPAllocationMethod(azure.ToString(fic.PrivateIPAllocationMethod)),
+			},
+		}
+		if fic.PublicIPAddressID != nil {
+			r[i].PublicIPAddress = &networkmgmt.PublicIPAddress{ID: fic.PublicIPAddressID}
+		}
+		if fic.SubnetID != nil {
+			r[i].Subnet = &networkmgmt.Subnet{ID: fic.SubnetID}
+		}
+	}
+	return &r
+}
+
+func newBackendAddressPools(baps []v1alpha3.BackendAddressPool) *[]networkmgmt.BackendAddressPool {
+	r := make([]networkmgmt.BackendAddressPool, len(baps))
+	for i, bap := range baps {
+		r[i] = networkmgmt.BackendAddressPool{Name: azure.ToStringPtr(bap.Name)}
+	}
+	return &r
+}
+
+func newProbes(ps []v1alpha3.Probe) *[]networkmgmt.Probe {
+	r := make([]networkmgmt.Probe, len(ps))
+	for i, p := range ps {
+		port := p.Port
+		r[i] = networkmgmt.Probe{
+			Name: azure.ToStringPtr(p.Name),
+			ProbePropertiesFormat: &networkmgmt.ProbePropertiesFormat{
+				Protocol:          networkmgmt.ProbeProtocol(p.Protocol),
+				Port:              &port,
+				IntervalInSeconds: p.IntervalInSeconds,
+				NumberOfProbes:    p.NumberOfProbes,
+				RequestPath:       p.RequestPath,
+			},
+		}
+	}
+	return &r
+}
+
+func newLoadBalancingRules(id string, rs []v1alpha3.LoadBalancingRule) *[]networkmgmt.LoadBalancingRule {
+	r := make([]networkmgmt.LoadBalancingRule, len(rs))
+	for i, lbr := range rs {
+		frontendPort := lbr.FrontendPort
+		r[i] = networkmgmt.LoadBalancingRule{
+			Name: azure.ToStringPtr(lbr.Name),
+			LoadBalancingRulePropertiesFormat: &networkmgmt.LoadBalancingRulePropertiesFormat{
+				FrontendIPConfiguration: newSubResource(id, pathFrontendIPConfigurations, lbr.FrontendIPConfigurationName),
+				Protocol:                networkmgmt.TransportProtocol(lbr.Protocol),
+				LoadDistribution:        networkmgmt.LoadDistribution(azure.ToString(lbr.LoadDistribution)),
+				FrontendPort:            &frontendPort,
+				BackendPort:             lbr.BackendPort,
+				IdleTimeoutInMinutes:    lbr.IdleTimeoutInMinutes,
+				EnableFloatingIP:        lbr.EnableFloatingIP,
+				EnableTCPReset:          lbr.EnableTCPReset,
+				DisableOutboundSnat:     lbr.DisableOutboundSNAT,
+			},
+		}
+		if lbr.BackendAddressPoolName != nil {
+			r[i].BackendAddressPool = newSubResource(id, pathBackendAddressPools, *lbr.BackendAddressPoolName)
+		}
+		if lbr.ProbeName != nil {
+			r[i].Probe = newSubResource(id, pathProbes, *lbr.ProbeName)
+		}
+	}
+	return &r
+}
+
+func newInboundNATRules(id string, rs []v1alpha3.InboundNATRule) *[]networkmgmt.InboundNatRule {
+	r := make([]networkmgmt.InboundNatRule, len(rs))
+	for i, nr := range rs {
+		frontendPort, backendPort := nr.FrontendPort, nr.BackendPort
+		r[i] = networkmgmt.InboundNatRule{
+			Name: azure.ToStringPtr(nr.Name),
+			InboundNatRulePropertiesFormat: &networkmgmt.InboundNatRulePropertiesFormat{
+				FrontendIPConfiguration: newSubResource(id, pathFrontendIPConfigurations, nr.FrontendIPConfigurationName),
+				Protocol:                networkmgmt.TransportProtocol(nr.Protocol),
+				FrontendPort:            &frontendPort,
+				BackendPort:             &backendPort,
+				IdleTimeoutInMinutes:    nr.IdleTimeoutInMinutes,
+				EnableFloatingIP:        nr.EnableFloatingIP,
+				EnableTCPReset:          nr.EnableTCPReset,
+			},
+		}
+	}
+	return &r
+}
+
+func newOutboundRules(id string, rs []v1alpha3.OutboundRule) *[]networkmgmt.OutboundRule {
+	r := make([]networkmgmt.OutboundRule, len(rs))
+	for i, or := range rs {
+		fics := make([]networkmgmt.SubResource, len(or.FrontendIPConfigurationNames))
+		for j, n := range or.FrontendIPConfigurationNames {
+			fics[j] = *newSubResource(id, pathFrontendIPConfigurations, n)
+		}
+		r[i] = networkmgmt.OutboundRule{
+			Name: azure.ToStringPtr(or.Name),
+			OutboundRulePropertiesFormat: &networkmgmt.OutboundRulePropertiesFormat{
+				FrontendIPConfigurations: &fics,
+				BackendAddressPool:       newSubResource(id, pathBackendAddressPools, or.BackendAddressPoolName),
+				Protocol:                 networkmgmt.LoadBalancerOutboundRuleProtocol(or.Protocol),
+				AllocatedOutboundPorts:   or.AllocatedOutboundPorts,
+				IdleTimeoutInMinutes:     or.IdleTimeoutInMinutes,
+				EnableTCPReset:           or.EnableTCPReset,
+			},
+		}
+	}
+	return &r
+}
+
+// newSubResource returns a reference to the named element of the load
+// balancer with the supplied ID.
+func newSubResource(id, path, name string) *networkmgmt.SubResource {
+	return &networkmgmt.SubResource{ID: azure.ToStringPtr(id + path + name)}
+}
+
+// subResourceName returns the name of the element of a load balancer the
+// supplied reference refers to, i.e. the last segment of its ID.
+func subResourceName(sr *networkmgmt.SubResource) string {
+	if sr == nil {
+		return ""
+	}
+	id := azure.ToString(sr.ID)
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+func isFrontendIPConfigurationsUpToDate(fics []v1alpha3.FrontendIPConfiguration, in *[]networkmgmt.FrontendIPConfiguration) bool {
+	desired := make([]string, len(fics))
+	for i := range fics {
+		desired[i] = fics[i].Name
+	}
+	var az []networkmgmt.FrontendIPConfiguration
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := fics[i], az[j].FrontendIPConfigurationPropertiesFormat
+		if f == nil {
+			return false
+		}
+		var publicIPAddressID, subnetID *string
+		if f.PublicIPAddress != nil {
+			publicIPAddressID = f.PublicIPAddress.ID
+		}
+		if f.Subnet != nil {
+			subnetID = f.Subnet.ID
+		}
+		switch {
+		case !strings.EqualFold(azure.ToString(p.PublicIPAddressID), azure.ToString(publicIPAddressID)):
+			return false
+		case !strings.EqualFold(azure.ToString(p.SubnetID), azure.ToString(subnetID)):
+			return false
+		case stringNeedsUpdate(p.PrivateIPAllocationMethod, string(f.PrivateIPAllocationMethod)):
+			return false
+		}
+		return !stringNeedsUpdate(p.PrivateIPAddress, azure.ToString(f.PrivateIPAddress))
+	})
+}
+
+func isBackendAddressPoolsUpToDate(baps []v1alpha3.BackendAddressPool, in *[]networkmgmt.BackendAddressPool) bool {
+	desired := make([]string, len(baps))
+	for i := range baps {
+		desired[i] = baps[i].Name
+	}
+	var observed []string
+	if in != nil {
+		for _, bap := range *in {
+			observed = append(observed, azure.ToString(bap.Name))
+		}
+	}
+	return equalByName(desired, observed, func(_, _ int) bool { return true })
+}
+
+func isProbesUpToDate(ps []v1alpha3.Probe, in *[]networkmgmt.Probe) bool {
+	desired := make([]string, len(ps))
+	for i := range ps {
+		desired[i] = ps[i].Name
+	}
+	var az []networkmgmt.Probe
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := ps[i], az[j].ProbePropertiesFormat
+		if f == nil {
+			return false
+		}
+		switch {
+		case !strings.EqualFold(p.Protocol, string(f.Protocol)):
+			return false
+		case int(p.Port) != azure.ToInt(f.Port):
+			return false
+		case int32NeedsUpdate(p.IntervalInSeconds, f.IntervalInSeconds):
+			return false
+		case int32NeedsUpdate(p.NumberOfProbes, f.NumberOfProbes):
+			return false
+		}
+		return p.RequestPath == nil || *p.RequestPath == azure.ToString(f.RequestPath)
+	})
+}
+
+func isLoadBalancingRulesUpToDate(rs []v1alpha3.LoadBalancingRule, in *[]networkmgmt.LoadBalancingRule) bool {
+	desired := make([]string, len(rs))
+	for i := range rs {
+		desired[i] = rs[i].Name
+	}
+	var az []networkmgmt.LoadBalancingRule
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := rs[i], az[j].LoadBalancingRulePropertiesFormat
+		if f == nil {
+			return false
+		}
+		switch {
+		case !strings.EqualFold(p.FrontendIPConfigurationName, subResourceName(f.FrontendIPConfiguration)):
+			return false
+		case !strings.EqualFold(azure.ToString(p.BackendAddressPoolName), subResourceName(f.BackendAddressPool)):
+			return false
+		case !strings.EqualFold(azure.ToString(p.ProbeName), subResourceName(f.Probe)):
+			return false
+		case !strings.EqualFold(p.Protocol, string(f.Protocol)):
+			return false
+		case int(p.FrontendPort) != azure.ToInt(f.FrontendPort):
+			return false
+		case int32NeedsUpdate(p.BackendPort, f.BackendPort):
+			return false
+		case stringNeedsUpdate(p.LoadDistribution, string(f.LoadDistribution)):
+			return false
+		case int32NeedsUpdate(p.IdleTimeoutInMinutes, f.IdleTimeoutInMinutes):
+			return false
+		case boolNeedsUpdate(p.EnableFloatingIP, f.EnableFloatingIP):
+			return false
+		case boolNeedsUpdate(p.EnableTCPReset, f.EnableTCPReset):
+			return false
+		}
+		return !boolNeedsUpdate(p.DisableOutboundSNAT, f.DisableOutboundSnat)
+	})
+}
+
+func isInboundNATRulesUpToDate(rs []v1alpha3.InboundNATRule, in *[]networkmgmt.InboundNatRule) bool {
+	desired := make([]string, len(rs))
+	for i := range rs {
+		desired[i] = rs[i].Name
+	}
+	var az []networkmgmt.InboundNatRule
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := rs[i], az[j].InboundNatRulePropertiesFormat
+		if f == nil {
+			return false
+		}
+		switch {
+		case !strings.EqualFold(p.FrontendIPConfigurationName, subResourceName(f.FrontendIPConfiguration)):
+			return false
+		case !strings.EqualFold(p.Protocol, string(f.Protocol)):
+			return false
+		case int(p.FrontendPort) != azure.ToInt(f.FrontendPort):
+			return false
+		case int(p.BackendPort) != azure.ToInt(f.BackendPort):
+			return false
+		case int32NeedsUpdate(p.IdleTimeoutInMinutes, f.IdleTimeoutInMinutes):
+			return false
+		case boolNeedsUpdate(p.EnableFloatingIP, f.EnableFloatingIP):
+			return false
+		}
+		return !boolNeedsUpdate(p.EnableTCPReset, f.EnableTCPReset)
+	})
+}
+
+func isOutboundRulesUpToDate(rs []v1alpha3.OutboundRule, in *[]networkmgmt.OutboundRule) bool {
+	desired := make([]string, len(rs))
+	for i := range rs {
+		desired[i] = rs[i].Name
+	}
+	var az []networkmgmt.OutboundRule
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := rs[i], az[j].OutboundRulePropertiesFormat
+		if f == nil {
+			return false
+		}
+		var fics []string
+		if f.FrontendIPConfigurations != nil {
+			for k := range *f.FrontendIPConfigurations {
+				fics = append(fics, subResourceName(&(*f.FrontendIPConfigurations)[k]))
+			}
+		}
+		switch {
+		case !equalSets(p.FrontendIPConfigurationNames, fics):
+			return false
+		case !strings.EqualFold(p.BackendAddressPoolName, subResourceName(f.BackendAddressPool)):
+			return false
+		case !strings.EqualFold(p.Protocol, string(f.Protocol)):
+			return false
+		case int32NeedsUpdate(p.AllocatedOutboundPorts, f.AllocatedOutboundPorts):
+			return false
+		case int32NeedsUpdate(p.IdleTimeoutInMinutes, f.IdleTimeoutInMinutes):
+			return false
+		}
+		return !boolNeedsUpdate(p.EnableTCPReset, f.EnableTCPReset)
+	})
+}
+
+// equalByName returns true if the supplied lists of desired and observed
+// names have the same length, and every desired element has an observed
+// element with the same name, compared case-insensitively, that is up to date
+// according to the supplied function. The function is called with the indices
+// of a desired element and of its observed counterpart.
+func equalByName(desired, observed []string, upToDate func(d, o int) bool) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	idx := make(map[string]int, len(observed))
+	for i, n := range observed {
+		idx[strings.ToLower(n)] = i
+	}
+	for i, n := range desired {
+		j, ok := idx[strings.ToLower(n)]
+		if !ok || !upToDate(i, j) {
+			return false
+		}
+	}
+	return true
+}
+
+// int32NeedsUpdate returns true if the desired value of a property is set and
+// differs from the observed one.
+func int32NeedsUpdate(desired, observed *int32) bool {
+	return desired != nil && int(*desired) != azure.ToInt(observed)
+}
+
+// stringNeedsUpdate returns true if the desired value of a property is set and
+// differs from the observed one, compared case-insensitively.
+func stringNeedsUpdate(desired *string, observed string) bool {
+	return desired != nil && !strings.EqualFold(*desired, observed)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var (
+	loadBalancerID    = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/cool-lb"
+	publicIPAddressID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/cool-ip"
+)
+
+func loadBalancerParameters() v1alpha3.LoadBalancerParameters {
+	return v1alpha3.LoadBalancerParameters{
+		Location: location,
+		SKU:      &v1alpha3.SKU{Name: "Standard"},
+		FrontendIPConfigurations: []v1alpha3.FrontendIPConfiguration{
+			{Name: "public", PublicIPAddressID: azure.ToStringPtr(publicIPAddressID)},
+		},
+		BackendAddressPools: []v1alpha3.BackendAddressPool{{Name: "web"}, {Name: "api"}},
+		Probes: []v1alpha3.Probe{
+			{Name: "http", Protocol: "Http", Port: 80, RequestPath: azure.ToStringPtr("/healthz")},
+			{Name: "tcp", Protocol: "Tcp", Port: 443},
+		},
+		LoadBalancingRules: []v1alpha3.LoadBalancingRule{{
+			Name:                        "http",
+			FrontendIPConfigurationName: "public",
+			BackendAddressPoolName:      azure.ToStringPtr("web"),
+			ProbeName:                   azure.ToStringPtr("http"),
+			Protocol:                    "Tcp",
+			FrontendPort:                80,
+			DisableOutboundSNAT:         azure.ToBoolPtr(true),
+		}},
+		InboundNATRules: []v1alpha3.InboundNATRule{{
+			Name:                        "ssh",
+			FrontendIPConfigurationName: "public",
+			Protocol:                    "Tcp",
+			FrontendPort:                2222,
+			BackendPort:                 22,
+		}},
+		OutboundRules: []v1alpha3.OutboundRule{{
+			Name:                         "egress",
+			FrontendIPConfigurationNames: []string{"public"},
+			BackendAddressPoolName:       "web",
+			Protocol:                     "All",
+		}},
+	}
+}
+
+// azureLoadBalancer returns the load balancer Azure returns for the parameters
+// above, with defaults and read-only properties filled in, and its elements in
+// a different order.
+func azureLoadBalancer() networkmgmt.LoadBalancer {
+	ref := func(path, name string) *networkmgmt.SubResource {
+		return &networkmgmt.SubResource{ID: azure.ToStringPtr(loadBalancerID + path + name)}
+	}
+	return networkmgmt.LoadBalancer{
+		ID:       azure.ToStringPtr(loadBalancerID),
+		Location: azure.ToStringPtr(location),
+		Sku:      &networkmgmt.LoadBalancerSku{Name: networkmgmt.LoadBalancerSkuNameStandard},
+		LoadBalancerPropertiesFormat: &networkmgmt.LoadBalancerPropertiesFormat{
+			ProvisioningState: azure.ToStringPtr("Succeeded"),
+			FrontendIPConfigurations: &[]networkmgmt.FrontendIPConfiguration{{
+				Name: azure.ToStringPtr("public"),
+				ID:   azure.ToStringPtr(loadBalancerID + "/frontendIPConfigurations/public"),
+				FrontendIPConfigurationPropertiesFormat: &networkmgmt.FrontendIPConfigurationPropertiesFormat{
+					PrivateIPAllocationMethod: networkmgmt.Dynamic,
+					PublicIPAddress:           &networkmgmt.PublicIPAddress{ID: azure.ToStringPtr(publicIPAddressID)},
+				},
+			}},
+			BackendAddressPools: &[]networkmgmt.BackendAddressPool{
+				{Name: azure.ToStringPtr("api"), ID: azure.ToStringPtr(loadBalancerID + "/backendAddressPools/api")},
+				{Name: azure.ToStringPtr("web"), ID: azure.ToStringPtr(loadBalancerID + "/backendAddressPools/web")},
+			},
+			Probes: &[]networkmgmt.Probe{
+				{
+					Name: azure.ToStringPtr("tcp"),
+					ProbePropertiesFormat: &networkmgmt.ProbePropertiesFormat{
+						Protocol:          networkmgmt.ProbeProtocolTCP,
+						Port:              azure.ToInt32Ptr(443),
+						IntervalInSeconds: azure.ToInt32Ptr(15),
+						NumberOfProbes:    azure.ToInt32Ptr(2),
+					},
+				},
+				{
+					Name: azure.ToStringPtr("http"),
+					ProbePropertiesFormat: &networkmgmt.ProbePropertiesFormat{
+						Protocol:          networkmgmt.ProbeProtocolHTTP,
+						Port:              azure.ToInt32Ptr(80),
+						IntervalInSeconds: azure.ToInt32Ptr(15),
+						NumberOfProbes:    azure.ToInt32Ptr(2),
+						RequestPath:       azure.ToStringPtr("/healthz"),
+					},
+				},
+			},
+			LoadBalancingRules: &[]networkmgmt.LoadBalancingRule{{
+				Name: azure.ToStringPtr("http"),
+				LoadBalancingRulePropertiesFormat: &networkmgmt.LoadBalancingRulePropertiesFormat{
+					FrontendIPConfiguration: ref("/frontendIPConfigurations/", "public"),
+					BackendAddressPool:      ref("/backendAddressPools/", "web"),
+					Probe:                   ref("/probes/", "http"),
+					Protocol:                networkmgmt.TransportProtocolTCP,
+					LoadDistribution:        networkmgmt.LoadDistributionDefault,
+					FrontendPort:            azure.ToInt32Ptr(80),
+					BackendPort:             azure.ToInt32Ptr(80),
+					IdleTimeoutInMinutes:    azure.ToInt32Ptr(4),
+					EnableFloatingIP:        azure.ToBoolPtr(false, azure.FieldRequired),
+					EnableTCPReset:          azure.ToBoolPtr(false, azure.FieldRequired),
+					DisableOutboundSnat:     azure.ToBoolPtr(true),
+				},
+			}},
+			InboundNatRules: &[]networkmgmt.InboundNatRule{{
+				Name: azure.ToStringPtr("ssh"),
+				ID:   azure.ToStringPtr(loadBalancerID + "/inboundNatRules/ssh"),
+				InboundNatRulePropertiesFormat: &networkmgmt.InboundNatRulePropertiesFormat{
+					FrontendIPConfiguration: ref("/frontendIPConfigurations/", "public"),
+					Protocol:                networkmgmt.TransportProtocolTCP,
+					FrontendPort:            azure.ToInt32Ptr(2222),
+					BackendPort:             azure.ToInt32Ptr(22),
+					IdleTimeoutInMinutes:    azure.ToInt32Ptr(4),
+				},
+			}},
+			OutboundRules: &[]networkmgmt.OutboundRule{{
+				Name: azure.ToStringPtr("egress"),
+				OutboundRulePropertiesFormat: &networkmgmt.OutboundRulePropertiesFormat{
+					FrontendIPConfigurations: &[]networkmgmt.SubResource{*ref("/frontendIPConfigurations/", "public")},
+					BackendAddressPool:       ref("/backendAddressPools/", "web"),
+					Protocol:                 networkmgmt.LoadBalancerOutboundRuleProtocolAll,
+					AllocatedOutboundPorts:   azure.ToInt32Ptr(1024),
+				},
+			}},
+		},
+	}
+}
+
+func TestNewLoadBalancerParameters(t *testing.T) {
+	got := NewLoadBalancerParameters(loadBalancerID, loadBalancerParameters(), azure.ResourceDefaults{})
+	f := got.LoadBalancerPropertiesFormat
+
+	if diff := cmp.Diff(&networkmgmt.LoadBalancerSku{Name: networkmgmt.LoadBalancerSkuNameStandard}, got.Sku); diff != "" {
+		t.Errorf("NewLoadBalancerParameters(...): -want SKU, +got SKU\n%s", diff)
+	}
+	wantRule := &networkmgmt.LoadBalancingRulePropertiesFormat{
+		FrontendIPConfiguration: &networkmgmt.SubResource{ID: azure.ToStringPtr(loadBalancerID + "/frontendIPConfigurations/public")},
+		BackendAddressPool:      &networkmgmt.SubResource{ID: azure.ToStringPtr(loadBalancerID + "/backendAddressPools/web")},
+		Probe:                   &networkmgmt.SubResource{ID: azure.ToStringPtr(loadBalancerID + "/probes/http")},
+		Protocol:                networkmgmt.TransportProtocolTCP,
+		FrontendPort:            azure.ToInt32Ptr(80),
+		DisableOutboundSnat:     azure.ToBoolPtr(true),
+	}
+	if diff := cmp.Diff(wantRule, (*f.LoadBalancingRules)[0].LoadBalancingRulePropertiesFormat); diff != "" {
+		t.Errorf("NewLoadBalancerParameters(...): -want rule, +got rule\n%s", diff)
+	}
+	wantOutbound := &networkmgmt.OutboundRulePropertiesFormat{
+		FrontendIPConfigurations: &[]networkmgmt.SubResource{{ID: azure.ToStringPtr(loadBalancerID + "/frontendIPConfigurations/public")}},
+		BackendAddressPool:       &networkmgmt.SubResource{ID: azure.ToStringPtr(loadBalancerID + "/backendAddressPools/web")},
+		Protocol:                 networkmgmt.LoadBalancerOutboundRuleProtocolAll,
+	}
+	if diff := cmp.Diff(wantOutbound, (*f.OutboundRules)[0].OutboundRulePropertiesFormat); diff != "" {
+		t.Errorf("NewLoadBalancerParameters(...): -want outbound rule, +got outbound rule\n%s", diff)
+	}
+}
+
+func TestIsLoadBalancerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(p *v1alpha3.LoadBalancerParameters)
+		az   func(az *networkmgmt.LoadBalancer)
+		want bool
+	}{
+		"UpToDateInAnyOrder": {
+			want: true,
+		},
+		"ProbeAdded": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.Probes = append(p.Probes, v1alpha3.Probe{Name: "https", Protocol: "Https", Port: 8443})
+			},
+			want: false,
+		},
+		"BackendAddressPoolRemoved": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.BackendAddressPools = p.BackendAddressPools[:1]
+			},
+			want: false,
+		},
+		"BackendAddressPoolRenamed": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.BackendAddressPools[1].Name = "internal"
+			},
+			want: false,
+		},
+		"ProbeRequestPathChanged": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.Probes[0].RequestPath = azure.ToStringPtr("/ready")
+			},
+			want: false,
+		},
+		"RuleMovedToOtherPool": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.LoadBalancingRules[0].BackendAddressPoolName = azure.ToStringPtr("api")
+			},
+			want: false,
+		},
+		"RuleIdleTimeoutChanged": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.LoadBalancingRules[0].IdleTimeoutInMinutes = azure.ToInt32Ptr(10)
+			},
+			want: false,
+		},
+		"InboundNATRuleBackendPortChanged": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.InboundNATRules[0].BackendPort = 2022
+			},
+			want: false,
+		},
+		"OutboundRuleProtocolChanged": {
+			az: func(az *networkmgmt.LoadBalancer) {
+				(*az.OutboundRules)[0].Protocol = networkmgmt.LoadBalancerOutboundRuleProtocolTCP
+			},
+			want: false,
+		},
+		"FrontendPublicIPAddressChanged": {
+			az: func(az *networkmgmt.LoadBalancer) {
+				(*az.FrontendIPConfigurations)[0].PublicIPAddress.ID = azure.ToStringPtr("/other-ip")
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: func(p *v1alpha3.LoadBalancerParameters) {
+				p.Tags = map[string]string{"env": "prod"}
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, az := loadBalancerParameters(), azureLoadBalancer()
+			if tc.p != nil {
+				tc.p(&p)
+			}
+			if tc.az != nil {
+				tc.az(&az)
+			}
+			got := IsLoadBalancerUpToDate(p, az, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLoadBalancerUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLoadBalancerObservation(t *testing.T) {
+	want := v1alpha3.LoadBalancerObservation{
+		State: "Succeeded",
+		ID:    loadBalancerID,
+		FrontendIPConfigurations: []v1alpha3.FrontendIPConfigurationObservation{
+			{Name: "public", ID: loadBalancerID + "/frontendIPConfigurations/public"},
+		},
+		BackendAddressPools: []v1alpha3.LoadBalancerSubResource{
+			{Name: "api", ID: loadBalancerID + "/backendAddressPools/api"},
+			{Name: "web", ID: loadBalancerID + "/backendAddressPools/web"},
+		},
+		InboundNATRules: []v1alpha3.LoadBalancerSubResource{
+			{Name: "ssh", ID: loadBalancerID + "/inboundNatRules/ssh"},
+		},
+	}
+	got := GenerateLoadBalancerObservation(azureLoadBalancer())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateLoadBalancerObservation(...): -want, +got\n%s", diff)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewNATGatewayParameters returns an Azure NatGateway object from a NAT
+// gateway spec and the supplied defaults. Standard is the only SKU of NAT
+// gateways.
+func NewNATGatewayParameters(p v1alpha3.NATGatewayParameters, d azure.ResourceDefaults) networkmgmt.NatGateway {
+	return networkmgmt.NatGateway{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		Sku:      &networkmgmt.NatGatewaySku{Name: networkmgmt.Standard},
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		NatGatewayPropertiesFormat: &networkmgmt.NatGatewayPropertiesFormat{
+			IdleTimeoutInMinutes: p.IdleTimeoutInMinutes,
+			PublicIPAddresses:    newSubResources(p.PublicIPAddressIDs),
+			PublicIPPrefixes:     newSubResources(p.PublicIPPrefixIDs),
+		},
+	}
+}
+
+// NewNATGatewayUpdate returns the desired state of the supplied Azure NAT
+// gateway.
+func NewNATGatewayUpdate(p v1alpha3.NATGatewayParameters, az networkmgmt.NatGateway, d azure.ResourceDefaults) networkmgmt.NatGateway {
+	ng := NewNATGatewayParameters(p, d)
+	ng.Location = az.Location
+	ng.Zones = az.Zones
+	ng.Tags = d.UpdateTags(d.MergeTags(p.Tags), az.Tags)
+	return ng
+}
+
+// GenerateNATGatewayObservation returns the observed state of the supplied
+// Azure NAT gateway.
+func GenerateNATGatewayObservation(az networkmgmt.NatGateway) v1alpha3.NATGatewayObservation {
+	o := v1alpha3.NATGatewayObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	f := az.NatGatewayPropertiesFormat
+	if f == nil {
+		return o
+	}
+	o.State = azure.ToString(f.ProvisioningState)
+	o.ResourceGUID = azure.ToString(f.ResourceGUID)
+	o.SubnetIDs = subResourceIDs(f.Subnets)
+	return o
+}
+
+// LateInitializeNATGateway late-initializes a NATGateway resource, except for
+// the supplied default tags.
+func LateInitializeNATGateway(p *v1alpha3.NATGatewayParameters, az networkmgmt.NatGateway, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, az.Zones)
+	if f := az.NatGatewayPropertiesFormat; f != nil {
+		p.IdleTimeoutInMinutes = azure.LateInitializeInt32PtrFromInt32Ptr(p.IdleTimeoutInMinutes, f.IdleTimeoutInMinutes)
+	}
+}
+
+// IsNATGatewayUpToDate returns true if the supplied Azure NAT gateway is up to
+// date with the supplied parameters, merged with the supplied defaults.
+func IsNATGatewayUpToDate(p v1alpha3.NATGatewayParameters, az networkmgmt.NatGateway, d azure.ResourceDefaults) bool {
+	f := az.NatGatewayPropertiesFormat
+	if f == nil {
+		return false
+	}
+	switch {
+	case !d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags):
+		return false
+	case int32NeedsUpdate(p.IdleTimeoutInMinutes, f.IdleTimeoutInMinutes):
+		return false
+	case !equalSets(p.PublicIPAddressIDs, subResourceIDs(f.PublicIPAddresses)):
+		return false
+	}
+	return equalSets(p.PublicIPPrefixIDs, subResourceIDs(f.PublicIPPrefixes))
+}
+
+func newSubResources(ids []string) *[]networkmgmt.SubResource {
+	r := make([]networkmgmt.SubResource, len(ids))
+	for i := range ids {
+		r[i] = networkmgmt.SubResource{ID: azure.ToStringPtr(ids[i])}
+	}
+	return &r
+}
+
+func subResourceIDs(srs *[]networkmgmt.SubResource) []string {
+	if srs == nil {
+		return nil
+	}
+	ids := make([]string, len(*srs))
+	for i, sr := range *srs {
+		ids[i] = azure.ToString(sr.ID)
+	}
+	return ids
+}
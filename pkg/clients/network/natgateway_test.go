@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	natPublicIPID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip"
+	natPrefixID   = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix"
+)
+
+func TestNewNATGatewayUpdate(t *testing.T) {
+	p := v1alpha3.NATGatewayParameters{
+		Location:             "ignored",
+		IdleTimeoutInMinutes: azure.ToInt32Ptr(10),
+		PublicIPAddressIDs:   []string{natPublicIPID},
+		PublicIPPrefixIDs:    []string{natPrefixID},
+		Tags:                 map[string]string{"env": "prod"},
+	}
+	az := networkmgmt.NatGateway{
+		Location: azure.ToStringPtr(location),
+		Zones:    &[]string{"1"},
+		Tags:     map[string]*string{"env": azure.ToStringPtr("test"), "owner": azure.ToStringPtr("network-team")},
+	}
+	want := networkmgmt.NatGateway{
+		Location: azure.ToStringPtr(location),
+		Zones:    &[]string{"1"},
+		Sku:      &networkmgmt.NatGatewaySku{Name: networkmgmt.Standard},
+		Tags: map[string]*string{
+			"env":   azure.ToStringPtr("prod"),
+			"owner": azure.ToStringPtr("network-team"),
+		},
+		NatGatewayPropertiesFormat: &networkmgmt.NatGatewayPropertiesFormat{
+			IdleTimeoutInMinutes: azure.ToInt32Ptr(10),
+			PublicIPAddresses:    &[]networkmgmt.SubResource{{ID: azure.ToStringPtr(natPublicIPID)}},
+			PublicIPPrefixes:     &[]networkmgmt.SubResource{{ID: azure.ToStringPtr(natPrefixID)}},
+		},
+	}
+
+	got := NewNATGatewayUpdate(p, az, azure.ResourceDefaults{})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewNATGatewayUpdate(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestIsNATGatewayUpToDate(t *testing.T) {
+	az := networkmgmt.NatGateway{
+		Tags: map[string]*string{"env": azure.ToStringPtr("test")},
+		NatGatewayPropertiesFormat: &networkmgmt.NatGatewayPropertiesFormat{
+			IdleTimeoutInMinutes: azure.ToInt32Ptr(4),
+			PublicIPAddresses:    &[]networkmgmt.SubResource{{ID: azure.ToStringPtr(natPublicIPID)}},
+			Subnets:              &[]networkmgmt.SubResource{{ID: azure.ToStringPtr("/subnet-id")}},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.NATGatewayParameters
+		az   networkmgmt.NatGateway
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.NATGatewayParameters{
+				IdleTimeoutInMinutes: azure.ToInt32Ptr(4),
+				PublicIPAddressIDs:   []string{"/SUBSCRIPTIONS/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip"},
+				Tags:                 map[string]string{"env": "test"},
+			},
+			az:   az,
+			want: true,
+		},
+		"IdleTimeoutChanged": {
+			p: v1alpha3.NATGatewayParameters{
+				IdleTimeoutInMinutes: azure.ToInt32Ptr(10),
+				PublicIPAddressIDs:   []string{natPublicIPID},
+				Tags:                 map[string]string{"env": "test"},
+			},
+			az: az,
+		},
+		"PublicIPPrefixAdded": {
+			p: v1alpha3.NATGatewayParameters{
+				PublicIPAddressIDs: []string{natPublicIPID},
+				PublicIPPrefixIDs:  []string{natPrefixID},
+				Tags:               map[string]string{"env": "test"},
+			},
+			az: az,
+		},
+		"PublicIPAddressRemoved": {
+			p: v1alpha3.NATGatewayParameters{
+				Tags: map[string]string{"env": "test"},
+			},
+			az: az,
+		},
+		"NoProperties": {
+			p: v1alpha3.NATGatewayParameters{
+				Tags: map[string]string{"env": "test"},
+			},
+			az: networkmgmt.NatGateway{Tags: map[string]*string{"env": azure.ToStringPtr("test")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNATGatewayUpToDate(tc.p, tc.az, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNATGatewayUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNATGatewayObservation(t *testing.T) {
+	az := networkmgmt.NatGateway{
+		ID:   azure.ToStringPtr("/nat-gateway-id"),
+		Etag: azure.ToStringPtr("etag"),
+		NatGatewayPropertiesFormat: &networkmgmt.NatGatewayPropertiesFormat{
+			Subnets:           &[]networkmgmt.SubResource{{ID: azure.ToStringPtr("/subnet-a")}, {ID: azure.ToStringPtr("/subnet-b")}},
+			ResourceGUID:      azure.ToStringPtr("guid"),
+			ProvisioningState: azure.ToStringPtr("Succeeded"),
+		},
+	}
+	want := v1alpha3.NATGatewayObservation{
+		State:        "Succeeded",
+		Etag:         "etag",
+		ID:           "/nat-gateway-id",
+		ResourceGUID: "guid",
+		SubnetIDs:    []string{"/subnet-a", "/subnet-b"},
+	}
+
+	got := GenerateNATGatewayObservation(az)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateNATGatewayObservation(...): -want, +got\n%s", diff)
+	}
+}
@@ -22,18 +22,17 @@ import (
 	"strings"
 
 	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
-// NewVirtualNetworkParameters returns an Azure VirtualNetwork object from a virtual network spec
-func NewVirtualNetworkParameters(v *v1alpha3.VirtualNetwork) networkmgmt.VirtualNetwork {
+// NewVirtualNetworkParameters returns an Azure VirtualNetwork object from a
+// virtual network spec and the supplied defaults.
+func NewVirtualNetworkParameters(v *v1alpha3.VirtualNetwork, d azure.ResourceDefaults) networkmgmt.VirtualNetwork {
 	return networkmgmt.VirtualNetwork{
-		Location: azure.ToStringPtr(v.Spec.Location),
-		Tags:     azure.ToStringPtrMap(v.Spec.Tags),
+		Location: azure.ToStringPtr(d.LocationOr(v.Spec.Location)),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(v.Spec.Tags)),
 		VirtualNetworkPropertiesFormat: &networkmgmt.VirtualNetworkPropertiesFormat{
 			EnableDdosProtection: azure.ToBoolPtr(v.Spec.VirtualNetworkPropertiesFormat.EnableDDOSProtection, azure.FieldRequired),
 			EnableVMProtection:   azure.ToBoolPtr(v.Spec.VirtualNetworkPropertiesFormat.EnableVMProtection),
@@ -45,8 +44,8 @@ func NewVirtualNetworkParameters(v *v1alpha3.VirtualNetwork) networkmgmt.Virtual
 }
 
 // VirtualNetworkNeedsUpdate determines if a virtual network need to be updated
-func VirtualNetworkNeedsUpdate(kube *v1alpha3.VirtualNetwork, az networkmgmt.VirtualNetwork) bool {
-	up := NewVirtualNetworkParameters(kube)
+func VirtualNetworkNeedsUpdate(kube *v1alpha3.VirtualNetwork, az networkmgmt.VirtualNetwork, d azure.ResourceDefaults) bool {
+	up := NewVirtualNetworkParameters(kube, d)
 
 	switch {
 	case !reflect.DeepEqual(up.VirtualNetworkPropertiesFormat.AddressSpace, az.VirtualNetworkPropertiesFormat.AddressSpace):
@@ -55,7 +54,7 @@ func VirtualNetworkNeedsUpdate(kube *v1alpha3.VirtualNetwork, az networkmgmt.Vir
 		return true
 	case !reflect.DeepEqual(up.VirtualNetworkPropertiesFormat.EnableVMProtection, az.VirtualNetworkPropertiesFormat.EnableVMProtection):
 		return true
-	case !reflect.DeepEqual(up.Tags, az.Tags):
+	case !d.TagsUpToDate(azure.ToStringMap(up.Tags), az.Tags):
 		return true
 	}
 
@@ -76,14 +75,60 @@ func UpdateVirtualNetworkStatusFromAzure(v *v1alpha3.VirtualNetwork, az networkm
 func NewSubnetParameters(s *v1alpha3.Subnet) networkmgmt.Subnet {
 	return networkmgmt.Subnet{
 		SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
-			AddressPrefix:    azure.ToStringPtr(s.Spec.SubnetPropertiesFormat.AddressPrefix),
-			ServiceEndpoints: NewServiceEndpoints(s.Spec.SubnetPropertiesFormat.ServiceEndpoints),
+			AddressPrefix:        azure.ToStringPtr(s.Spec.SubnetPropertiesFormat.AddressPrefix),
+			ServiceEndpoints:     NewServiceEndpoints(s.Spec.SubnetPropertiesFormat.ServiceEndpoints),
+			NetworkSecurityGroup: newSecurityGroupRef(s.Spec.SubnetPropertiesFormat.NetworkSecurityGroupID),
+			RouteTable:           newRouteTableRef(s.Spec.SubnetPropertiesFormat.RouteTableID),
+			NatGateway:           newNATGatewayRef(s.Spec.SubnetPropertiesFormat.NATGatewayID),
 		},
 	}
 }
 
-// NewPublicIPAddressParameters returns an Azure PublicIPAddress object from a public ip address spec
-func NewPublicIPAddressParameters(s *v1alpha3.PublicIPAddress) networkmgmt.PublicIPAddress {
+// NewSubnetUpdate returns an Azure Subnet object from a subnet spec. The
+// network security group, route table and NAT gateway of the supplied subnet
+// are retained if the spec does not set them, so that associations made
+// outside of Crossplane, for example by AKS, are kept.
+func NewSubnetUpdate(s *v1alpha3.Subnet, az networkmgmt.Subnet) networkmgmt.Subnet {
+	up := NewSubnetParameters(s)
+	if az.SubnetPropertiesFormat == nil {
+		return up
+	}
+	if up.NetworkSecurityGroup == nil && az.NetworkSecurityGroup != nil {
+		up.NetworkSecurityGroup = newSecurityGroupRef(az.NetworkSecurityGroup.ID)
+	}
+	if up.RouteTable == nil && az.RouteTable != nil {
+		up.RouteTable = newRouteTableRef(az.RouteTable.ID)
+	}
+	if up.NatGateway == nil && az.NatGateway != nil {
+		up.NatGateway = newNATGatewayRef(az.NatGateway.ID)
+	}
+	return up
+}
+
+func newSecurityGroupRef(id *string) *networkmgmt.SecurityGroup {
+	if id == nil {
+		return nil
+	}
+	return &networkmgmt.SecurityGroup{ID: id}
+}
+
+func newRouteTableRef(id *string) *networkmgmt.RouteTable {
+	if id == nil {
+		return nil
+	}
+	return &networkmgmt.RouteTable{ID: id}
+}
+
+func newNATGatewayRef(id *string) *networkmgmt.SubResource {
+	if id == nil {
+		return nil
+	}
+	return &networkmgmt.SubResource{ID: id}
+}
+
+// NewPublicIPAddressParameters returns an Azure PublicIPAddress object from a
+// public ip address spec and the supplied defaults.
+func NewPublicIPAddressParameters(s *v1alpha3.PublicIPAddress, d azure.ResourceDefaults) networkmgmt.PublicIPAddress {
 	p := s.Spec.ForProvider
 	return networkmgmt.PublicIPAddress{
 		Sku: NewPublicIPAddressSKU(s.Spec.ForProvider.SKU),
@@ -95,8 +140,8 @@ func NewPublicIPAddressParameters(s *v1alpha3.PublicIPAddress) networkmgmt.Publi
 			IdleTimeoutInMinutes:     p.TCPIdleTimeoutInMinutes,
 			IPTags:                   newIPTags(p.IPTags),
 		},
-		Location: &p.Location,
-		Tags:     azure.ToStringPtrMap(p.Tags),
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
 	}
 }
 
@@ -161,7 +206,31 @@ func NewServiceEndpoints(e []v1alpha3.ServiceEndpointPropertiesFormat) *[]networ
 func SubnetNeedsUpdate(kube *v1alpha3.Subnet, az networkmgmt.Subnet) bool {
 	up := NewSubnetParameters(kube)
 
-	return !reflect.DeepEqual(up.SubnetPropertiesFormat.AddressPrefix, az.SubnetPropertiesFormat.AddressPrefix)
+	var nsg *string
+	if az.SubnetPropertiesFormat.NetworkSecurityGroup != nil {
+		nsg = az.SubnetPropertiesFormat.NetworkSecurityGroup.ID
+	}
+	var rt *string
+	if az.SubnetPropertiesFormat.RouteTable != nil {
+		rt = az.SubnetPropertiesFormat.RouteTable.ID
+	}
+	var ng *string
+	if az.SubnetPropertiesFormat.NatGateway != nil {
+		ng = az.SubnetPropertiesFormat.NatGateway.ID
+	}
+
+	switch {
+	case !reflect.DeepEqual(up.SubnetPropertiesFormat.AddressPrefix, az.SubnetPropertiesFormat.AddressPrefix):
+		return true
+	case kube.Spec.NetworkSecurityGroupID != nil && !strings.EqualFold(azure.ToString(kube.Spec.NetworkSecurityGroupID), azure.ToString(nsg)):
+		return true
+	case kube.Spec.RouteTableID != nil && !strings.EqualFold(azure.ToString(kube.Spec.RouteTableID), azure.ToString(rt)):
+		return true
+	case kube.Spec.NATGatewayID != nil && !strings.EqualFold(azure.ToString(kube.Spec.NATGatewayID), azure.ToString(ng)):
+		return true
+	}
+
+	return false
 }
 
 // UpdateSubnetStatusFromAzure updates the status related to the external
@@ -199,10 +268,11 @@ func GeneratePublicIPAddressObservation(az networkmgmt.PublicIPAddress) *v1alpha
 	return v
 }
 
-// LateInitializePublicIPAddress late-initilizes a PublicIPAddress resource
-func LateInitializePublicIPAddress(p *v1alpha3.PublicIPAddressProperties, in *networkmgmt.PublicIPAddress) {
+// LateInitializePublicIPAddress late-initilizes a PublicIPAddress resource,
+// except for the supplied default tags.
+func LateInitializePublicIPAddress(p *v1alpha3.PublicIPAddressProperties, in *networkmgmt.PublicIPAddress, d azure.ResourceDefaults) {
 	p.PublicIPAddressDNSSettings = lateInitializeDNSSettings(p.PublicIPAddressDNSSettings, in.DNSSettings)
-	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	p.Tags = d.LateInitializeTags(p.Tags, in.Tags)
 	if p.SKU == nil && in.Sku != nil {
 		p.SKU = &v1alpha3.SKU{
 			Name: string(in.Sku.Name),
@@ -245,9 +315,10 @@ func lateInitializeDNSSettings(d *v1alpha3.PublicIPAddressDNSSettings, in *netwo
 }
 
 // IsPublicIPAddressUpToDate is used to report whether given network.PublicIPAddress is in
-// sync with the PublicIPAddressProperties that the user desires.
-func IsPublicIPAddressUpToDate(p v1alpha3.PublicIPAddressProperties, in networkmgmt.PublicIPAddress) bool {
-	if !cmp.Equal(p.Tags, azure.ToStringMap(in.Tags), cmpopts.EquateEmpty()) {
+// sync with the PublicIPAddressProperties that the user desires, merged with the
+// supplied defaults.
+func IsPublicIPAddressUpToDate(p v1alpha3.PublicIPAddressProperties, in networkmgmt.PublicIPAddress, d azure.ResourceDefaults) bool {
+	if !d.TagsUpToDate(d.MergeTags(p.Tags), in.Tags) {
 		return false
 	}
 
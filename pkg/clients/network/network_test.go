@@ -126,7 +126,7 @@ func TestNewVirtualNetworkParameters(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewVirtualNetworkParameters(tc.r)
+			got := NewVirtualNetworkParameters(tc.r, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewVirtualNetworkParameters(...): -want, +got\n%s", diff)
 			}
@@ -275,7 +275,7 @@ func TestVirtualNetworkNeedsUpdate(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := VirtualNetworkNeedsUpdate(tc.kube, tc.az)
+			got := VirtualNetworkNeedsUpdate(tc.kube, tc.az, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("VirtualNetworkNeedsUpdate(...): -want, +got\n%s", diff)
 			}
@@ -394,6 +394,46 @@ func TestNewSubnetParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithSecurityGroupAndRouteTable",
+			r: &v1alpha3.Subnet{
+				ObjectMeta: metav1.ObjectMeta{UID: uid},
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: azure.ToStringPtr("/nsg-id"),
+						RouteTableID:           azure.ToStringPtr("/route-table-id"),
+					},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints:     NewServiceEndpoints(nil),
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr("/nsg-id")},
+					RouteTable:           &networkmgmt.RouteTable{ID: azure.ToStringPtr("/route-table-id")},
+				},
+			},
+		},
+		{
+			name: "WithNATGateway",
+			r: &v1alpha3.Subnet{
+				ObjectMeta: metav1.ObjectMeta{UID: uid},
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+						NATGatewayID:  azure.ToStringPtr("/nat-gateway-id"),
+					},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:    azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints: NewServiceEndpoints(nil),
+					NatGateway:       &networkmgmt.SubResource{ID: azure.ToStringPtr("/nat-gateway-id")},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -406,6 +446,116 @@ func TestNewSubnetParameters(t *testing.T) {
 	}
 }
 
+func TestNewSubnetUpdate(t *testing.T) {
+	nsg := azure.ToStringPtr("/nsg-id")
+
+	cases := []struct {
+		name string
+		r    *v1alpha3.Subnet
+		az   networkmgmt.Subnet
+		want networkmgmt.Subnet
+	}{
+		{
+			name: "RetainsSecurityGroup",
+			r: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: nsg, Etag: azure.ToStringPtr("etag")},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints:     NewServiceEndpoints(nil),
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: nsg},
+				},
+			},
+		},
+		{
+			name: "RetainsRouteTable",
+			r: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					RouteTable: &networkmgmt.RouteTable{ID: azure.ToStringPtr("/route-table-id"), Etag: azure.ToStringPtr("etag")},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:    azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints: NewServiceEndpoints(nil),
+					RouteTable:       &networkmgmt.RouteTable{ID: azure.ToStringPtr("/route-table-id")},
+				},
+			},
+		},
+		{
+			name: "RetainsNATGateway",
+			r: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					NatGateway: &networkmgmt.SubResource{ID: azure.ToStringPtr("/nat-gateway-id")},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:    azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints: NewServiceEndpoints(nil),
+					NatGateway:       &networkmgmt.SubResource{ID: azure.ToStringPtr("/nat-gateway-id")},
+				},
+			},
+		},
+		{
+			name: "OverridesSecurityGroup",
+			r: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: azure.ToStringPtr("/other-nsg-id"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: nsg},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints:     NewServiceEndpoints(nil),
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr("/other-nsg-id")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewSubnetUpdate(tc.r, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewSubnetUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNewPublicIPAddressParameters(t *testing.T) {
 	cases := []struct {
 		name string
@@ -474,7 +624,7 @@ func TestNewPublicIPAddressParameters(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewPublicIPAddressParameters(tc.r)
+			got := NewPublicIPAddressParameters(tc.r, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewSubnetParameters(...): -want, +got\n%s", diff)
 			}
@@ -553,6 +703,145 @@ func TestSubnetNeedsUpdate(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "NeedsSecurityGroup",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "SecurityGroupUpToDate",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        &addressPrefix,
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/RG/providers/Microsoft.Network/networkSecurityGroups/nsg")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "SecurityGroupUnset",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        &addressPrefix,
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "RouteTableUnset",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+					RouteTable:    &networkmgmt.RouteTable{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/routeTables/rt")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "RouteTableUpToDate",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+						RouteTableID:  azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/routeTables/rt"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+					RouteTable:    &networkmgmt.RouteTable{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/routeTables/rt")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NeedsNATGatewayAdded",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+						NATGatewayID:  azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/natGateways/ng"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "NATGatewayUnset",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+					NatGateway:    &networkmgmt.SubResource{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/natGateways/ng")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NATGatewayUpToDate",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+						NATGatewayID:  azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/natGateways/ng"),
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+					NatGateway:    &networkmgmt.SubResource{ID: azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/natGateways/NG")},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -954,7 +1243,7 @@ func TestIsPublicIPAddressUpToDate(t *testing.T) {
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			if got := IsPublicIPAddressUpToDate(tt.args.p, tt.args.in); got != tt.want {
+			if got := IsPublicIPAddressUpToDate(tt.args.p, tt.args.in, azure.ResourceDefaults{}); got != tt.want {
 				t.Errorf("IsPublicIPAddressUpToDate() = %v, want %v", got, tt.want)
 			}
 		})
@@ -1126,7 +1415,7 @@ func TestLateInitializePublicIPAddress(t *testing.T) {
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			LateInitializePublicIPAddress(&tt.args.p, &tt.args.in)
+			LateInitializePublicIPAddress(&tt.args.p, &tt.args.in, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tt.want, tt.args.p); diff != "" {
 				t.Errorf("LateInitializePublicIPAddress(tt.args.p, tt.args.in): -want, +got\n%s", diff)
 			}
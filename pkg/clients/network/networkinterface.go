@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewNetworkInterfaceParameters returns an Azure Interface object from a
+// network interface spec and the supplied defaults.
+func NewNetworkInterfaceParameters(p v1alpha3.NetworkInterfaceParameters, d azure.ResourceDefaults) networkmgmt.Interface {
+	return networkmgmt.Interface{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		InterfacePropertiesFormat: &networkmgmt.InterfacePropertiesFormat{
+			IPConfigurations:            newInterfaceIPConfigurations(p.IPConfigurations),
+			EnableAcceleratedNetworking: p.EnableAcceleratedNetworking,
+			EnableIPForwarding:          p.EnableIPForwarding,
+			NetworkSecurityGroup:        newSecurityGroupRef(p.NetworkSecurityGroupID),
+			DNSSettings:                 &networkmgmt.InterfaceDNSSettings{DNSServers: azure.ToStringArrayPtr(p.DNSServers)},
+		},
+	}
+}
+
+// NewNetworkInterfaceUpdate returns the desired state of the supplied Azure
+// network interface.
+func NewNetworkInterfaceUpdate(p v1alpha3.NetworkInterfaceParameters, az networkmgmt.Interface, d azure.ResourceDefaults) networkmgmt.Interface {
+	nic := NewNetworkInterfaceParameters(p, d)
+	nic.Location = az.Location
+	nic.Tags = d.UpdateTags(d.MergeTags(p.Tags), az.Tags)
+	return nic
+}
+
+// GenerateNetworkInterfaceObservation returns the observed state of the
+// supplied Azure network interface.
+func GenerateNetworkInterfaceObservation(az networkmgmt.Interface) v1alpha3.NetworkInterfaceObservation {
+	o := v1alpha3.NetworkInterfaceObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	f := az.InterfacePropertiesFormat
+	if f == nil {
+		return o
+	}
+	o.State = azure.ToString(f.ProvisioningState)
+	o.ResourceGUID = azure.ToString(f.ResourceGUID)
+	o.MACAddress = azure.ToString(f.MacAddress)
+	if f.VirtualMachine != nil {
+		o.VirtualMachineID = azure.ToString(f.VirtualMachine.ID)
+	}
+	if f.IPConfigurations == nil {
+		return o
+	}
+	for _, ic := range *f.IPConfigurations {
+		if ic.InterfaceIPConfigurationPropertiesFormat == nil || ic.PrivateIPAddress == nil {
+			continue
+		}
+		o.PrivateIPAddresses = append(o.PrivateIPAddresses, *ic.PrivateIPAddress)
+		if azure.ToBool(ic.Primary) {
+			o.PrivateIPAddress = *ic.PrivateIPAddress
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkInterface late-initializes a NetworkInterface
+// resource, except for the supplied default tags.
+func LateInitializeNetworkInterface(p *v1alpha3.NetworkInterfaceParameters, az networkmgmt.Interface, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+	f := az.InterfacePropertiesFormat
+	if f == nil {
+		return
+	}
+	p.EnableAcceleratedNetworking = azure.LateInitializeBoolPtrFromPtr(p.EnableAcceleratedNetworking, f.EnableAcceleratedNetworking)
+	p.EnableIPForwarding = azure.LateInitializeBoolPtrFromPtr(p.EnableIPForwarding, f.EnableIPForwarding)
+	if len(p.DNSServers) == 0 && f.DNSSettings != nil && f.DNSSettings.DNSServers != nil {
+		p.DNSServers = *f.DNSSettings.DNSServers
+	}
+}
+
+// IsNetworkInterfaceUpToDate returns true if the supplied Azure network
+// interface is up to date with the supplied parameters, merged with the
+// supplied defaults. IP configurations are matched by name, and only their
+// optional properties that are set in the parameters are compared.
+func IsNetworkInterfaceUpToDate(p v1alpha3.NetworkInterfaceParameters, az networkmgmt.Interface, d azure.ResourceDefaults) bool {
+	f := az.InterfacePropertiesFormat
+	if f == nil {
+		return false
+	}
+	var nsgID *string
+	if f.NetworkSecurityGroup != nil {
+		nsgID = f.NetworkSecurityGroup.ID
+	}
+	var dnsServers []string
+	if f.DNSSettings != nil && f.DNSSettings.DNSServers != nil {
+		dnsServers = *f.DNSSettings.DNSServers
+	}
+	switch {
+	case !d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags):
+		return false
+	case boolNeedsUpdate(p.EnableAcceleratedNetworking, f.EnableAcceleratedNetworking):
+		return false
+	case boolNeedsUpdate(p.EnableIPForwarding, f.EnableIPForwarding):
+		return false
+	case !strings.EqualFold(azure.ToString(p.NetworkSecurityGroupID), azure.ToString(nsgID)):
+		return false
+	case !equalLists(p.DNSServers, dnsServers):
+		return false
+	}
+	return isInterfaceIPConfigurationsUpToDate(p.IPConfigurations, f.IPConfigurations)
+}
+
+func newInterfaceIPConfigurations(ics []v1alpha3.NetworkInterfaceIPConfiguration) *[]networkmgmt.InterfaceIPConfiguration {
+	r := make([]networkmgmt.InterfaceIPConfiguration, len(ics))
+	for i, ic := range ics {
+		r[i] = networkmgmt.InterfaceIPConfiguration{
+			Name: azure.ToStringPtr(ic.Name),
+			InterfaceIPConfigurationPropertiesFormat: &networkmgmt.InterfaceIPConfigurationPropertiesFormat{
+				Primary:                         ic.Primary,
+				Subnet:                          &networkmgmt.Subnet{ID: azure.ToStringPtr(ic.SubnetID)},
+				PrivateIPAddress:                ic.PrivateIPAddress,
+				PrivateIPAllocationMethod:       networkmgmt.IPAllocationMethod(azure.ToString(ic.PrivateIPAllocationMethod)),
+				PrivateIPAddressVersion:         networkmgmt.IPVersion(azure.ToString(ic.PrivateIPAddressVersion)),
+				LoadBalancerBackendAddressPools: newBackendAddressPoolRefs(ic.LoadBalancerBackendAddressPoolIDs),
+				LoadBalancerInboundNatRules:     newInboundNATRuleRefs(ic.LoadBalancerInboundNATRuleIDs),
+			},
+		}
+		if ic.PublicIPAddressID != nil {
+			r[i].PublicIPAddress = &networkmgmt.PublicIPAddress{ID: ic.PublicIPAddressID}
+		}
+	}
+	return &r
+}
+
+func newBackendAddressPoolRefs(ids []string) *[]networkmgmt.BackendAddressPool {
+	r := make([]networkmgmt.BackendAddressPool, len(ids))
+	for i := range ids {
+		r[i] = networkmgmt.BackendAddressPool{ID: azure.ToStringPtr(ids[i])}
+	}
+	return &r
+}
+
+func newInboundNATRuleRefs(ids []string) *[]networkmgmt.InboundNatRule {
+	r := make([]networkmgmt.InboundNatRule, len(ids))
+	for i := range ids {
+		r[i] = networkmgmt.InboundNatRule{ID: azure.ToStringPtr(ids[i])}
+	}
+	return &r
+}
+
+func isInterfaceIPConfigurationsUpToDate(ics []v1alpha3.NetworkInterfaceIPConfiguration, in *[]networkmgmt.InterfaceIPConfiguration) bool {
+	desired := make([]string, len(ics))
+	for i := range ics {
+		desired[i] = ics[i].Name
+	}
+	var az []networkmgmt.InterfaceIPConfiguration
+	if in != nil {
+		az = *in
+	}
+	observed := make([]string, len(az))
+	for i := range az {
+		observed[i] = azure.ToString(az[i].Name)
+	}
+	return equalByName(desired, observed, func(i, j int) bool {
+		p, f := ics[i], az[j].InterfaceIPConfigurationPropertiesFormat
+		if f == nil {
+			return false
+		}
+		var subnetID, publicIPAddressID *string
+		if f.Subnet != nil {
+			subnetID = f.Subnet.ID
+		}
+		if f.PublicIPAddress != nil {
+			publicIPAddressID = f.PublicIPAddress.ID
+		}
+		var poolIDs, natRuleIDs []string
+		if f.LoadBalancerBackendAddressPools != nil {
+			for _, bap := range *f.LoadBalancerBackendAddressPools {
+				poolIDs = append(poolIDs, azure.ToString(bap.ID))
+			}
+		}
+		if f.LoadBalancerInboundNatRules != nil {
+			for _, r := range *f.LoadBalancerInboundNatRules {
+				natRuleIDs = append(natRuleIDs, azure.ToString(r.ID))
+			}
+		}
+		switch {
+		case !strings.EqualFold(p.SubnetID, azure.ToString(subnetID)):
+			return false
+		case !strings.EqualFold(azure.ToString(p.PublicIPAddressID), azure.ToString(publicIPAddressID)):
+			return false
+		case boolNeedsUpdate(p.Primary, f.Primary):
+			return false
+		case stringNeedsUpdate(p.PrivateIPAllocationMethod, string(f.PrivateIPAllocationMethod)):
+			return false
+		case stringNeedsUpdate(p.PrivateIPAddress, azure.ToString(f.PrivateIPAddress)):
+			return false
+		case stringNeedsUpdate(p.PrivateIPAddressVersion, string(f.PrivateIPAddressVersion)):
+			return false
+		case !equalSets(p.LoadBalancerBackendAddressPoolIDs, poolIDs):
+			return false
+		}
+		return equalSets(p.LoadBalancerInboundNATRuleIDs, natRuleIDs)
+	})
+}
+
+// equalLists returns true if the supplied slices contain the same values in
+// the same order.
+func equalLists(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
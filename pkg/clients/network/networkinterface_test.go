@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	nicSubnetID   = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet"
+	nicPublicIPID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip"
+	nicNSGID      = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg"
+	nicPoolID     = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb/backendAddressPools/pool"
+)
+
+func networkInterfaceParameters() v1alpha3.NetworkInterfaceParameters {
+	return v1alpha3.NetworkInterfaceParameters{
+		Location: location,
+		IPConfigurations: []v1alpha3.NetworkInterfaceIPConfiguration{
+			{
+				Name:                              "primary",
+				Primary:                           azure.ToBoolPtr(true),
+				SubnetID:                          nicSubnetID,
+				PrivateIPAllocationMethod:         azure.ToStringPtr("Static"),
+				PrivateIPAddress:                  azure.ToStringPtr("10.0.0.4"),
+				PublicIPAddressID:                 azure.ToStringPtr(nicPublicIPID),
+				LoadBalancerBackendAddressPoolIDs: []string{nicPoolID},
+			},
+			{
+				Name:     "secondary",
+				SubnetID: nicSubnetID,
+			},
+		},
+		EnableAcceleratedNetworking: azure.ToBoolPtr(true),
+		NetworkSecurityGroupID:      azure.ToStringPtr(nicNSGID),
+		DNSServers:                  []string{"10.0.0.10", "10.0.0.11"},
+		Tags:                        map[string]string{"env": "test"},
+	}
+}
+
+func azureNetworkInterface() networkmgmt.Interface {
+	return networkmgmt.Interface{
+		ID:       azure.ToStringPtr("/nic-id"),
+		Etag:     azure.ToStringPtr("etag"),
+		Location: azure.ToStringPtr(location),
+		Tags:     map[string]*string{"env": azure.ToStringPtr("test")},
+		InterfacePropertiesFormat: &networkmgmt.InterfacePropertiesFormat{
+			IPConfigurations: &[]networkmgmt.InterfaceIPConfiguration{
+				{
+					// Azure does not necessarily return IP configurations in
+					// the order they were supplied in.
+					Name: azure.ToStringPtr("secondary"),
+					InterfaceIPConfigurationPropertiesFormat: &networkmgmt.InterfaceIPConfigurationPropertiesFormat{
+						Primary:                   azure.ToBoolPtr(false, azure.FieldRequired),
+						Subnet:                    &networkmgmt.Subnet{ID: azure.ToStringPtr(nicSubnetID)},
+						PrivateIPAddress:          azure.ToStringPtr("10.0.0.5"),
+						PrivateIPAllocationMethod: networkmgmt.Dynamic,
+						PrivateIPAddressVersion:   networkmgmt.IPv4,
+					},
+				},
+				{
+					Name: azure.ToStringPtr("primary"),
+					InterfaceIPConfigurationPropertiesFormat: &networkmgmt.InterfaceIPConfigurationPropertiesFormat{
+						Primary:                         azure.ToBoolPtr(true),
+						Subnet:                          &networkmgmt.Subnet{ID: azure.ToStringPtr(nicSubnetID)},
+						PrivateIPAddress:                azure.ToStringPtr("10.0.0.4"),
+						PrivateIPAllocationMethod:       networkmgmt.Static,
+						PrivateIPAddressVersion:         networkmgmt.IPv4,
+						PublicIPAddress:                 &networkmgmt.PublicIPAddress{ID: azure.ToStringPtr(nicPublicIPID)},
+						LoadBalancerBackendAddressPools: &[]networkmgmt.BackendAddressPool{{ID: azure.ToStringPtr(nicPoolID)}},
+					},
+				},
+			},
+			EnableAcceleratedNetworking: azure.ToBoolPtr(true),
+			EnableIPForwarding:          azure.ToBoolPtr(false, azure.FieldRequired),
+			NetworkSecurityGroup:        &networkmgmt.SecurityGroup{ID: azure.ToStringPtr(nicNSGID)},
+			DNSSettings:                 &networkmgmt.InterfaceDNSSettings{DNSServers: &[]string{"10.0.0.10", "10.0.0.11"}},
+			MacAddress:                  azure.ToStringPtr("00-0D-3A-00-00-01"),
+			VirtualMachine:              &networkmgmt.SubResource{ID: azure.ToStringPtr("/vm-id")},
+			ResourceGUID:                azure.ToStringPtr("guid"),
+			ProvisioningState:           azure.ToStringPtr("Succeeded"),
+		},
+	}
+}
+
+func TestNewNetworkInterfaceParameters(t *testing.T) {
+	p := v1alpha3.NetworkInterfaceParameters{
+		IPConfigurations: []v1alpha3.NetworkInterfaceIPConfiguration{{
+			Name:              "primary",
+			SubnetID:          nicSubnetID,
+			PublicIPAddressID: azure.ToStringPtr(nicPublicIPID),
+		}},
+		EnableIPForwarding: azure.ToBoolPtr(true),
+	}
+	d := azure.ResourceDefaults{Location: location, Tags: map[string]string{"managed-by": "crossplane"}}
+	want := networkmgmt.Interface{
+		Location: azure.ToStringPtr(location),
+		Tags:     map[string]*string{"managed-by": azure.ToStringPtr("crossplane")},
+		InterfacePropertiesFormat: &networkmgmt.InterfacePropertiesFormat{
+			IPConfigurations: &[]networkmgmt.InterfaceIPConfiguration{{
+				Name: azure.ToStringPtr("primary"),
+				InterfaceIPConfigurationPropertiesFormat: &networkmgmt.InterfaceIPConfigurationPropertiesFormat{
+					Subnet:                          &networkmgmt.Subnet{ID: azure.ToStringPtr(nicSubnetID)},
+					PublicIPAddress:                 &networkmgmt.PublicIPAddress{ID: azure.ToStringPtr(nicPublicIPID)},
+					LoadBalancerBackendAddressPools: &[]networkmgmt.BackendAddressPool{},
+					LoadBalancerInboundNatRules:     &[]networkmgmt.InboundNatRule{},
+				},
+			}},
+			EnableIPForwarding: azure.ToBoolPtr(true),
+			DNSSettings:        &networkmgmt.InterfaceDNSSettings{},
+		},
+	}
+
+	got := NewNetworkInterfaceParameters(p, d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewNetworkInterfaceParameters(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestIsNetworkInterfaceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(p *v1alpha3.NetworkInterfaceParameters)
+		az   func(az *networkmgmt.Interface)
+		want bool
+	}{
+		"UpToDate": {
+			want: true,
+		},
+		"IDsDifferInCase": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.NetworkSecurityGroupID = azure.ToStringPtr("/SUBSCRIPTIONS/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg")
+			},
+			want: true,
+		},
+		"TagsChanged": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.Tags = map[string]string{"env": "prod"}
+			},
+		},
+		"IPForwardingEnabled": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.EnableIPForwarding = azure.ToBoolPtr(true)
+			},
+		},
+		"NetworkSecurityGroupRemoved": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.NetworkSecurityGroupID = nil
+			},
+		},
+		"DNSServersReordered": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.DNSServers = []string{"10.0.0.11", "10.0.0.10"}
+			},
+		},
+		"IPConfigurationAdded": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.IPConfigurations = append(p.IPConfigurations, v1alpha3.NetworkInterfaceIPConfiguration{Name: "tertiary", SubnetID: nicSubnetID})
+			},
+		},
+		"PrivateIPAddressChanged": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.IPConfigurations[0].PrivateIPAddress = azure.ToStringPtr("10.0.0.6")
+			},
+		},
+		"PublicIPAddressRemoved": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.IPConfigurations[0].PublicIPAddressID = nil
+			},
+		},
+		"BackendAddressPoolRemoved": {
+			p: func(p *v1alpha3.NetworkInterfaceParameters) {
+				p.IPConfigurations[0].LoadBalancerBackendAddressPoolIDs = nil
+			},
+		},
+		"NoProperties": {
+			az: func(az *networkmgmt.Interface) {
+				az.InterfacePropertiesFormat = nil
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, az := networkInterfaceParameters(), azureNetworkInterface()
+			if tc.p != nil {
+				tc.p(&p)
+			}
+			if tc.az != nil {
+				tc.az(&az)
+			}
+			got := IsNetworkInterfaceUpToDate(p, az, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNetworkInterfaceUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeNetworkInterface(t *testing.T) {
+	p := v1alpha3.NetworkInterfaceParameters{
+		IPConfigurations: []v1alpha3.NetworkInterfaceIPConfiguration{{Name: "primary", SubnetID: nicSubnetID}},
+	}
+	want := v1alpha3.NetworkInterfaceParameters{
+		IPConfigurations:            []v1alpha3.NetworkInterfaceIPConfiguration{{Name: "primary", SubnetID: nicSubnetID}},
+		EnableAcceleratedNetworking: azure.ToBoolPtr(true),
+		EnableIPForwarding:          azure.ToBoolPtr(false, azure.FieldRequired),
+		DNSServers:                  []string{"10.0.0.10", "10.0.0.11"},
+		Tags:                        map[string]string{"env": "test"},
+	}
+
+	LateInitializeNetworkInterface(&p, azureNetworkInterface(), azure.ResourceDefaults{})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeNetworkInterface(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestGenerateNetworkInterfaceObservation(t *testing.T) {
+	want := v1alpha3.NetworkInterfaceObservation{
+		State:              "Succeeded",
+		Etag:               "etag",
+		ID:                 "/nic-id",
+		ResourceGUID:       "guid",
+		MACAddress:         "00-0D-3A-00-00-01",
+		PrivateIPAddress:   "10.0.0.4",
+		PrivateIPAddresses: []string{"10.0.0.5", "10.0.0.4"},
+		VirtualMachineID:   "/vm-id",
+	}
+
+	got := GenerateNetworkInterfaceObservation(azureNetworkInterface())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateNetworkInterfaceObservation(...): -want, +got\n%s", diff)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// PrivateDNSZoneGroupName is the name of the DNS zone group through which a
+// private endpoint is registered in its private DNS zone.
+const PrivateDNSZoneGroupName = "default"
+
+// NewPrivateEndpointParameters returns an Azure PrivateEndpoint object from a
+// private endpoint spec and the supplied defaults. The connection to the
+// private link resource is named after the endpoint.
+func NewPrivateEndpointParameters(name string, p v1alpha3.PrivateEndpointParameters, d azure.ResourceDefaults) networkmgmt.PrivateEndpoint {
+	groupIDs := make([]string, len(p.GroupIDs))
+	copy(groupIDs, p.GroupIDs)
+	return networkmgmt.PrivateEndpoint{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		PrivateEndpointProperties: &networkmgmt.PrivateEndpointProperties{
+			Subnet: &networkmgmt.Subnet{ID: azure.ToStringPtr(p.SubnetID)},
+			PrivateLinkServiceConnections: &[]networkmgmt.PrivateLinkServiceConnection{{
+				Name: azure.ToStringPtr(name),
+				PrivateLinkServiceConnectionProperties: &networkmgmt.PrivateLinkServiceConnectionProperties{
+					PrivateLinkServiceID: azure.ToStringPtr(p.PrivateLinkResourceID),
+					GroupIds:             &groupIDs,
+				},
+			}},
+		},
+	}
+}
+
+// NewPrivateEndpointUpdate returns the supplied Azure private endpoint with
+// its tags updated.
+func NewPrivateEndpointUpdate(p v1alpha3.PrivateEndpointParameters, az networkmgmt.PrivateEndpoint, d azure.ResourceDefaults) networkmgmt.PrivateEndpoint {
+	az.Tags = d.UpdateTags(d.MergeTags(p.Tags), az.Tags)
+	return az
+}
+
+// LateInitializePrivateEndpoint late-initializes a PrivateEndpoint resource,
+// except for the supplied default tags.
+func LateInitializePrivateEndpoint(p *v1alpha3.PrivateEndpointParameters, az networkmgmt.PrivateEndpoint, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+}
+
+// IsPrivateEndpointUpToDate returns true if the tags of the supplied Azure
+// private endpoint are up to date with the supplied parameters, merged with
+// the supplied defaults. All other parameters are immutable.
+func IsPrivateEndpointUpToDate(p v1alpha3.PrivateEndpointParameters, az networkmgmt.PrivateEndpoint, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags)
+}
+
+// NewPrivateDNSZoneGroupParameters returns an Azure PrivateDNSZoneGroup object
+// that registers a private endpoint in the supplied private DNS zone.
+func NewPrivateDNSZoneGroupParameters(zoneID string) networkmgmt.PrivateDNSZoneGroup {
+	return networkmgmt.PrivateDNSZoneGroup{
+		PrivateDNSZoneGroupPropertiesFormat: &networkmgmt.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: &[]networkmgmt.PrivateDNSZoneConfig{{
+				Name: azure.ToStringPtr(privateDNSZoneConfigName(zoneID)),
+				PrivateDNSZonePropertiesFormat: &networkmgmt.PrivateDNSZonePropertiesFormat{
+					PrivateDNSZoneID: azure.ToStringPtr(zoneID),
+				},
+			}},
+		},
+	}
+}
+
+// IsPrivateDNSZoneGroupUpToDate returns true if the supplied Azure DNS zone
+// group, which is nil if it does not exist, registers the endpoint in the
+// desired private DNS zone, or does not exist if none is desired.
+func IsPrivateDNSZoneGroupUpToDate(p v1alpha3.PrivateEndpointParameters, az *networkmgmt.PrivateDNSZoneGroup) bool {
+	if p.PrivateDNSZoneID == nil {
+		return az == nil
+	}
+	if az == nil || az.PrivateDNSZoneGroupPropertiesFormat == nil || az.PrivateDNSZoneConfigs == nil {
+		return false
+	}
+	cfgs := *az.PrivateDNSZoneConfigs
+	if len(cfgs) != 1 || cfgs[0].PrivateDNSZonePropertiesFormat == nil {
+		return false
+	}
+	return strings.EqualFold(*p.PrivateDNSZoneID, azure.ToString(cfgs[0].PrivateDNSZoneID))
+}
+
+// GeneratePrivateEndpointObservation returns the observed state of the
+// supplied Azure private endpoint and its DNS zone group, which is nil if it
+// does not exist. The DNS records of the zone group take precedence over the
+// custom DNS configurations of the endpoint.
+func GeneratePrivateEndpointObservation(az networkmgmt.PrivateEndpoint, zg *networkmgmt.PrivateDNSZoneGroup) v1alpha3.PrivateEndpointObservation {
+	o := v1alpha3.PrivateEndpointObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if zg != nil && zg.PrivateDNSZoneGroupPropertiesFormat != nil && zg.PrivateDNSZoneConfigs != nil {
+		for _, cfg := range *zg.PrivateDNSZoneConfigs {
+			if cfg.PrivateDNSZonePropertiesFormat == nil || cfg.RecordSets == nil {
+				continue
+			}
+			for _, rs := range *cfg.RecordSets {
+				o.DNSConfigs = appendDNSConfig(o.DNSConfigs, rs.Fqdn, rs.IPAddresses)
+			}
+		}
+	}
+	f := az.PrivateEndpointProperties
+	if f == nil {
+		return o
+	}
+	o.State = string(f.ProvisioningState)
+	if f.PrivateLinkServiceConnections != nil {
+		for _, c := range *f.PrivateLinkServiceConnections {
+			if c.PrivateLinkServiceConnectionProperties != nil && c.PrivateLinkServiceConnectionState != nil {
+				o.ConnectionState = azure.ToString(c.PrivateLinkServiceConnectionState.Status)
+				break
+			}
+		}
+	}
+	if f.CustomDNSConfigs != nil {
+		for _, c := range *f.CustomDNSConfigs {
+			o.DNSConfigs = appendDNSConfig(o.DNSConfigs, c.Fqdn, c.IPAddresses)
+		}
+	}
+	if f.NetworkInterfaces != nil {
+		for _, nic := range *f.NetworkInterfaces {
+			o.NetworkInterfaceIDs = append(o.NetworkInterfaceIDs, azure.ToString(nic.ID))
+		}
+	}
+	if len(o.DNSConfigs) > 0 {
+		o.FQDN = o.DNSConfigs[0].FQDN
+		if len(o.DNSConfigs[0].IPAddresses) > 0 {
+			o.PrivateIPAddress = o.DNSConfigs[0].IPAddresses[0]
+		}
+	}
+	return o
+}
+
+// appendDNSConfig appends a DNS config with the supplied FQDN and IP addresses
+// unless one with the same FQDN was already appended.
+func appendDNSConfig(cfgs []v1alpha3.PrivateEndpointDNSConfig, fqdn *string, ips *[]string) []v1alpha3.PrivateEndpointDNSConfig {
+	c := v1alpha3.PrivateEndpointDNSConfig{FQDN: azure.ToString(fqdn)}
+	for _, e := range cfgs {
+		if strings.EqualFold(e.FQDN, c.FQDN) {
+			return cfgs
+		}
+	}
+	if ips != nil {
+		c.IPAddresses = append(c.IPAddresses, *ips...)
+	}
+	return append(cfgs, c)
+}
+
+// privateDNSZoneConfigName returns the name of the DNS zone group config for
+// the supplied private DNS zone ID, e.g. privatelink-blob-core-windows-net.
+func privateDNSZoneConfigName(zoneID string) string {
+	return strings.ReplaceAll(zoneID[strings.LastIndex(zoneID, "/")+1:], ".", "-")
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var (
+	privateLinkResourceID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/cool"
+	privateDNSZoneID      = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"
+)
+
+func TestNewPrivateEndpointParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.PrivateEndpointParameters
+		d    azure.ResourceDefaults
+		want networkmgmt.PrivateEndpoint
+	}{
+		"Successful": {
+			p: v1alpha3.PrivateEndpointParameters{
+				SubnetID:              "/subnet-id",
+				PrivateLinkResourceID: privateLinkResourceID,
+				GroupIDs:              []string{"blob"},
+				Tags:                  map[string]string{"env": "prod"},
+			},
+			d: azure.ResourceDefaults{Location: location, Tags: map[string]string{"managed-by": "crossplane"}},
+			want: networkmgmt.PrivateEndpoint{
+				Location: azure.ToStringPtr(location),
+				Tags: map[string]*string{
+					"env":        azure.ToStringPtr("prod"),
+					"managed-by": azure.ToStringPtr("crossplane"),
+				},
+				PrivateEndpointProperties: &networkmgmt.PrivateEndpointProperties{
+					Subnet: &networkmgmt.Subnet{ID: azure.ToStringPtr("/subnet-id")},
+					PrivateLinkServiceConnections: &[]networkmgmt.PrivateLinkServiceConnection{{
+						Name: azure.ToStringPtr("cool-endpoint"),
+						PrivateLinkServiceConnectionProperties: &networkmgmt.PrivateLinkServiceConnectionProperties{
+							PrivateLinkServiceID: azure.ToStringPtr(privateLinkResourceID),
+							GroupIds:             &[]string{"blob"},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewPrivateEndpointParameters("cool-endpoint", tc.p, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewPrivateEndpointParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPrivateDNSZoneGroupParameters(t *testing.T) {
+	want := networkmgmt.PrivateDNSZoneGroup{
+		PrivateDNSZoneGroupPropertiesFormat: &networkmgmt.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: &[]networkmgmt.PrivateDNSZoneConfig{{
+				Name: azure.ToStringPtr("privatelink-blob-core-windows-net"),
+				PrivateDNSZonePropertiesFormat: &networkmgmt.PrivateDNSZonePropertiesFormat{
+					PrivateDNSZoneID: azure.ToStringPtr(privateDNSZoneID),
+				},
+			}},
+		},
+	}
+	got := NewPrivateDNSZoneGroupParameters(privateDNSZoneID)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewPrivateDNSZoneGroupParameters(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestIsPrivateDNSZoneGroupUpToDate(t *testing.T) {
+	zg := NewPrivateDNSZoneGroupParameters(privateDNSZoneID)
+
+	cases := map[string]struct {
+		p    v1alpha3.PrivateEndpointParameters
+		az   *networkmgmt.PrivateDNSZoneGroup
+		want bool
+	}{
+		"NoneDesiredNoneExists": {
+			p:    v1alpha3.PrivateEndpointParameters{},
+			want: true,
+		},
+		"NoneDesiredOneExists": {
+			p:    v1alpha3.PrivateEndpointParameters{},
+			az:   &zg,
+			want: false,
+		},
+		"DesiredNoneExists": {
+			p:    v1alpha3.PrivateEndpointParameters{PrivateDNSZoneID: azure.ToStringPtr(privateDNSZoneID)},
+			want: false,
+		},
+		"DesiredExists": {
+			p:    v1alpha3.PrivateEndpointParameters{PrivateDNSZoneID: azure.ToStringPtr(privateDNSZoneID)},
+			az:   &zg,
+			want: true,
+		},
+		"DifferentZone": {
+			p:    v1alpha3.PrivateEndpointParameters{PrivateDNSZoneID: azure.ToStringPtr("/other-zone-id")},
+			az:   &zg,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPrivateDNSZoneGroupUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPrivateDNSZoneGroupUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePrivateEndpointObservation(t *testing.T) {
+	endpoint := networkmgmt.PrivateEndpoint{
+		ID:   azure.ToStringPtr("/endpoint-id"),
+		Etag: azure.ToStringPtr("etag"),
+		PrivateEndpointProperties: &networkmgmt.PrivateEndpointProperties{
+			ProvisioningState: networkmgmt.ProvisioningStateSucceeded,
+			NetworkInterfaces: &[]networkmgmt.Interface{{ID: azure.ToStringPtr("/nic-id")}},
+			PrivateLinkServiceConnections: &[]networkmgmt.PrivateLinkServiceConnection{{
+				PrivateLinkServiceConnectionProperties: &networkmgmt.PrivateLinkServiceConnectionProperties{
+					PrivateLinkServiceConnectionState: &networkmgmt.PrivateLinkServiceConnectionState{
+						Status: azure.ToStringPtr("Approved"),
+					},
+				},
+			}},
+			CustomDNSConfigs: &[]networkmgmt.CustomDNSConfigPropertiesFormat{{
+				Fqdn:        azure.ToStringPtr("cool.blob.core.windows.net"),
+				IPAddresses: &[]string{"10.0.0.4"},
+			}},
+		},
+	}
+
+	cases := map[string]struct {
+		az   networkmgmt.PrivateEndpoint
+		zg   *networkmgmt.PrivateDNSZoneGroup
+		want v1alpha3.PrivateEndpointObservation
+	}{
+		"NoDNSZoneGroup": {
+			az: endpoint,
+			want: v1alpha3.PrivateEndpointObservation{
+				State:               string(networkmgmt.ProvisioningStateSucceeded),
+				ConnectionState:     "Approved",
+				PrivateIPAddress:    "10.0.0.4",
+				FQDN:                "cool.blob.core.windows.net",
+				DNSConfigs:          []v1alpha3.PrivateEndpointDNSConfig{{FQDN: "cool.blob.core.windows.net", IPAddresses: []string{"10.0.0.4"}}},
+				NetworkInterfaceIDs: []string{"/nic-id"},
+				Etag:                "etag",
+				ID:                  "/endpoint-id",
+			},
+		},
+		"DNSZoneGroupRecordsFirst": {
+			az: endpoint,
+			zg: &networkmgmt.PrivateDNSZoneGroup{
+				PrivateDNSZoneGroupPropertiesFormat: &networkmgmt.PrivateDNSZoneGroupPropertiesFormat{
+					PrivateDNSZoneConfigs: &[]networkmgmt.PrivateDNSZoneConfig{{
+						PrivateDNSZonePropertiesFormat: &networkmgmt.PrivateDNSZonePropertiesFormat{
+							RecordSets: &[]networkmgmt.RecordSet{{
+								Fqdn:        azure.ToStringPtr("cool.privatelink.blob.core.windows.net"),
+								IPAddresses: &[]string{"10.0.0.4"},
+							}},
+						},
+					}},
+				},
+			},
+			want: v1alpha3.PrivateEndpointObservation{
+				State:            string(networkmgmt.ProvisioningStateSucceeded),
+				ConnectionState:  "Approved",
+				PrivateIPAddress: "10.0.0.4",
+				FQDN:             "cool.privatelink.blob.core.windows.net",
+				DNSConfigs: []v1alpha3.PrivateEndpointDNSConfig{
+					{FQDN: "cool.privatelink.blob.core.windows.net", IPAddresses: []string{"10.0.0.4"}},
+					{FQDN: "cool.blob.core.windows.net", IPAddresses: []string{"10.0.0.4"}},
+				},
+				NetworkInterfaceIDs: []string{"/nic-id"},
+				Etag:                "etag",
+				ID:                  "/endpoint-id",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePrivateEndpointObservation(tc.az, tc.zg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePrivateEndpointObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
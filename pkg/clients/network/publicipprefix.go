@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewPublicIPPrefixParameters returns an Azure PublicIPPrefix object from a
+// public IP prefix spec and the supplied defaults. Standard is the only SKU of
+// public IP prefixes.
+func NewPublicIPPrefixParameters(p v1alpha3.PublicIPPrefixParameters, d azure.ResourceDefaults) networkmgmt.PublicIPPrefix {
+	return networkmgmt.PublicIPPrefix{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		Sku:      &networkmgmt.PublicIPPrefixSku{Name: networkmgmt.PublicIPPrefixSkuNameStandard},
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		PublicIPPrefixPropertiesFormat: &networkmgmt.PublicIPPrefixPropertiesFormat{
+			PrefixLength:           azure.ToInt32Ptr(int(p.PrefixLength), azure.FieldRequired),
+			PublicIPAddressVersion: networkmgmt.IPVersion(azure.ToString(p.PublicIPAddressVersion)),
+			IPTags:                 newIPTags(p.IPTags),
+		},
+	}
+}
+
+// NewPublicIPPrefixTags returns the tags of the supplied public IP prefix
+// updated with the desired tags.
+func NewPublicIPPrefixTags(p v1alpha3.PublicIPPrefixParameters, az networkmgmt.PublicIPPrefix, d azure.ResourceDefaults) networkmgmt.TagsObject {
+	return networkmgmt.TagsObject{Tags: d.UpdateTags(d.MergeTags(p.Tags), az.Tags)}
+}
+
+// GeneratePublicIPPrefixObservation returns the observed state of the
+// supplied Azure public IP prefix.
+func GeneratePublicIPPrefixObservation(az networkmgmt.PublicIPPrefix) v1alpha3.PublicIPPrefixObservation {
+	o := v1alpha3.PublicIPPrefixObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	f := az.PublicIPPrefixPropertiesFormat
+	if f == nil {
+		return o
+	}
+	o.State = azure.ToString(f.ProvisioningState)
+	o.ResourceGUID = azure.ToString(f.ResourceGUID)
+	o.IPPrefix = azure.ToString(f.IPPrefix)
+	if f.PublicIPAddresses != nil {
+		for _, ip := range *f.PublicIPAddresses {
+			o.PublicIPAddressIDs = append(o.PublicIPAddressIDs, azure.ToString(ip.ID))
+		}
+	}
+	return o
+}
+
+// LateInitializePublicIPPrefix late-initializes a PublicIPPrefix resource,
+// except for the supplied default tags.
+func LateInitializePublicIPPrefix(p *v1alpha3.PublicIPPrefixParameters, az networkmgmt.PublicIPPrefix, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, az.Zones)
+	f := az.PublicIPPrefixPropertiesFormat
+	if f == nil {
+		return
+	}
+	if f.PublicIPAddressVersion != "" {
+		p.PublicIPAddressVersion = azure.LateInitializeStringPtrFromVal(p.PublicIPAddressVersion, string(f.PublicIPAddressVersion))
+	}
+	p.IPTags = lateInitializeIPTags(p.IPTags, f.IPTags)
+}
+
+// IsPublicIPPrefixUpToDate returns true if the supplied Azure public IP prefix
+// is up to date with the supplied parameters, merged with the supplied
+// defaults. Only tags can be updated.
+func IsPublicIPPrefixUpToDate(p v1alpha3.PublicIPPrefixParameters, az networkmgmt.PublicIPPrefix, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags)
+}
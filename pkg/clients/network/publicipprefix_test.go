@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestNewPublicIPPrefixParameters(t *testing.T) {
+	p := v1alpha3.PublicIPPrefixParameters{
+		PrefixLength: 28,
+		IPTags:       []v1alpha3.IPTag{{IPTagType: "FirstPartyUsage", Tag: "SQL"}},
+		Zones:        []string{"1", "2", "3"},
+	}
+	d := azure.ResourceDefaults{Location: location, Tags: map[string]string{"managed-by": "crossplane"}}
+	want := networkmgmt.PublicIPPrefix{
+		Location: azure.ToStringPtr(location),
+		Tags:     map[string]*string{"managed-by": azure.ToStringPtr("crossplane")},
+		Sku:      &networkmgmt.PublicIPPrefixSku{Name: networkmgmt.PublicIPPrefixSkuNameStandard},
+		Zones:    &[]string{"1", "2", "3"},
+		PublicIPPrefixPropertiesFormat: &networkmgmt.PublicIPPrefixPropertiesFormat{
+			PrefixLength: azure.ToInt32Ptr(28),
+			IPTags: &[]networkmgmt.IPTag{{
+				IPTagType: azure.ToStringPtr("FirstPartyUsage"),
+				Tag:       azure.ToStringPtr("SQL"),
+			}},
+		},
+	}
+
+	got := NewPublicIPPrefixParameters(p, d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewPublicIPPrefixParameters(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestLateInitializePublicIPPrefix(t *testing.T) {
+	az := networkmgmt.PublicIPPrefix{
+		Zones: &[]string{"1"},
+		Tags:  map[string]*string{"env": azure.ToStringPtr("test"), "managed-by": azure.ToStringPtr("crossplane")},
+		PublicIPPrefixPropertiesFormat: &networkmgmt.PublicIPPrefixPropertiesFormat{
+			PrefixLength:           azure.ToInt32Ptr(31),
+			PublicIPAddressVersion: networkmgmt.IPv4,
+		},
+	}
+	p := v1alpha3.PublicIPPrefixParameters{PrefixLength: 31}
+	want := v1alpha3.PublicIPPrefixParameters{
+		PrefixLength:           31,
+		PublicIPAddressVersion: azure.ToStringPtr("IPv4"),
+		Zones:                  []string{"1"},
+		Tags:                   map[string]string{"env": "test"},
+	}
+
+	LateInitializePublicIPPrefix(&p, az, azure.ResourceDefaults{Tags: map[string]string{"managed-by": "crossplane"}})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializePublicIPPrefix(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestGeneratePublicIPPrefixObservation(t *testing.T) {
+	az := networkmgmt.PublicIPPrefix{
+		ID:   azure.ToStringPtr("/prefix-id"),
+		Etag: azure.ToStringPtr("etag"),
+		PublicIPPrefixPropertiesFormat: &networkmgmt.PublicIPPrefixPropertiesFormat{
+			IPPrefix:          azure.ToStringPtr("20.50.0.0/31"),
+			PublicIPAddresses: &[]networkmgmt.ReferencedPublicIPAddress{{ID: azure.ToStringPtr("/pip-id")}},
+			ResourceGUID:      azure.ToStringPtr("guid"),
+			ProvisioningState: azure.ToStringPtr("Succeeded"),
+		},
+	}
+	want := v1alpha3.PublicIPPrefixObservation{
+		State:              "Succeeded",
+		Etag:               "etag",
+		ID:                 "/prefix-id",
+		ResourceGUID:       "guid",
+		IPPrefix:           "20.50.0.0/31",
+		PublicIPAddressIDs: []string{"/pip-id"},
+	}
+
+	got := GeneratePublicIPPrefixObservation(az)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GeneratePublicIPPrefixObservation(...): -want, +got\n%s", diff)
+	}
+}
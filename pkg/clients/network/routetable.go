@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewRouteTableParameters returns an Azure RouteTable object from a route
+// table spec and the supplied defaults. Routes are managed by Routes, so none
+// are included.
+func NewRouteTableParameters(p v1alpha3.RouteTableParameters, d azure.ResourceDefaults) networkmgmt.RouteTable {
+	return networkmgmt.RouteTable{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+		RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+			DisableBgpRoutePropagation: p.DisableBGPRoutePropagation,
+		},
+	}
+}
+
+// NewRouteTableUpdate returns the supplied Azure route table updated with the
+// desired parameters. Its routes are retained.
+func NewRouteTableUpdate(p v1alpha3.RouteTableParameters, az networkmgmt.RouteTable, d azure.ResourceDefaults) networkmgmt.RouteTable {
+	rt := networkmgmt.RouteTable{
+		Location: az.Location,
+		Tags:     d.UpdateTags(d.MergeTags(p.Tags), az.Tags),
+		RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+			DisableBgpRoutePropagation: p.DisableBGPRoutePropagation,
+		},
+	}
+	if az.RouteTablePropertiesFormat != nil {
+		rt.Routes = az.Routes
+	}
+	return rt
+}
+
+// GenerateRouteTableObservation returns the observed state of the supplied
+// Azure route table.
+func GenerateRouteTableObservation(az networkmgmt.RouteTable) v1alpha3.RouteTableObservation {
+	o := v1alpha3.RouteTableObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.RouteTablePropertiesFormat == nil {
+		return o
+	}
+	o.State = azure.ToString(az.ProvisioningState)
+	if az.Subnets != nil {
+		for _, s := range *az.Subnets {
+			o.SubnetIDs = append(o.SubnetIDs, azure.ToString(s.ID))
+		}
+	}
+	return o
+}
+
+// LateInitializeRouteTable late-initializes a RouteTable resource, except for
+// the supplied default tags.
+func LateInitializeRouteTable(p *v1alpha3.RouteTableParameters, az networkmgmt.RouteTable, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+	if az.RouteTablePropertiesFormat != nil {
+		p.DisableBGPRoutePropagation = azure.LateInitializeBoolPtrFromPtr(p.DisableBGPRoutePropagation, az.DisableBgpRoutePropagation)
+	}
+}
+
+// IsRouteTableUpToDate returns true if the supplied Azure route table is up to
+// date with the supplied parameters, merged with the supplied defaults.
+func IsRouteTableUpToDate(p v1alpha3.RouteTableParameters, az networkmgmt.RouteTable, d azure.ResourceDefaults) bool {
+	if az.RouteTablePropertiesFormat == nil {
+		return false
+	}
+	if p.DisableBGPRoutePropagation != nil && *p.DisableBGPRoutePropagation != azure.ToBool(az.DisableBgpRoutePropagation) {
+		return false
+	}
+	return d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags)
+}
+
+// NewRouteParameters returns an Azure Route object from a route spec.
+func NewRouteParameters(p v1alpha3.RouteParameters) networkmgmt.Route {
+	return networkmgmt.Route{
+		RoutePropertiesFormat: &networkmgmt.RoutePropertiesFormat{
+			AddressPrefix:    azure.ToStringPtr(p.AddressPrefix),
+			NextHopType:      networkmgmt.RouteNextHopType(p.NextHopType),
+			NextHopIPAddress: p.NextHopIPAddress,
+		},
+	}
+}
+
+// GenerateRouteObservation returns the observed state of the supplied Azure
+// route.
+func GenerateRouteObservation(az networkmgmt.Route) v1alpha3.RouteObservation {
+	o := v1alpha3.RouteObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.RoutePropertiesFormat != nil {
+		o.State = azure.ToString(az.ProvisioningState)
+	}
+	return o
+}
+
+// IsRouteUpToDate returns true if the supplied Azure route is up to date with
+// the supplied parameters.
+func IsRouteUpToDate(p v1alpha3.RouteParameters, az networkmgmt.Route) bool {
+	f := az.RoutePropertiesFormat
+	if f == nil {
+		return false
+	}
+	switch {
+	case !strings.EqualFold(p.AddressPrefix, azure.ToString(f.AddressPrefix)):
+		return false
+	case !strings.EqualFold(p.NextHopType, string(f.NextHopType)):
+		return false
+	case azure.ToString(p.NextHopIPAddress) != azure.ToString(f.NextHopIPAddress):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestNewRouteTableUpdate(t *testing.T) {
+	routes := &[]networkmgmt.Route{{ID: azure.ToStringPtr("/route-id")}}
+
+	cases := map[string]struct {
+		p    v1alpha3.RouteTableParameters
+		az   networkmgmt.RouteTable
+		d    azure.ResourceDefaults
+		want networkmgmt.RouteTable
+	}{
+		"RoutesAndExternalTagsRetained": {
+			p: v1alpha3.RouteTableParameters{
+				DisableBGPRoutePropagation: azure.ToBoolPtr(true),
+				Tags:                       map[string]string{"env": "prod"},
+			},
+			az: networkmgmt.RouteTable{
+				Location: azure.ToStringPtr(location),
+				Tags:     map[string]*string{"env": azure.ToStringPtr("test"), "owner": azure.ToStringPtr("network-team")},
+				RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+					Routes:  routes,
+					Subnets: &[]networkmgmt.Subnet{{ID: azure.ToStringPtr("/subnet-id")}},
+				},
+			},
+			d: azure.ResourceDefaults{Tags: map[string]string{"managed-by": "crossplane"}},
+			want: networkmgmt.RouteTable{
+				Location: azure.ToStringPtr(location),
+				Tags: map[string]*string{
+					"env":        azure.ToStringPtr("prod"),
+					"owner":      azure.ToStringPtr("network-team"),
+					"managed-by": azure.ToStringPtr("crossplane"),
+				},
+				RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+					DisableBgpRoutePropagation: azure.ToBoolPtr(true),
+					Routes:                     routes,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewRouteTableUpdate(tc.p, tc.az, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewRouteTableUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRouteTableUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.RouteTableParameters
+		az   networkmgmt.RouteTable
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.RouteTableParameters{
+				DisableBGPRoutePropagation: azure.ToBoolPtr(true),
+				Tags:                       map[string]string{"env": "prod"},
+			},
+			az: networkmgmt.RouteTable{
+				Tags: map[string]*string{"env": azure.ToStringPtr("prod")},
+				RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+					DisableBgpRoutePropagation: azure.ToBoolPtr(true),
+				},
+			},
+			want: true,
+		},
+		"BGPRoutePropagationChanged": {
+			p: v1alpha3.RouteTableParameters{
+				DisableBGPRoutePropagation: azure.ToBoolPtr(false, azure.FieldRequired),
+			},
+			az: networkmgmt.RouteTable{
+				RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{
+					DisableBgpRoutePropagation: azure.ToBoolPtr(true),
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: v1alpha3.RouteTableParameters{
+				Tags: map[string]string{"env": "prod"},
+			},
+			az: networkmgmt.RouteTable{
+				RouteTablePropertiesFormat: &networkmgmt.RouteTablePropertiesFormat{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRouteTableUpToDate(tc.p, tc.az, azure.ResourceDefaults{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsRouteTableUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRouteUpToDate(t *testing.T) {
+	p := v1alpha3.RouteParameters{
+		AddressPrefix:    "0.0.0.0/0",
+		NextHopType:      string(networkmgmt.RouteNextHopTypeVirtualAppliance),
+		NextHopIPAddress: azure.ToStringPtr("10.0.0.4"),
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.RouteParameters
+		az   networkmgmt.Route
+		want bool
+	}{
+		"UpToDate": {
+			p: p,
+			az: networkmgmt.Route{RoutePropertiesFormat: &networkmgmt.RoutePropertiesFormat{
+				AddressPrefix:    azure.ToStringPtr("0.0.0.0/0"),
+				NextHopType:      networkmgmt.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: azure.ToStringPtr("10.0.0.4"),
+			}},
+			want: true,
+		},
+		"NextHopTypeChanged": {
+			p: p,
+			az: networkmgmt.Route{RoutePropertiesFormat: &networkmgmt.RoutePropertiesFormat{
+				AddressPrefix: azure.ToStringPtr("0.0.0.0/0"),
+				NextHopType:   networkmgmt.RouteNextHopTypeInternet,
+			}},
+			want: false,
+		},
+		"NextHopIPAddressChanged": {
+			p: p,
+			az: networkmgmt.Route{RoutePropertiesFormat: &networkmgmt.RoutePropertiesFormat{
+				AddressPrefix:    azure.ToStringPtr("0.0.0.0/0"),
+				NextHopType:      networkmgmt.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: azure.ToStringPtr("10.0.0.5"),
+			}},
+			want: false,
+		},
+		"NoProperties": {
+			p:    p,
+			az:   networkmgmt.Route{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRouteUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsRouteUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"sort"
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewSecurityGroupParameters returns an Azure SecurityGroup object from a
+// security group spec and the supplied defaults. Rules are managed by
+// SecurityRules, so none are included.
+func NewSecurityGroupParameters(p v1alpha3.SecurityGroupParameters, d azure.ResourceDefaults) networkmgmt.SecurityGroup {
+	return networkmgmt.SecurityGroup{
+		Location: azure.ToStringPtr(d.LocationOr(p.Location), azure.FieldRequired),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(p.Tags)),
+	}
+}
+
+// NewSecurityGroupTags returns the tags of the supplied security group updated
+// with the desired tags.
+func NewSecurityGroupTags(p v1alpha3.SecurityGroupParameters, az networkmgmt.SecurityGroup, d azure.ResourceDefaults) networkmgmt.TagsObject {
+	return networkmgmt.TagsObject{Tags: d.UpdateTags(d.MergeTags(p.Tags), az.Tags)}
+}
+
+// GenerateSecurityGroupObservation returns the observed state of the supplied
+// Azure security group.
+func GenerateSecurityGroupObservation(az networkmgmt.SecurityGroup) v1alpha3.SecurityGroupObservation {
+	o := v1alpha3.SecurityGroupObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.SecurityGroupPropertiesFormat != nil {
+		o.State = azure.ToString(az.ProvisioningState)
+		o.ResourceGUID = azure.ToString(az.ResourceGUID)
+	}
+	return o
+}
+
+// LateInitializeSecurityGroup late-initializes a SecurityGroup resource,
+// except for the supplied default tags.
+func LateInitializeSecurityGroup(p *v1alpha3.SecurityGroupParameters, az networkmgmt.SecurityGroup, d azure.ResourceDefaults) {
+	p.Tags = d.LateInitializeTags(p.Tags, az.Tags)
+}
+
+// IsSecurityGroupUpToDate returns true if the supplied Azure security group is
+// up to date with the supplied parameters, merged with the supplied defaults.
+func IsSecurityGroupUpToDate(p v1alpha3.SecurityGroupParameters, az networkmgmt.SecurityGroup, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(d.MergeTags(p.Tags), az.Tags)
+}
+
+// NewSecurityRuleParameters returns an Azure SecurityRule object from a
+// security rule spec. Azure requires lists with a single element, e.g. a
+// service tag, to be supplied through the singular form of a property.
+func NewSecurityRuleParameters(p v1alpha3.SecurityRuleParameters) networkmgmt.SecurityRule {
+	f := &networkmgmt.SecurityRulePropertiesFormat{
+		Description:                          p.Description,
+		Priority:                             &p.Priority,
+		Direction:                            networkmgmt.SecurityRuleDirection(p.Direction),
+		Access:                               networkmgmt.SecurityRuleAccess(p.Access),
+		Protocol:                             networkmgmt.SecurityRuleProtocol(p.Protocol),
+		SourceApplicationSecurityGroups:      newApplicationSecurityGroups(p.SourceApplicationSecurityGroupIDs),
+		DestinationApplicationSecurityGroups: newApplicationSecurityGroups(p.DestinationApplicationSecurityGroupIDs),
+	}
+	f.SourcePortRange, f.SourcePortRanges = singleOrMany(p.SourcePortRanges)
+	f.DestinationPortRange, f.DestinationPortRanges = singleOrMany(p.DestinationPortRanges)
+	f.SourceAddressPrefix, f.SourceAddressPrefixes = singleOrMany(p.SourceAddressPrefixes)
+	f.DestinationAddressPrefix, f.DestinationAddressPrefixes = singleOrMany(p.DestinationAddressPrefixes)
+	return networkmgmt.SecurityRule{SecurityRulePropertiesFormat: f}
+}
+
+// GenerateSecurityRuleObservation returns the observed state of the supplied
+// Azure security rule.
+func GenerateSecurityRuleObservation(az networkmgmt.SecurityRule) v1alpha3.SecurityRuleObservation {
+	o := v1alpha3.SecurityRuleObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.SecurityRulePropertiesFormat != nil {
+		o.State = azure.ToString(az.ProvisioningState)
+	}
+	return o
+}
+
+// LateInitializeSecurityRule late-initializes a SecurityRule resource.
+func LateInitializeSecurityRule(p *v1alpha3.SecurityRuleParameters, az networkmgmt.SecurityRule) {
+	if az.SecurityRulePropertiesFormat == nil {
+		return
+	}
+	p.Description = azure.LateInitializeStringPtrFromPtr(p.Description, az.Description)
+}
+
+// IsSecurityRuleUpToDate returns true if the supplied Azure security rule is
+// up to date with the supplied parameters. Port ranges, address prefixes and
+// application security groups are compared regardless of their order.
+func IsSecurityRuleUpToDate(p v1alpha3.SecurityRuleParameters, az networkmgmt.SecurityRule) bool {
+	f := az.SecurityRulePropertiesFormat
+	if f == nil {
+		return false
+	}
+	switch {
+	case azure.ToString(p.Description) != azure.ToString(f.Description):
+		return false
+	case int(p.Priority) != azure.ToInt(f.Priority):
+		return false
+	case !strings.EqualFold(p.Direction, string(f.Direction)):
+		return false
+	case !strings.EqualFold(p.Access, string(f.Access)):
+		return false
+	case !strings.EqualFold(p.Protocol, string(f.Protocol)):
+		return false
+	}
+	return equalSets(p.SourcePortRanges, singleAndMany(f.SourcePortRange, f.SourcePortRanges)) &&
+		equalSets(p.DestinationPortRanges, singleAndMany(f.DestinationPortRange, f.DestinationPortRanges)) &&
+		equalSets(p.SourceAddressPrefixes, singleAndMany(f.SourceAddressPrefix, f.SourceAddressPrefixes)) &&
+		equalSets(p.DestinationAddressPrefixes, singleAndMany(f.DestinationAddressPrefix, f.DestinationAddressPrefixes)) &&
+		equalSets(p.SourceApplicationSecurityGroupIDs, applicationSecurityGroupIDs(f.SourceApplicationSecurityGroups)) &&
+		equalSets(p.DestinationApplicationSecurityGroupIDs, applicationSecurityGroupIDs(f.DestinationApplicationSecurityGroups))
+}
+
+func newApplicationSecurityGroups(ids []string) *[]networkmgmt.ApplicationSecurityGroup {
+	if len(ids) == 0 {
+		return nil
+	}
+	asgs := make([]networkmgmt.ApplicationSecurityGroup, len(ids))
+	for i := range ids {
+		asgs[i] = networkmgmt.ApplicationSecurityGroup{ID: azure.ToStringPtr(ids[i])}
+	}
+	return &asgs
+}
+
+func applicationSecurityGroupIDs(asgs *[]networkmgmt.ApplicationSecurityGroup) []string {
+	if asgs == nil {
+		return nil
+	}
+	ids := make([]string, len(*asgs))
+	for i, asg := range *asgs {
+		ids[i] = azure.ToString(asg.ID)
+	}
+	return ids
+}
+
+// singleOrMany returns the only element of the supplied values, or all of
+// them if there are several.
+func singleOrMany(v []string) (*string, *[]string) {
+	switch len(v) {
+	case 0:
+		return nil, nil
+	case 1:
+		return azure.ToStringPtr(v[0]), nil
+	default:
+		return nil, &v
+	}
+}
+
+// singleAndMany returns the values of the singular and plural forms of a
+// property.
+func singleAndMany(single *string, many *[]string) []string {
+	var v []string
+	if single != nil && *single != "" {
+		v = append(v, *single)
+	}
+	if many != nil {
+		v = append(v, *many...)
+	}
+	return v
+}
+
+// equalSets returns true if the supplied slices contain the same values in any
+// order. Values are compared case-insensitively because Azure may change the
+// case of service tags and resource IDs.
+func equalSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ca, cb := make([]string, len(a)), make([]string, len(b))
+	for i := range a {
+		ca[i], cb[i] = strings.ToLower(a[i]), strings.ToLower(b[i])
+	}
+	sort.Strings(ca)
+	sort.Strings(cb)
+	for i := range ca {
+		if ca[i] != cb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var (
+	asgID1 = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/applicationSecurityGroups/web"
+	asgID2 = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/applicationSecurityGroups/db"
+)
+
+type securityRuleParametersModifier func(*v1alpha3.SecurityRuleParameters)
+
+func securityRuleParameters(m ...securityRuleParametersModifier) v1alpha3.SecurityRuleParameters {
+	p := v1alpha3.SecurityRuleParameters{
+		Priority:                               100,
+		Direction:                              string(networkmgmt.SecurityRuleDirectionInbound),
+		Access:                                 string(networkmgmt.SecurityRuleAccessAllow),
+		Protocol:                               string(networkmgmt.SecurityRuleProtocolTCP),
+		SourcePortRanges:                       []string{"*"},
+		DestinationPortRanges:                  []string{"80", "443"},
+		SourceAddressPrefixes:                  []string{"10.0.0.0/24", "10.0.1.0/24"},
+		DestinationApplicationSecurityGroupIDs: []string{asgID1, asgID2},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func securityRuleProperties() *networkmgmt.SecurityRulePropertiesFormat {
+	return &networkmgmt.SecurityRulePropertiesFormat{
+		Priority:              azure.ToInt32Ptr(100),
+		Direction:             networkmgmt.SecurityRuleDirectionInbound,
+		Access:                networkmgmt.SecurityRuleAccessAllow,
+		Protocol:              networkmgmt.SecurityRuleProtocolTCP,
+		SourcePortRange:       azure.ToStringPtr("*"),
+		DestinationPortRanges: &[]string{"80", "443"},
+		SourceAddressPrefixes: &[]string{"10.0.0.0/24", "10.0.1.0/24"},
+		DestinationApplicationSecurityGroups: &[]networkmgmt.ApplicationSecurityGroup{
+			{ID: azure.ToStringPtr(asgID1)},
+			{ID: azure.ToStringPtr(asgID2)},
+		},
+	}
+}
+
+func TestNewSecurityRuleParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.SecurityRuleParameters
+		want networkmgmt.SecurityRule
+	}{
+		"SingleAndManyValues": {
+			p:    securityRuleParameters(),
+			want: networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+		},
+		"ServiceTag": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.SourceAddressPrefixes = []string{"Internet"}
+				p.DestinationApplicationSecurityGroupIDs = nil
+				p.Description = azure.ToStringPtr("allow web")
+			}),
+			want: networkmgmt.SecurityRule{SecurityRulePropertiesFormat: func() *networkmgmt.SecurityRulePropertiesFormat {
+				f := securityRuleProperties()
+				f.SourceAddressPrefix = azure.ToStringPtr("Internet")
+				f.SourceAddressPrefixes = nil
+				f.DestinationApplicationSecurityGroups = nil
+				f.Description = azure.ToStringPtr("allow web")
+				return f
+			}()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewSecurityRuleParameters(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewSecurityRuleParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSecurityRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.SecurityRuleParameters
+		az   networkmgmt.SecurityRule
+		want bool
+	}{
+		"UpToDate": {
+			p:    securityRuleParameters(),
+			az:   networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+			want: true,
+		},
+		"UpToDateInDifferentOrder": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.DestinationPortRanges = []string{"443", "80"}
+				p.SourceAddressPrefixes = []string{"10.0.1.0/24", "10.0.0.0/24"}
+				p.DestinationApplicationSecurityGroupIDs = []string{asgID2, asgID1}
+			}),
+			az:   networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+			want: true,
+		},
+		"UpToDateWithDifferentCase": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.SourceAddressPrefixes = []string{"Internet"}
+			}),
+			az: networkmgmt.SecurityRule{SecurityRulePropertiesFormat: func() *networkmgmt.SecurityRulePropertiesFormat {
+				f := securityRuleProperties()
+				f.SourceAddressPrefix = azure.ToStringPtr("internet")
+				f.SourceAddressPrefixes = &[]string{}
+				return f
+			}()},
+			want: true,
+		},
+		"PrefixRemoved": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.SourceAddressPrefixes = []string{"10.0.0.0/24"}
+			}),
+			az:   networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+			want: false,
+		},
+		"PriorityChanged": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.Priority = 200
+			}),
+			az:   networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+			want: false,
+		},
+		"AccessChanged": {
+			p: securityRuleParameters(func(p *v1alpha3.SecurityRuleParameters) {
+				p.Access = string(networkmgmt.SecurityRuleAccessDeny)
+			}),
+			az:   networkmgmt.SecurityRule{SecurityRulePropertiesFormat: securityRuleProperties()},
+			want: false,
+		},
+		"NoProperties": {
+			p:    securityRuleParameters(),
+			az:   networkmgmt.SecurityRule{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSecurityRuleUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSecurityRuleUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
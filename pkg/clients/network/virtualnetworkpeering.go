@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewVirtualNetworkPeeringParameters returns an Azure VirtualNetworkPeering
+// object from a virtual network peering spec.
+func NewVirtualNetworkPeeringParameters(p *v1alpha3.VirtualNetworkPeering) networkmgmt.VirtualNetworkPeering {
+	fp := p.Spec.ForProvider
+	return networkmgmt.VirtualNetworkPeering{
+		VirtualNetworkPeeringPropertiesFormat: &networkmgmt.VirtualNetworkPeeringPropertiesFormat{
+			RemoteVirtualNetwork:      &networkmgmt.SubResource{ID: azure.ToStringPtr(fp.RemoteVirtualNetworkID)},
+			AllowVirtualNetworkAccess: fp.AllowVirtualNetworkAccess,
+			AllowForwardedTraffic:     fp.AllowForwardedTraffic,
+			AllowGatewayTransit:       fp.AllowGatewayTransit,
+			UseRemoteGateways:         fp.UseRemoteGateways,
+		},
+	}
+}
+
+// VirtualNetworkPeeringNeedsUpdate determines if a virtual network peering
+// needs to be updated.
+func VirtualNetworkPeeringNeedsUpdate(kube *v1alpha3.VirtualNetworkPeering, az networkmgmt.VirtualNetworkPeering) bool {
+	f := az.VirtualNetworkPeeringPropertiesFormat
+	if f == nil {
+		return true
+	}
+	fp := kube.Spec.ForProvider
+
+	switch {
+	case boolNeedsUpdate(fp.AllowVirtualNetworkAccess, f.AllowVirtualNetworkAccess):
+		return true
+	case boolNeedsUpdate(fp.AllowForwardedTraffic, f.AllowForwardedTraffic):
+		return true
+	case boolNeedsUpdate(fp.AllowGatewayTransit, f.AllowGatewayTransit):
+		return true
+	case boolNeedsUpdate(fp.UseRemoteGateways, f.UseRemoteGateways):
+		return true
+	}
+
+	return false
+}
+
+// LateInitializeVirtualNetworkPeering late-initializes the flags of a
+// VirtualNetworkPeering from the supplied Azure virtual network peering.
+func LateInitializeVirtualNetworkPeering(p *v1alpha3.VirtualNetworkPeering, az networkmgmt.VirtualNetworkPeering) {
+	f := az.VirtualNetworkPeeringPropertiesFormat
+	if f == nil {
+		return
+	}
+	fp := &p.Spec.ForProvider
+	fp.AllowVirtualNetworkAccess = azure.LateInitializeBoolPtrFromPtr(fp.AllowVirtualNetworkAccess, f.AllowVirtualNetworkAccess)
+	fp.AllowForwardedTraffic = azure.LateInitializeBoolPtrFromPtr(fp.AllowForwardedTraffic, f.AllowForwardedTraffic)
+	fp.AllowGatewayTransit = azure.LateInitializeBoolPtrFromPtr(fp.AllowGatewayTransit, f.AllowGatewayTransit)
+	fp.UseRemoteGateways = azure.LateInitializeBoolPtrFromPtr(fp.UseRemoteGateways, f.UseRemoteGateways)
+}
+
+// UpdateVirtualNetworkPeeringStatusFromAzure updates the status related to the
+// external Azure virtual network peering in the VirtualNetworkPeeringStatus.
+func UpdateVirtualNetworkPeeringStatusFromAzure(p *v1alpha3.VirtualNetworkPeering, az networkmgmt.VirtualNetworkPeering) {
+	o := v1alpha3.VirtualNetworkPeeringObservation{
+		ID:   azure.ToString(az.ID),
+		Etag: azure.ToString(az.Etag),
+	}
+	if f := az.VirtualNetworkPeeringPropertiesFormat; f != nil {
+		o.State = string(f.ProvisioningState)
+		o.PeeringState = string(f.PeeringState)
+		o.PeeringSyncLevel = string(f.PeeringSyncLevel)
+		if f.RemoteAddressSpace != nil && f.RemoteAddressSpace.AddressPrefixes != nil {
+			o.RemoteAddressPrefixes = *f.RemoteAddressSpace.AddressPrefixes
+		}
+	}
+	p.Status.AtProvider = o
+}
+
+// boolNeedsUpdate returns true if the desired value of a flag is set and
+// differs from the observed one.
+func boolNeedsUpdate(desired, observed *bool) bool {
+	return desired != nil && *desired != azure.ToBool(observed)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+var remoteVirtualNetworkID = "/subscriptions/hub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub"
+
+func virtualNetworkPeering(p v1alpha3.VirtualNetworkPeeringParameters) *v1alpha3.VirtualNetworkPeering {
+	return &v1alpha3.VirtualNetworkPeering{Spec: v1alpha3.VirtualNetworkPeeringSpec{ForProvider: p}}
+}
+
+func TestNewVirtualNetworkPeeringParameters(t *testing.T) {
+	cases := map[string]struct {
+		r    *v1alpha3.VirtualNetworkPeering
+		want networkmgmt.VirtualNetworkPeering
+	}{
+		"Successful": {
+			r: virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{
+				RemoteVirtualNetworkID: remoteVirtualNetworkID,
+				AllowForwardedTraffic:  azure.ToBoolPtr(true),
+				UseRemoteGateways:      azure.ToBoolPtr(true),
+			}),
+			want: networkmgmt.VirtualNetworkPeering{
+				VirtualNetworkPeeringPropertiesFormat: &networkmgmt.VirtualNetworkPeeringPropertiesFormat{
+					RemoteVirtualNetwork:  &networkmgmt.SubResource{ID: azure.ToStringPtr(remoteVirtualNetworkID)},
+					AllowForwardedTraffic: azure.ToBoolPtr(true),
+					UseRemoteGateways:     azure.ToBoolPtr(true),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewVirtualNetworkPeeringParameters(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewVirtualNetworkPeeringParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVirtualNetworkPeeringNeedsUpdate(t *testing.T) {
+	az := networkmgmt.VirtualNetworkPeering{
+		VirtualNetworkPeeringPropertiesFormat: &networkmgmt.VirtualNetworkPeeringPropertiesFormat{
+			AllowVirtualNetworkAccess: azure.ToBoolPtr(true),
+			AllowForwardedTraffic:     azure.ToBoolPtr(false, azure.FieldRequired),
+			AllowGatewayTransit:       azure.ToBoolPtr(false, azure.FieldRequired),
+			UseRemoteGateways:         azure.ToBoolPtr(true),
+		},
+	}
+
+	cases := map[string]struct {
+		kube *v1alpha3.VirtualNetworkPeering
+		az   networkmgmt.VirtualNetworkPeering
+		want bool
+	}{
+		"UpToDate": {
+			kube: virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{
+				AllowVirtualNetworkAccess: azure.ToBoolPtr(true),
+				UseRemoteGateways:         azure.ToBoolPtr(true),
+			}),
+			az:   az,
+			want: false,
+		},
+		"AllowForwardedTraffic": {
+			kube: virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{
+				AllowForwardedTraffic: azure.ToBoolPtr(true),
+			}),
+			az:   az,
+			want: true,
+		},
+		"StopUsingRemoteGateways": {
+			kube: virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{
+				UseRemoteGateways: azure.ToBoolPtr(false, azure.FieldRequired),
+			}),
+			az:   az,
+			want: true,
+		},
+		"NoProperties": {
+			kube: virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{}),
+			az:   networkmgmt.VirtualNetworkPeering{},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VirtualNetworkPeeringNeedsUpdate(tc.kube, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VirtualNetworkPeeringNeedsUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateVirtualNetworkPeeringStatusFromAzure(t *testing.T) {
+	r := virtualNetworkPeering(v1alpha3.VirtualNetworkPeeringParameters{})
+	UpdateVirtualNetworkPeeringStatusFromAzure(r, networkmgmt.VirtualNetworkPeering{
+		ID:   azure.ToStringPtr(id),
+		Etag: azure.ToStringPtr(etag),
+		VirtualNetworkPeeringPropertiesFormat: &networkmgmt.VirtualNetworkPeeringPropertiesFormat{
+			ProvisioningState:  networkmgmt.ProvisioningStateSucceeded,
+			PeeringState:       networkmgmt.VirtualNetworkPeeringStateConnected,
+			PeeringSyncLevel:   networkmgmt.VirtualNetworkPeeringLevelLocalNotInSync,
+			RemoteAddressSpace: &networkmgmt.AddressSpace{AddressPrefixes: &addressPrefixes},
+		},
+	})
+
+	want := v1alpha3.VirtualNetworkPeeringObservation{
+		State:                 string(networkmgmt.ProvisioningStateSucceeded),
+		PeeringState:          string(networkmgmt.VirtualNetworkPeeringStateConnected),
+		PeeringSyncLevel:      string(networkmgmt.VirtualNetworkPeeringLevelLocalNotInSync),
+		RemoteAddressPrefixes: addressPrefixes,
+		Etag:                  etag,
+		ID:                    id,
+	}
+	if diff := cmp.Diff(want, r.Status.AtProvider); diff != "" {
+		t.Errorf("UpdateVirtualNetworkPeeringStatusFromAzure(...): -want, +got\n%s", diff)
+	}
+}
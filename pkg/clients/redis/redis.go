@@ -34,12 +34,12 @@ const (
 )
 
 // NewCreateParameters returns Redis resource creation parameters suitable for
-// use with the Azure API.
-func NewCreateParameters(cr *v1beta1.Redis) redis.CreateParameters {
+// use with the Azure API. The supplied defaults are applied.
+func NewCreateParameters(cr *v1beta1.Redis, d azure.ResourceDefaults) redis.CreateParameters {
 	return redis.CreateParameters{
-		Location: azure.ToStringPtr(cr.Spec.ForProvider.Location),
+		Location: azure.ToStringPtr(d.LocationOr(cr.Spec.ForProvider.Location)),
 		Zones:    azure.ToStringArrayPtr(cr.Spec.ForProvider.Zones),
-		Tags:     azure.ToStringPtrMap(cr.Spec.ForProvider.Tags),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(cr.Spec.ForProvider.Tags)),
 		CreateProperties: &redis.CreateProperties{
 			Sku:                NewSKU(cr.Spec.ForProvider.SKU),
 			SubnetID:           cr.Spec.ForProvider.SubnetID,
@@ -54,7 +54,8 @@ func NewCreateParameters(cr *v1beta1.Redis) redis.CreateParameters {
 }
 
 // NewUpdateParameters returns a redis.UpdateParameters object only with changed
-// fields.
+// fields. Tags are only included if the supplied defaults merged with the tags
+// of the spec are not all present in the supplied state.
 // TODO(muvaf): Removal of an entry from the maps such as RedisConfiguration and
 // TenantSettings is not properly supported. The user has to give empty string
 // for deletion instead of just deleting the whole entry.
@@ -62,9 +63,8 @@ func NewCreateParameters(cr *v1beta1.Redis) redis.CreateParameters {
 // statements which increase the cyclomatic complexity even though it's actually
 // easier to maintain all this in one function.
 // nolint:gocyclo
-func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType) redis.UpdateParameters {
+func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType, d azure.ResourceDefaults) redis.UpdateParameters {
 	patch := redis.UpdateParameters{
-		Tags: azure.ToStringPtrMap(spec.Tags),
 		UpdateProperties: &redis.UpdateProperties{
 			Sku:                NewSKU(spec.SKU),
 			RedisConfiguration: azure.ToStringPtrMap(spec.RedisConfiguration),
@@ -78,13 +78,8 @@ func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType)
 	// ResourceType and extract a JSON patch. But since the number of fields
 	// are not that many, I wanted to go with if statements. Hopefully, we'll
 	// generate this code in the future.
-	for k, v := range state.Tags {
-		if patch.Tags[k] == v {
-			delete(patch.Tags, k)
-		}
-	}
-	if len(patch.Tags) == 0 {
-		patch.Tags = nil
+	if tags := d.MergeTags(spec.Tags); !d.TagsUpToDate(tags, state.Tags) {
+		patch.Tags = d.UpdateTags(tags, state.Tags)
 	}
 	if state.Properties == nil {
 		return patch
@@ -132,11 +127,11 @@ func NewSKU(s v1beta1.SKU) *redis.Sku {
 // NeedsUpdate returns true if the supplied spec object differs from the
 // supplied Azure resource. It considers only fields that can be modified in
 // place without deleting and recreating the instance.
-func NeedsUpdate(spec v1beta1.RedisParameters, az redis.ResourceType) bool {
+func NeedsUpdate(spec v1beta1.RedisParameters, az redis.ResourceType, d azure.ResourceDefaults) bool {
 	if az.Properties == nil {
 		return true
 	}
-	patch := NewUpdateParameters(spec, az)
+	patch := NewUpdateParameters(spec, az, d)
 	empty := redis.UpdateParameters{UpdateProperties: &redis.UpdateProperties{}}
 	return !reflect.DeepEqual(empty, patch)
 }
@@ -166,10 +161,11 @@ func GenerateObservation(az redis.ResourceType) v1beta1.RedisObservation {
 }
 
 // LateInitialize fills the spec values that user did not fill with their
-// corresponding value in the Azure, if there is any.
-func LateInitialize(spec *v1beta1.RedisParameters, az redis.ResourceType) {
+// corresponding value in the Azure, if there is any. The supplied default tags
+// are not late-initialized.
+func LateInitialize(spec *v1beta1.RedisParameters, az redis.ResourceType, d azure.ResourceDefaults) {
 	spec.Zones = azure.LateInitializeStringValArrFromArrPtr(spec.Zones, az.Zones)
-	spec.Tags = azure.LateInitializeStringMap(spec.Tags, az.Tags)
+	spec.Tags = d.LateInitializeTags(spec.Tags, az.Tags)
 	if az.Properties == nil {
 		return
 	}
@@ -58,6 +58,7 @@ func TestNewCreateParameters(t *testing.T) {
 	cases := []struct {
 		name string
 		r    *v1beta1.Redis
+		d    azure.ResourceDefaults
 		want redismgmt.CreateParameters
 	}{
 		{
@@ -103,11 +104,41 @@ func TestNewCreateParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Defaults",
+			r: &v1beta1.Redis{
+				Spec: v1beta1.RedisSpec{
+					ForProvider: v1beta1.RedisParameters{
+						Tags: tags,
+						SKU: v1beta1.SKU{
+							Name:     skuName,
+							Family:   skuFamily,
+							Capacity: skuCapacity,
+						},
+					},
+				},
+			},
+			d: azure.ResourceDefaults{
+				Tags:     map[string]string{"key1": "default", "key2": "val2"},
+				Location: location,
+			},
+			want: redismgmt.CreateParameters{
+				Location: azure.ToStringPtr(location),
+				Tags:     azure.ToStringPtrMap(tags2),
+				CreateProperties: &redismgmt.CreateProperties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewCreateParameters(tc.r)
+			got := NewCreateParameters(tc.r, tc.d)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewCreateParameters(...): -want, +got\n%s", diff)
 			}
@@ -123,6 +154,7 @@ func TestNewUpdateParameters(t *testing.T) {
 		name    string
 		spec    v1beta1.RedisParameters
 		current redismgmt.ResourceType
+		d       azure.ResourceDefaults
 		want    redismgmt.UpdateParameters
 	}{
 		{
@@ -224,11 +256,29 @@ func TestNewUpdateParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "PatchDefaultTags",
+			spec: v1beta1.RedisParameters{
+				Tags: tags,
+			},
+			current: redismgmt.ResourceType{
+				Tags: map[string]*string{"key1": azure.ToStringPtr("val1"), "policy": azure.ToStringPtr("set")},
+			},
+			d: azure.ResourceDefaults{Tags: map[string]string{"key2": "val2"}},
+			want: redismgmt.UpdateParameters{
+				UpdateProperties: &redismgmt.UpdateProperties{Sku: &redismgmt.Sku{Capacity: azure.ToInt32Ptr(0, azure.FieldRequired)}},
+				Tags: map[string]*string{
+					"key1":   azure.ToStringPtr("val1"),
+					"key2":   azure.ToStringPtr("val2"),
+					"policy": azure.ToStringPtr("set"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewUpdateParameters(tc.spec, tc.current)
+			got := NewUpdateParameters(tc.spec, tc.current, tc.d)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewUpdateParameters(...): -want, +got\n%s", diff)
 			}
@@ -315,11 +365,33 @@ func TestNeedsUpdate(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "UnmanagedTags",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     skuName,
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+				Tags: tags,
+			},
+			az: redismgmt.ResourceType{
+				Tags: azure.ToStringPtrMap(tags2),
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NeedsUpdate(tc.spec, tc.az)
+			got := NeedsUpdate(tc.spec, tc.az, azure.ResourceDefaults{})
 			if got != tc.want {
 				t.Errorf("NeedsUpdate(...): want %t, got %t", tc.want, got)
 			}
@@ -442,7 +514,7 @@ func TestLateInitialize(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			LateInitialize(tc.args.spec, tc.args.az)
+			LateInitialize(tc.args.spec, tc.args.az, azure.ResourceDefaults{})
 			if diff := cmp.Diff(tc.want.spec, tc.args.spec); diff != "" {
 				t.Errorf("LateInitialize(...): -want, +got\n%s", diff)
 			}
@@ -19,6 +19,8 @@ package fake
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks/locksapi"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources/resourcesapi"
 	"github.com/Azure/go-autorest/autorest"
@@ -34,6 +36,7 @@ type MockClient struct {
 	MockCheckExistence func(ctx context.Context, resourceGroupName string) (result autorest.Response, err error)
 	MockDelete         func(ctx context.Context, resourceGroupName string) (result resources.GroupsDeleteFuture, err error)
 	MockGet            func(ctx context.Context, resourceGroupName string) (result resources.Group, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, parameters resources.GroupPatchable) (result resources.Group, err error)
 }
 
 // CreateOrUpdate calls the underlying MockCreateOrUpdate method.
@@ -55,3 +58,38 @@ func (m *MockClient) Delete(ctx context.Context, resourceGroupName string) (resu
 func (m *MockClient) Get(ctx context.Context, resourceGroupName string) (result resources.Group, err error) {
 	return m.MockGet(ctx, resourceGroupName)
 }
+
+// Update calls the underlying MockUpdate method.
+func (m *MockClient) Update(ctx context.Context, resourceGroupName string, parameters resources.GroupPatchable) (result resources.Group, err error) {
+	return m.MockUpdate(ctx, resourceGroupName, parameters)
+}
+
+var _ locksapi.ManagementLocksClientAPI = &MockLocksClient{}
+
+// MockLocksClient is a fake implementation of the azure management locks
+// client.
+type MockLocksClient struct {
+	locksapi.ManagementLocksClientAPI
+
+	MockCreateOrUpdateAtResourceGroupLevel func(ctx context.Context, resourceGroupName string, lockName string, parameters locks.ManagementLockObject) (result locks.ManagementLockObject, err error)
+	MockDeleteAtResourceGroupLevel         func(ctx context.Context, resourceGroupName string, lockName string) (result autorest.Response, err error)
+	MockGetAtResourceGroupLevel            func(ctx context.Context, resourceGroupName string, lockName string) (result locks.ManagementLockObject, err error)
+}
+
+// CreateOrUpdateAtResourceGroupLevel calls the underlying
+// MockCreateOrUpdateAtResourceGroupLevel method.
+func (m *MockLocksClient) CreateOrUpdateAtResourceGroupLevel(ctx context.Context, resourceGroupName string, lockName string, parameters locks.ManagementLockObject) (result locks.ManagementLockObject, err error) {
+	return m.MockCreateOrUpdateAtResourceGroupLevel(ctx, resourceGroupName, lockName, parameters)
+}
+
+// DeleteAtResourceGroupLevel calls the underlying
+// MockDeleteAtResourceGroupLevel method.
+func (m *MockLocksClient) DeleteAtResourceGroupLevel(ctx context.Context, resourceGroupName string, lockName string) (result autorest.Response, err error) {
+	return m.MockDeleteAtResourceGroupLevel(ctx, resourceGroupName, lockName)
+}
+
+// GetAtResourceGroupLevel calls the underlying MockGetAtResourceGroupLevel
+// method.
+func (m *MockLocksClient) GetAtResourceGroupLevel(ctx context.Context, resourceGroupName string, lockName string) (result locks.ManagementLockObject, err error) {
+	return m.MockGetAtResourceGroupLevel(ctx, resourceGroupName, lockName)
+}
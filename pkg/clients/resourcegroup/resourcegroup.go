@@ -17,11 +17,10 @@ limitations under the License.
 package resourcegroup
 
 import (
-	"encoding/json"
-
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks/locksapi"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources/resourcesapi"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -30,30 +29,25 @@ import (
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
+// LockName is the name of the management lock Crossplane creates for a
+// resource group.
+const LockName = "crossplane"
+
 // A GroupsClient handles CRUD operations for Azure Resource Group resources.
 type GroupsClient resourcesapi.GroupsClientAPI
 
+// A LocksClient handles CRUD operations for Azure management locks.
+type LocksClient locksapi.ManagementLocksClientAPI
+
 // NewClient returns a new Azure Resource Groups client. Credentials must be
 // passed as JSON encoded data.
 func NewClient(credentials []byte) (GroupsClient, error) {
-	c := azure.Credentials{}
-	if err := json.Unmarshal(credentials, &c); err != nil {
-		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
-	}
-	client := resources.NewGroupsClient(c.SubscriptionID)
-
-	cfg := auth.ClientCredentialsConfig{
-		ClientID:     c.ClientID,
-		ClientSecret: c.ClientSecret,
-		TenantID:     c.TenantID,
-		AADEndpoint:  c.ActiveDirectoryEndpointURL,
-		Resource:     c.ResourceManagerEndpointURL,
-	}
-	a, err := cfg.Authorizer()
+	c, err := azure.NewClient(credentials)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot create Azure authorizer from credentials config")
 	}
-	client.Authorizer = a
+	client := resources.NewGroupsClientWithBaseURI(c.ResourceManagerEndpointURL, c.SubscriptionID)
+	client.Authorizer = c.Authorizer
 	if err := client.AddToUserAgent(azure.UserAgent); err != nil {
 		return nil, errors.Wrap(err, "cannot add to Azure client user agent")
 	}
@@ -62,10 +56,55 @@ func NewClient(credentials []byte) (GroupsClient, error) {
 }
 
 // NewParameters returns Resource Group resource creation parameters suitable for
-// use with the Azure API.
-func NewParameters(r *v1alpha3.ResourceGroup) resources.Group {
+// use with the Azure API. The supplied defaults are applied.
+func NewParameters(r *v1alpha3.ResourceGroup, d azure.ResourceDefaults) resources.Group {
 	return resources.Group{
 		Name:     azure.ToStringPtr(meta.GetExternalName(r)),
-		Location: azure.ToStringPtr(r.Spec.Location),
+		Location: azure.ToStringPtr(d.LocationOr(r.Spec.Location)),
+		Tags:     azure.ToStringPtrMap(d.MergeTags(r.Spec.Tags)),
+	}
+}
+
+// NewPatchParameters returns Resource Group resource update parameters suitable
+// for use with the Azure API.
+func NewPatchParameters(r *v1alpha3.ResourceGroup, g resources.Group, d azure.ResourceDefaults) resources.GroupPatchable {
+	return resources.GroupPatchable{
+		Tags: d.UpdateTags(azure.ToStringMap(NewParameters(r, d).Tags), g.Tags),
+	}
+}
+
+// IsUpToDate returns true if the supplied group is up to date with the supplied
+// ResourceGroup and defaults.
+func IsUpToDate(r *v1alpha3.ResourceGroup, g resources.Group, d azure.ResourceDefaults) bool {
+	return d.TagsUpToDate(azure.ToStringMap(NewParameters(r, d).Tags), g.Tags)
+}
+
+// LateInitialize fills the empty fields of the supplied ResourceGroup with the
+// values of the supplied group.
+func LateInitialize(r *v1alpha3.ResourceGroup, g resources.Group, d azure.ResourceDefaults) {
+	r.Spec.Tags = d.LateInitializeTags(r.Spec.Tags, g.Tags)
+}
+
+// NewLockParameters returns management lock creation parameters suitable for
+// use with the Azure API.
+func NewLockParameters(l *v1alpha3.ResourceGroupLock) locks.ManagementLockObject {
+	return locks.ManagementLockObject{
+		ManagementLockProperties: &locks.ManagementLockProperties{
+			Level: locks.LockLevel(l.Level),
+			Notes: l.Notes,
+		},
+	}
+}
+
+// IsLockUpToDate returns true if the supplied management lock is up to date
+// with the desired lock. A nil management lock means the resource group is not
+// locked.
+func IsLockUpToDate(l *v1alpha3.ResourceGroupLock, o *locks.ManagementLockObject) bool {
+	if l == nil || o == nil {
+		return l == nil && o == nil
+	}
+	if o.ManagementLockProperties == nil {
+		return false
 	}
+	return string(o.Level) == l.Level && azure.ToString(o.Notes) == azure.ToString(l.Notes)
 }
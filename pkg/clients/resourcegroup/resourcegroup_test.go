@@ -19,6 +19,7 @@ package resourcegroup
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/google/go-cmp/cmp"
 
@@ -37,6 +38,7 @@ func TestNewParameters(t *testing.T) {
 	cases := []struct {
 		name string
 		r    *v1alpha3.ResourceGroup
+		d    azure.ResourceDefaults
 		want resources.Group
 	}{
 		{
@@ -55,14 +57,196 @@ func TestNewParameters(t *testing.T) {
 				Location: azure.ToStringPtr(location),
 			},
 		},
+		{
+			name: "Defaults",
+			r: func() *v1alpha3.ResourceGroup {
+				r := &v1alpha3.ResourceGroup{}
+				meta.SetExternalName(r, name)
+				return r
+			}(),
+			d: azure.ResourceDefaults{
+				Tags:     map[string]string{"owner": "finance"},
+				Location: location,
+			},
+			want: resources.Group{
+				Name:     azure.ToStringPtr(name),
+				Location: azure.ToStringPtr(location),
+				Tags:     map[string]*string{"owner": azure.ToStringPtr("finance")},
+			},
+		},
+		{
+			name: "Tags",
+			r: func() *v1alpha3.ResourceGroup {
+				r := &v1alpha3.ResourceGroup{
+					Spec: v1alpha3.ResourceGroupSpec{
+						Location: location,
+						Tags:     map[string]string{"owner": "marketing"},
+					},
+				}
+				meta.SetExternalName(r, name)
+				return r
+			}(),
+			d: azure.ResourceDefaults{
+				Tags: map[string]string{"owner": "finance", "env": "prod"},
+			},
+			want: resources.Group{
+				Name:     azure.ToStringPtr(name),
+				Location: azure.ToStringPtr(location),
+				Tags: map[string]*string{
+					"owner": azure.ToStringPtr("marketing"),
+					"env":   azure.ToStringPtr("prod"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewParameters(tc.r)
+			got := NewParameters(tc.r, tc.d)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewParameters(...): -want, +got\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestIsUpToDate(t *testing.T) {
+	cases := []struct {
+		name string
+		g    resources.Group
+		d    azure.ResourceDefaults
+		want bool
+	}{
+		{
+			name: "NoTags",
+			want: true,
+		},
+		{
+			name: "MissingDefaultTag",
+			d:    azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
+			want: false,
+		},
+		{
+			name: "UnmanagedTag",
+			g: resources.Group{Tags: map[string]*string{
+				"owner":  azure.ToStringPtr("finance"),
+				"policy": azure.ToStringPtr("set"),
+			}},
+			d:    azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsUpToDate(&v1alpha3.ResourceGroup{}, tc.g, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := []struct {
+		name string
+		r    *v1alpha3.ResourceGroup
+		g    resources.Group
+		d    azure.ResourceDefaults
+		want *v1alpha3.ResourceGroup
+	}{
+		{
+			name: "Tags",
+			r:    &v1alpha3.ResourceGroup{},
+			g: resources.Group{Tags: map[string]*string{
+				"owner": azure.ToStringPtr("finance"),
+				"team":  azure.ToStringPtr("platform"),
+			}},
+			d: azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
+			want: &v1alpha3.ResourceGroup{Spec: v1alpha3.ResourceGroupSpec{
+				Tags: map[string]string{"team": "platform"},
+			}},
+		},
+		{
+			name: "AlreadySet",
+			r: &v1alpha3.ResourceGroup{Spec: v1alpha3.ResourceGroupSpec{
+				Tags: map[string]string{"team": "data"},
+			}},
+			g: resources.Group{Tags: map[string]*string{"team": azure.ToStringPtr("platform")}},
+			want: &v1alpha3.ResourceGroup{Spec: v1alpha3.ResourceGroupSpec{
+				Tags: map[string]string{"team": "data"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			LateInitialize(tc.r, tc.g, tc.d)
+			if diff := cmp.Diff(tc.want, tc.r); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLockUpToDate(t *testing.T) {
+	notes := "do not delete"
+
+	cases := []struct {
+		name string
+		l    *v1alpha3.ResourceGroupLock
+		o    *locks.ManagementLockObject
+		want bool
+	}{
+		{
+			name: "NoLock",
+			want: true,
+		},
+		{
+			name: "LockMissing",
+			l:    &v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete},
+			want: false,
+		},
+		{
+			name: "LockNotDesired",
+			o: &locks.ManagementLockObject{ManagementLockProperties: &locks.ManagementLockProperties{
+				Level: locks.CanNotDelete,
+			}},
+			want: false,
+		},
+		{
+			name: "LevelChanged",
+			l:    &v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelReadOnly},
+			o: &locks.ManagementLockObject{ManagementLockProperties: &locks.ManagementLockProperties{
+				Level: locks.CanNotDelete,
+			}},
+			want: false,
+		},
+		{
+			name: "NotesChanged",
+			l:    &v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete, Notes: &notes},
+			o: &locks.ManagementLockObject{ManagementLockProperties: &locks.ManagementLockProperties{
+				Level: locks.CanNotDelete,
+			}},
+			want: false,
+		},
+		{
+			name: "UpToDate",
+			l:    &v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete, Notes: &notes},
+			o: &locks.ManagementLockObject{ManagementLockProperties: &locks.ManagementLockProperties{
+				Level: locks.CanNotDelete,
+				Notes: azure.ToStringPtr(notes),
+			}},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsLockUpToDate(tc.l, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLockUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,30 +22,32 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
 // NewStorageAccountClient create Azure storage.AccountClient using provided credentials data
 func NewStorageAccountClient(data []byte) (*storage.AccountsClient, error) {
-	creds := &azure.Credentials{}
-	if err := json.Unmarshal(data, creds); err != nil {
+	creds := map[string]string{}
+	if err := json.Unmarshal(data, &creds); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
 	}
+	if err := azure.SetEnvironment(creds, v1beta1.ProviderConfigSpec{}); err != nil {
+		return nil, err
+	}
 
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := azure.NewAuthorizer(creds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authorizer from config: %w", err)
 	}
 
-	client := storage.NewAccountsClient(creds.SubscriptionID)
+	client := storage.NewAccountsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], creds[azure.CredentialsKeySubscriptionID])
 	client.Authorizer = authorizer
 
 	if err := client.AddToUserAgent(azure.UserAgent); err != nil {
@@ -56,7 +58,7 @@ func NewStorageAccountClient(data []byte) (*storage.AccountsClient, error) {
 
 // AccountOperations Azure storate account interface
 type AccountOperations interface {
-	Create(context.Context, storage.AccountCreateParameters) (*storage.Account, error)
+	Create(context.Context, storage.AccountCreateParameters) error
 	Update(context.Context, storage.AccountUpdateParameters) (*storage.Account, error)
 	Get(ctx context.Context) (*storage.Account, error)
 	Delete(ctx context.Context) error
@@ -82,27 +84,15 @@ func NewAccountHandle(client *storage.AccountsClient, groupName, accountName str
 	}
 }
 
-// Create create new storage account with given location
-func (a *AccountHandle) Create(ctx context.Context, params storage.AccountCreateParameters) (*storage.Account, error) {
+// Create starts creating a new storage account. It does not wait for the
+// storage account to be provisioned.
+func (a *AccountHandle) Create(ctx context.Context, params storage.AccountCreateParameters) error {
 	if err := a.IsAccountNameAvailable(ctx, a.accountName); err != nil {
-		return nil, errors.Wrapf(err, "failed to check account name availability")
-	}
-
-	future, err := a.client.Create(ctx, a.groupName, a.accountName, params)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to start creating storage account")
-	}
-
-	err = future.WaitForCompletionRef(ctx, a.client.Client)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to finish creating storage account")
+		return errors.Wrapf(err, "failed to check account name availability")
 	}
 
-	acct, err := future.Result(*a.client)
-	if err != nil {
-		return nil, err
-	}
-	return &acct, nil
+	_, err := a.client.Create(ctx, a.groupName, a.accountName, params)
+	return errors.Wrapf(err, "failed to start creating storage account")
 }
 
 // Update create new storage account with given location
@@ -157,3 +147,95 @@ func (a *AccountHandle) ListKeys(ctx context.Context) ([]storage.AccountKey, err
 
 	return *rs.Keys, nil
 }
+
+// withDefaults returns a copy of the supplied storage account spec with the
+// supplied defaults applied.
+func withDefaults(s *v1alpha3.StorageAccountSpec, d azure.ResourceDefaults) *v1alpha3.StorageAccountSpec {
+	out := &v1alpha3.StorageAccountSpec{}
+	if s != nil {
+		out = s.DeepCopy()
+	}
+	out.Location = d.LocationOr(out.Location)
+	out.Tags = d.MergeTags(out.Tags)
+	return out
+}
+
+// NewAccountCreateParameters returns storage account creation parameters
+// suitable for use with the Azure API. The supplied defaults are applied.
+func NewAccountCreateParameters(s *v1alpha3.StorageAccountSpec, d azure.ResourceDefaults) storage.AccountCreateParameters {
+	return v1alpha3.ToStorageAccountCreate(withDefaults(s, d))
+}
+
+// NewAccountUpdateParameters returns storage account update parameters
+// suitable for use with the Azure API.
+func NewAccountUpdateParameters(s *v1alpha3.StorageAccountSpec, a *storage.Account, d azure.ResourceDefaults) storage.AccountUpdateParameters {
+	desired := withDefaults(s, d)
+	p := v1alpha3.ToStorageAccountUpdate(desired)
+	p.Tags = d.UpdateTags(desired.Tags, a.Tags)
+	return p
+}
+
+// LateInitializeAccount fills the empty fields of the supplied storage account
+// spec with the values of the supplied storage account.
+func LateInitializeAccount(s *v1alpha3.StorageAccountSpec, a *storage.Account, d azure.ResourceDefaults) { // nolint:gocyclo
+	if s == nil || a == nil {
+		return
+	}
+	o := v1alpha3.NewStorageAccountSpec(a)
+	s.Tags = d.LateInitializeTags(s.Tags, a.Tags)
+	if s.Kind == "" {
+		s.Kind = o.Kind
+	}
+	if s.Identity == nil {
+		s.Identity = o.Identity
+	}
+	if s.Sku == nil {
+		s.Sku = o.Sku
+	} else if o.Sku != nil && s.Sku.Tier == "" {
+		s.Sku.Tier = o.Sku.Tier
+	}
+	if o.StorageAccountSpecProperties == nil {
+		return
+	}
+	if s.StorageAccountSpecProperties == nil {
+		s.StorageAccountSpecProperties = o.StorageAccountSpecProperties
+		return
+	}
+	if s.AccessTier == "" {
+		s.AccessTier = o.AccessTier
+	}
+	if s.CustomDomain == nil {
+		s.CustomDomain = o.CustomDomain
+	}
+	switch {
+	case s.Encryption == nil:
+		s.Encryption = o.Encryption
+	case o.Encryption != nil && s.Encryption.KeySource == "":
+		s.Encryption.KeySource = o.Encryption.KeySource
+	}
+	switch {
+	case s.NetworkRuleSet == nil:
+		s.NetworkRuleSet = o.NetworkRuleSet
+	case o.NetworkRuleSet != nil:
+		if s.NetworkRuleSet.Bypass == "" {
+			s.NetworkRuleSet.Bypass = o.NetworkRuleSet.Bypass
+		}
+		if s.NetworkRuleSet.DefaultAction == "" {
+			s.NetworkRuleSet.DefaultAction = o.NetworkRuleSet.DefaultAction
+		}
+	}
+}
+
+// IsAccountUpToDate returns true if the supplied storage account is up to date
+// with the supplied spec and defaults. The location of a storage account
+// cannot be changed, and is not compared.
+func IsAccountUpToDate(s *v1alpha3.StorageAccountSpec, a *storage.Account, d azure.ResourceDefaults) bool {
+	desired := withDefaults(s, d)
+	if !d.TagsUpToDate(desired.Tags, a.Tags) {
+		return false
+	}
+	return cmp.Equal(desired, v1alpha3.NewStorageAccountSpec(a),
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha3.StorageAccountSpec{}, "Location", "Tags"),
+		cmpopts.IgnoreFields(v1alpha3.Identity{}, "PrincipalID", "TenantID"))
+}
@@ -20,10 +20,14 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
 func TestNewStorageAccountClient(t *testing.T) {
@@ -105,3 +109,159 @@ func TestNewAccountHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAccountUpdateParameters(t *testing.T) {
+	s := &v1alpha3.StorageAccountSpec{
+		Sku:  &v1alpha3.Sku{Name: storage.StandardLRS},
+		Tags: map[string]string{"team": "platform"},
+	}
+	a := &storage.Account{Tags: map[string]*string{"policy": to.StringPtr("set"), "team": to.StringPtr("data")}}
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	want := storage.AccountUpdateParameters{
+		Sku: &storage.Sku{Name: storage.StandardLRS},
+		Tags: map[string]*string{
+			"owner":  to.StringPtr("finance"),
+			"policy": to.StringPtr("set"),
+			"team":   to.StringPtr("platform"),
+		},
+	}
+	got := NewAccountUpdateParameters(s, a, d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewAccountUpdateParameters(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestLateInitializeAccount(t *testing.T) {
+	a := &storage.Account{
+		Kind: storage.BlobStorage,
+		Sku:  &storage.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+		Tags: map[string]*string{"owner": to.StringPtr("finance"), "team": to.StringPtr("platform")},
+		AccountProperties: &storage.AccountProperties{
+			AccessTier: storage.Hot,
+			Encryption: &storage.Encryption{KeySource: storage.MicrosoftStorage},
+			NetworkRuleSet: &storage.NetworkRuleSet{
+				Bypass:        storage.AzureServices,
+				DefaultAction: storage.DefaultActionAllow,
+			},
+		},
+	}
+	d := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	cases := map[string]struct {
+		s    *v1alpha3.StorageAccountSpec
+		want *v1alpha3.StorageAccountSpec
+	}{
+		"Empty": {
+			s: &v1alpha3.StorageAccountSpec{},
+			want: &v1alpha3.StorageAccountSpec{
+				Kind: storage.BlobStorage,
+				Sku:  &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+				StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+					AccessTier: storage.Hot,
+					Encryption: &v1alpha3.Encryption{KeySource: storage.MicrosoftStorage},
+					NetworkRuleSet: &v1alpha3.NetworkRuleSet{
+						Bypass:        storage.AzureServices,
+						DefaultAction: storage.DefaultActionAllow,
+					},
+				},
+				Tags: map[string]string{"team": "platform"},
+			},
+		},
+		"PartiallySet": {
+			s: &v1alpha3.StorageAccountSpec{
+				Kind: storage.Storage,
+				Sku:  &v1alpha3.Sku{Name: storage.StandardLRS},
+				StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+					Encryption:     &v1alpha3.Encryption{Services: &v1alpha3.EnabledEncryptionServices{Blob: true}},
+					NetworkRuleSet: &v1alpha3.NetworkRuleSet{DefaultAction: storage.DefaultActionDeny},
+				},
+				Tags: map[string]string{"team": "data"},
+			},
+			want: &v1alpha3.StorageAccountSpec{
+				Kind: storage.Storage,
+				Sku:  &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+				StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+					AccessTier: storage.Hot,
+					Encryption: &v1alpha3.Encryption{
+						Services:  &v1alpha3.EnabledEncryptionServices{Blob: true},
+						KeySource: storage.MicrosoftStorage,
+					},
+					NetworkRuleSet: &v1alpha3.NetworkRuleSet{
+						Bypass:        storage.AzureServices,
+						DefaultAction: storage.DefaultActionDeny,
+					},
+				},
+				Tags: map[string]string{"team": "data"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccount(tc.s, a, d)
+			if diff := cmp.Diff(tc.want, tc.s); diff != "" {
+				t.Errorf("LateInitializeAccount(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccountUpToDate(t *testing.T) {
+	a := &storage.Account{
+		Kind:     storage.Storage,
+		Location: to.StringPtr("westus2"),
+		Identity: &storage.Identity{Type: to.StringPtr("SystemAssigned"), PrincipalID: to.StringPtr("cool-principal")},
+		Sku:      &storage.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+		Tags:     map[string]*string{"owner": to.StringPtr("finance"), "policy": to.StringPtr("set")},
+		AccountProperties: &storage.AccountProperties{
+			EnableHTTPSTrafficOnly: to.BoolPtr(true),
+		},
+	}
+	spec := func(m ...func(*v1alpha3.StorageAccountSpec)) *v1alpha3.StorageAccountSpec {
+		s := &v1alpha3.StorageAccountSpec{
+			Kind:                         storage.Storage,
+			Location:                     "West US 2",
+			Identity:                     &v1alpha3.Identity{Type: "SystemAssigned"},
+			Sku:                          &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+			StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{EnableHTTPSTrafficOnly: true},
+		}
+		for _, f := range m {
+			f(s)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		s    *v1alpha3.StorageAccountSpec
+		d    azure.ResourceDefaults
+		want bool
+	}{
+		"UpToDate": {
+			s:    spec(),
+			d:    azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
+			want: true,
+		},
+		"TagsChanged": {
+			s:    spec(func(s *v1alpha3.StorageAccountSpec) { s.Tags = map[string]string{"owner": "marketing"} }),
+			want: false,
+		},
+		"SkuChanged": {
+			s:    spec(func(s *v1alpha3.StorageAccountSpec) { s.Sku.Name = storage.StandardGRS }),
+			want: false,
+		},
+		"PropertiesChanged": {
+			s:    spec(func(s *v1alpha3.StorageAccountSpec) { s.EnableHTTPSTrafficOnly = false }),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccountUpToDate(tc.s, a, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAccountUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
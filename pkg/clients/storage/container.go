@@ -17,94 +17,66 @@ limitations under the License.
 package storage
 
 import (
-	"context"
-	"errors"
-	"fmt"
-	"net/http"
-	"net/url"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage/storageapi"
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
-// ContainerOperations interface to perform operations on Container resources
-type ContainerOperations interface {
-	Create(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error
-	Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error
-	Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error)
-	Delete(ctx context.Context) error
-}
-
-// ContainerHandle implements ContainerOperations
-type ContainerHandle struct {
-	azblob.ContainerURL
-	PublicAccessType azblob.PublicAccessType
-}
-
-var _ ContainerOperations = &ContainerHandle{}
-
-const blobFormatString = `https://%s.blob.core.windows.net`
-
-// NewContainerHandle creates a new instance of ContainerHandle for given storage account and given container name
-func NewContainerHandle(accountName, accountKey, containerName string) (*ContainerHandle, error) {
-	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
-	if err != nil {
-		return nil, err
+// A BlobContainersClient handles CRUD operations for Azure Blob Storage
+// Containers using the Azure Resource Manager API.
+type BlobContainersClient storageapi.BlobContainersClientAPI
+
+// NewBlobContainer returns blob container parameters suitable for use with
+// the Azure API.
+func NewBlobContainer(p v1alpha3.ContainerParameters) storage.BlobContainer {
+	return storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: toPublicAccess(p.PublicAccessType),
+			Metadata:     azure.ToStringPtrMap(p.Metadata),
+		},
 	}
-
-	p := azblob.NewPipeline(c, azblob.PipelineOptions{
-		Telemetry: azblob.TelemetryOptions{Value: azure.UserAgent},
-	})
-
-	u, _ := url.Parse(fmt.Sprintf(blobFormatString, accountName))
-	service := azblob.NewServiceURL(*u, p)
-
-	return &ContainerHandle{
-		ContainerURL: service.NewContainerURL(containerName),
-	}, nil
 }
 
-// Create container resource
-func (a *ContainerHandle) Create(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error {
-	_, err := a.ContainerURL.Create(ctx, azblob.Metadata{}, publicAccessType)
-	return err
-}
-
-// Update container resource
-func (a *ContainerHandle) Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error {
-	if _, err := a.ContainerURL.SetMetadata(ctx, metadata, azblob.ContainerAccessConditions{}); err != nil {
-		return err
+// LateInitializeContainer fills the empty fields of the supplied container
+// parameters with the values of the supplied blob container.
+func LateInitializeContainer(p *v1alpha3.ContainerParameters, c storage.BlobContainer) {
+	if c.ContainerProperties == nil {
+		return
 	}
-	_, err := a.ContainerURL.SetAccessPolicy(ctx, publicAccessType, nil, azblob.ContainerAccessConditions{})
-	return err
-}
-
-// Get resource information
-func (a *ContainerHandle) Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-	rs, err := a.ContainerURL.GetProperties(ctx, azblob.LeaseAccessConditions{})
-	if err != nil {
-		return nil, nil, err
+	if p.Metadata == nil && len(c.Metadata) > 0 {
+		p.Metadata = azure.ToStringMap(c.Metadata)
 	}
-	publicAccess := rs.BlobPublicAccess()
-	return &publicAccess, emtpyMetaToNil(rs.NewMetadata()), nil
 }
 
-// Delete deletes the named container.
-func (a *ContainerHandle) Delete(ctx context.Context) error {
-	_, err := a.ContainerURL.Delete(ctx, azblob.ContainerAccessConditions{})
-	return err
-}
-
-func emtpyMetaToNil(m azblob.Metadata) azblob.Metadata {
-	if len(m) == 0 {
-		return nil
+// IsContainerUpToDate returns true if the supplied blob container is up to
+// date with the supplied container parameters.
+func IsContainerUpToDate(p v1alpha3.ContainerParameters, c storage.BlobContainer) bool {
+	if c.ContainerProperties == nil {
+		return false
 	}
-	return m
+	if toPublicAccess(p.PublicAccessType) != c.PublicAccess {
+		return false
+	}
+	return cmp.Equal(map[string]string(p.Metadata), azure.ToStringMap(c.Metadata), cmpopts.EquateEmpty())
 }
 
-// IsNotFoundError tests for azblob not found error
-func IsNotFoundError(err error) bool {
-	var sErr azblob.StorageError
-	return errors.As(err, &sErr) && sErr.Response().StatusCode == http.StatusNotFound // nolint: bodyclose
+// toPublicAccess converts the public access type of a container to its Azure
+// Resource Manager equivalent. Public access is disabled if no type is
+// specified.
+func toPublicAccess(t azblob.PublicAccessType) storage.PublicAccess {
+	switch strings.ToLower(string(t)) {
+	case strings.ToLower(string(storage.PublicAccessBlob)):
+		return storage.PublicAccessBlob
+	case strings.ToLower(string(storage.PublicAccessContainer)):
+		return storage.PublicAccessContainer
+	default:
+		return storage.PublicAccessNone
+	}
 }
@@ -1,56 +1,136 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
 package storage
 
 import (
-	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 
-	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 )
 
-type storageError struct{}
-
-func (s *storageError) ServiceCode() azblob.ServiceCodeType {
-	return "boom"
-}
-
-func (s *storageError) Error() string {
-	return "boom"
-}
+func TestNewBlobContainer(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.ContainerParameters
+		want storage.BlobContainer
+	}{
+		"Private": {
+			p: v1alpha3.ContainerParameters{},
+			want: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: storage.PublicAccessNone,
+			}},
+		},
+		"Public": {
+			p: v1alpha3.ContainerParameters{
+				PublicAccessType: azblob.PublicAccessContainer,
+				Metadata:         azblob.Metadata{"owner": "finance"},
+			},
+			want: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: storage.PublicAccessContainer,
+				Metadata:     map[string]*string{"owner": to.StringPtr("finance")},
+			}},
+		},
+	}
 
-func (s *storageError) Timeout() bool {
-	return false
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewBlobContainer(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewBlobContainer(...): -want, +got\n%s", diff)
+			}
+		})
+	}
 }
 
-func (s *storageError) Temporary() bool {
-	return false
-}
+func TestLateInitializeContainer(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.ContainerParameters
+		c    storage.BlobContainer
+		want v1alpha3.ContainerParameters
+	}{
+		"Metadata": {
+			c: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				Metadata: map[string]*string{"owner": to.StringPtr("finance")},
+			}},
+			want: v1alpha3.ContainerParameters{Metadata: azblob.Metadata{"owner": "finance"}},
+		},
+		"AlreadySet": {
+			p: v1alpha3.ContainerParameters{Metadata: azblob.Metadata{"owner": "marketing"}},
+			c: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				Metadata: map[string]*string{"owner": to.StringPtr("finance")},
+			}},
+			want: v1alpha3.ContainerParameters{Metadata: azblob.Metadata{"owner": "marketing"}},
+		},
+	}
 
-func (s *storageError) Response() *http.Response {
-	return &http.Response{
-		StatusCode: http.StatusNotFound,
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeContainer(&tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeContainer(...): -want, +got\n%s", diff)
+			}
+		})
 	}
 }
 
-var _ azblob.StorageError = &storageError{}
-
-func TestIsNotFound(t *testing.T) {
-
-	cases := []struct {
-		err      error
-		expected bool
+func TestIsContainerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.ContainerParameters
+		c    storage.BlobContainer
+		want bool
 	}{
-		{nil, false},
-		{errors.New("boom"), false},
-		{&storageError{}, true},
+		"NoProperties": {
+			want: false,
+		},
+		"UpToDate": {
+			p: v1alpha3.ContainerParameters{PublicAccessType: azblob.PublicAccessBlob},
+			c: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: storage.PublicAccessBlob,
+				Metadata:     map[string]*string{},
+			}},
+			want: true,
+		},
+		"PublicAccessChanged": {
+			p: v1alpha3.ContainerParameters{},
+			c: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: storage.PublicAccessBlob,
+			}},
+			want: false,
+		},
+		"MetadataChanged": {
+			p: v1alpha3.ContainerParameters{Metadata: azblob.Metadata{"owner": "marketing"}},
+			c: storage.BlobContainer{ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: storage.PublicAccessNone,
+				Metadata:     map[string]*string{"owner": to.StringPtr("finance")},
+			}},
+			want: false,
+		},
 	}
 
-	for _, tt := range cases {
-		got := IsNotFoundError(tt.err)
-		if diff := cmp.Diff(got, tt.expected); diff != "" {
-			t.Errorf("IsNotFoundError() = %v, expected %v\n%s", got, tt.expected, diff)
-		}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsContainerUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsContainerUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
 	}
 }
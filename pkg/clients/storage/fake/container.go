@@ -19,60 +19,40 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-storage-blob-go/azblob"
-
-	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage/storageapi"
+	"github.com/Azure/go-autorest/autorest"
 )
 
-// MockContainerOperations mock implementation of ContainerOperations
-type MockContainerOperations struct {
-	MockCreate func(context.Context, azblob.PublicAccessType, azblob.Metadata) error
-	MockUpdate func(context.Context, azblob.PublicAccessType, azblob.Metadata) error
-	MockGet    func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error)
-	MockDelete func(ctx context.Context) error
-}
-
-var _ azurestorage.ContainerOperations = &MockContainerOperations{}
+var _ storageapi.BlobContainersClientAPI = &MockBlobContainersClient{}
 
-// NewMockContainerOperations create new mock instance with default mocks
-func NewMockContainerOperations() *MockContainerOperations {
-	return &MockContainerOperations{
-		MockCreate: func(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
-			return nil
-		},
-		MockUpdate: func(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
-			return nil
-		},
-		MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-			return nil, nil, nil
-		},
-		MockDelete: func(ctx context.Context) error {
-			return nil
-		},
-	}
-}
+// MockBlobContainersClient is a fake implementation of the Azure blob
+// containers client.
+type MockBlobContainersClient struct {
+	storageapi.BlobContainersClientAPI
 
-// Create mock create function
-func (m *MockContainerOperations) Create(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
-	return m.MockCreate(ctx, pat, meta)
+	MockCreate func(ctx context.Context, resourceGroupName string, accountName string, containerName string, blobContainer storage.BlobContainer) (storage.BlobContainer, error)
+	MockUpdate func(ctx context.Context, resourceGroupName string, accountName string, containerName string, blobContainer storage.BlobContainer) (storage.BlobContainer, error)
+	MockGet    func(ctx context.Context, resourceGroupName string, accountName string, containerName string) (storage.BlobContainer, error)
+	MockDelete func(ctx context.Context, resourceGroupName string, accountName string, containerName string) (autorest.Response, error)
 }
 
-// Update mock update function
-func (m *MockContainerOperations) Update(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
-	return m.MockUpdate(ctx, pat, meta)
+// Create calls the underlying MockCreate method.
+func (m *MockBlobContainersClient) Create(ctx context.Context, resourceGroupName string, accountName string, containerName string, blobContainer storage.BlobContainer) (storage.BlobContainer, error) {
+	return m.MockCreate(ctx, resourceGroupName, accountName, containerName, blobContainer)
 }
 
-// Get mock get function
-func (m *MockContainerOperations) Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-	return m.MockGet(ctx)
+// Update calls the underlying MockUpdate method.
+func (m *MockBlobContainersClient) Update(ctx context.Context, resourceGroupName string, accountName string, containerName string, blobContainer storage.BlobContainer) (storage.BlobContainer, error) {
+	return m.MockUpdate(ctx, resourceGroupName, accountName, containerName, blobContainer)
 }
 
-// Delete mock delete function
-func (m *MockContainerOperations) Delete(ctx context.Context) error {
-	return m.MockDelete(ctx)
+// Get calls the underlying MockGet method.
+func (m *MockBlobContainersClient) Get(ctx context.Context, resourceGroupName string, accountName string, containerName string) (storage.BlobContainer, error) {
+	return m.MockGet(ctx, resourceGroupName, accountName, containerName)
 }
 
-// PublicAccessTypePtr returns pointer of the PublicAccessType value
-func PublicAccessTypePtr(pab azblob.PublicAccessType) *azblob.PublicAccessType {
-	return &pab
+// Delete calls the underlying MockDelete method.
+func (m *MockBlobContainersClient) Delete(ctx context.Context, resourceGroupName string, accountName string, containerName string) (autorest.Response, error) {
+	return m.MockDelete(ctx, resourceGroupName, accountName, containerName)
 }
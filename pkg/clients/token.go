@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+)
+
+// Azure AD reports errors as codes like AADSTS7000215 in the description of
+// its token responses.
+var aadErrorCode = regexp.MustCompile(`AADSTS\d+`)
+
+// ErrorCode returns the Azure AD or Azure Resource Manager error code of the
+// supplied error, or the empty string if it has none.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	if c := aadErrorCode.FindString(err.Error()); c != "" {
+		return c
+	}
+	var rErr *azure.RequestError
+	if errors.As(err, &rErr) && rErr.ServiceError != nil {
+		return rErr.ServiceError.Code
+	}
+	return ""
+}
+
+// TokenTenantID returns the ID of the Azure AD tenant that issued the supplied
+// access token, or the empty string if it cannot be determined. The token's
+// signature is not verified.
+func TokenTenantID(accessToken string) string {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	claims := struct {
+		TenantID string `json:"tid"`
+	}{}
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return ""
+	}
+	return claims.TenantID
+}
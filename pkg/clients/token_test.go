@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestErrorCode(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want string
+	}{
+		"Nil": {},
+		"AzureAD": {
+			err:  errors.New(`adal: Refresh request failed. Status Code = '401'. Response body: {"error":"invalid_client","error_description":"AADSTS7000215: Invalid client secret provided."}`),
+			want: "AADSTS7000215",
+		},
+		"ResourceManager": {
+			err: autorest.DetailedError{Original: &azure.RequestError{
+				ServiceError: &azure.ServiceError{Code: "AuthorizationFailed"},
+			}},
+			want: "AuthorizationFailed",
+		},
+		"Unknown": {
+			err: errors.New("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ErrorCode(tc.err)); diff != "" {
+				t.Errorf("ErrorCode(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTokenTenantID(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"tid":"` + testTenantID + `"}`))
+
+	cases := map[string]struct {
+		token string
+		want  string
+	}{
+		"JWT": {
+			token: "header." + claims + ".signature",
+			want:  testTenantID,
+		},
+		"Opaque": {
+			token: testAccessToken,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, TokenTenantID(tc.token)); diff != "" {
+				t.Errorf("TokenTenantID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,11 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/cache"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/disk"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/nodepool"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/snapshot"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/virtualmachine"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/virtualmachinescaleset"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/cosmosdb"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqlserver"
@@ -33,12 +38,24 @@ import (
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlserverconfiguration"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlserverfirewallrule"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlservervirtualnetworkrule"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/dns/privatezone"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/dns/recordset"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/dns/zone"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/keyvault/secret"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/managedidentity/userassignedidentity"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/loadbalancer"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/natgateway"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/networkinterface"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/privateendpoint"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/publicipaddress"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/publicipprefix"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/route"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/routetable"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/securitygroup"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/securityrule"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/subnet"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/virtualnetwork"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/virtualnetworkpeering"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/resourcegroup"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/storage/account"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/storage/container"
@@ -49,6 +66,11 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		cache.SetupRedis,
 		compute.SetupAKSCluster,
+		nodepool.Setup,
+		virtualmachine.Setup,
+		virtualmachinescaleset.Setup,
+		disk.Setup,
+		snapshot.Setup,
 		mysqlserver.Setup,
 		mysqlserverfirewallrule.Setup,
 		mysqlservervirtualnetworkrule.Setup,
@@ -60,13 +82,25 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cosmosdb.Setup,
 		publicipaddress.Setup,
 		virtualnetwork.Setup,
+		virtualnetworkpeering.Setup,
 		subnet.Setup,
+		securitygroup.Setup,
+		securityrule.Setup,
+		routetable.Setup,
+		route.Setup,
+		privateendpoint.Setup,
+		loadbalancer.Setup,
+		networkinterface.Setup,
+		publicipprefix.Setup,
+		natgateway.Setup,
 		resourcegroup.Setup,
 		account.Setup,
 		container.Setup,
 		secret.SetupSecret,
 		zone.Setup,
 		recordset.Setup,
+		privatezone.Setup,
+		userassignedidentity.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
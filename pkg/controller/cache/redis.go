@@ -85,14 +85,19 @@ func (c connector) Connect(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return nil, errors.Wrap(err, errConnectFailed)
 	}
-	cl := redis.NewClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := redis.NewClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{kube: c.kube, client: cl}, nil
+	d, err := azure.GetResourceDefaults(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	return &external{kube: c.kube, client: cl, defaults: d}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client redisapi.ClientAPI
+	kube     client.Client
+	client   redisapi.ClientAPI
+	defaults azure.ResourceDefaults
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -105,7 +110,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(azure.IsNotFound, err), errGetFailed)
 	}
 
-	redisclients.LateInitialize(&cr.Spec.ForProvider, cache)
+	redisclients.LateInitialize(&cr.Spec.ForProvider, cache, c.defaults)
+	upToDate := !redisclients.NeedsUpdate(cr.Spec.ForProvider, cache, c.defaults)
+	if upToDate {
+		azure.SetManagedTags(cr, c.defaults.MergeTags(cr.Spec.ForProvider.Tags))
+	}
 	if err := c.kube.Update(ctx, cr); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateRedisCRFailed)
 	}
@@ -133,7 +142,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !redisclients.NeedsUpdate(cr.Spec.ForProvider, cache),
+		ResourceUpToDate:  upToDate,
 		ConnectionDetails: conn,
 	}, nil
 }
@@ -144,7 +153,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotRedis)
 	}
 	cr.Status.SetConditions(xpv1.Creating())
-	_, err := c.client.Create(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redisclients.NewCreateParameters(cr))
+	_, err := c.client.Create(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redisclients.NewCreateParameters(cr, c.defaults))
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 }
 
@@ -166,7 +175,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		ctx,
 		cr.Spec.ForProvider.ResourceGroupName,
 		meta.GetExternalName(cr),
-		redisclients.NewUpdateParameters(cr.Spec.ForProvider, cache))
+		redisclients.NewUpdateParameters(cr.Spec.ForProvider, cache, c.defaults))
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 }
 
@@ -0,0 +1,377 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name          = "cool-disk"
+	resourceGroup = "coolgroup"
+	sku           = "Premium_LRS"
+	id            = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.Compute/disks/cool-disk"
+	uniqueID      = "cool-disk-id"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type diskModifier func(*v1alpha3.Disk)
+
+func withConditions(c ...xpv1.Condition) diskModifier {
+	return func(cr *v1alpha3.Disk) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDiskSizeGB(s int) diskModifier {
+	return func(cr *v1alpha3.Disk) { cr.Spec.DiskSizeGB = to.IntPtr(s) }
+}
+
+func withZone(z string) diskModifier {
+	return func(cr *v1alpha3.Disk) { cr.Spec.Zone = to.StringPtr(z) }
+}
+
+func withStatus(s v1alpha3.DiskStatus) diskModifier {
+	return func(cr *v1alpha3.Disk) {
+		s.ConditionedStatus = cr.Status.ConditionedStatus
+		cr.Status = s
+	}
+}
+
+func disk(m ...diskModifier) *v1alpha3.Disk {
+	cr := &v1alpha3.Disk{
+		Spec: v1alpha3.DiskSpec{
+			DiskParameters: v1alpha3.DiskParameters{
+				ResourceGroupName: resourceGroup,
+				SKU:               to.StringPtr(sku),
+				DiskSizeGB:        to.IntPtr(64),
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func azureDisk(state string, zones ...string) computemgmt.Disk {
+	d := computemgmt.Disk{
+		ID:  to.StringPtr(id),
+		Sku: &computemgmt.DiskSku{Name: computemgmt.DiskStorageAccountTypes(sku)},
+		DiskProperties: &computemgmt.DiskProperties{
+			ProvisioningState: to.StringPtr(state),
+			DiskState:         computemgmt.DiskStateUnattached,
+			UniqueID:          to.StringPtr(uniqueID),
+			DiskSizeGB:        to.Int32Ptr(64),
+		},
+	}
+	if len(zones) > 0 {
+		d.Zones = &zones
+	}
+	return d
+}
+
+func getFn(d computemgmt.Disk, err error) func(context.Context, string, string) (computemgmt.Disk, error) {
+	return func(_ context.Context, rg, n string) (computemgmt.Disk, error) {
+		if rg != resourceGroup || n != name {
+			return computemgmt.Disk{}, errors.Errorf("unexpected disk %s/%s", rg, n)
+		}
+		return d, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	status := v1alpha3.DiskStatus{State: stateSucceeded, DiskState: string(computemgmt.DiskStateUnattached), ProviderID: id, UniqueID: uniqueID}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotDisk": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotDisk),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockDisksClient{MockGet: getFn(computemgmt.Disk{}, errNotFound)}},
+			cr: disk(),
+			want: want{
+				cr: disk(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockDisksClient{MockGet: getFn(computemgmt.Disk{}, errBoom)}},
+			cr: disk(),
+			want: want{
+				cr:  disk(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockDisksClient{MockGet: getFn(azureDisk(stateSucceeded, "1"), nil)}},
+			cr: disk(),
+			want: want{
+				cr: disk(
+					withZone("1"),
+					withConditions(xpv1.Available()),
+					withStatus(status),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Updating": {
+			e:  &external{client: &fake.MockDisksClient{MockGet: getFn(azureDisk("Updating"), nil)}},
+			cr: disk(withDiskSizeGB(128)),
+			want: want{
+				cr: disk(
+					withDiskSizeGB(128),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha3.DiskStatus{State: "Updating", DiskState: string(computemgmt.DiskStateUnattached), ProviderID: id, UniqueID: uniqueID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockDisksClient{MockGet: getFn(azureDisk(stateSucceeded), nil)}},
+			cr: disk(withDiskSizeGB(128)),
+			want: want{
+				cr: disk(
+					withDiskSizeGB(128),
+					withConditions(xpv1.Available()),
+					withStatus(status),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotDisk": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotDisk),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.Disk) (computemgmt.DisksCreateOrUpdateFuture, error) {
+					return computemgmt.DisksCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr: disk(),
+			want: want{
+				cr:  disk(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockDisksClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.Disk) (computemgmt.DisksCreateOrUpdateFuture, error) {
+					return computemgmt.DisksCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: disk(),
+			want: want{
+				cr: disk(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotDisk": {
+			e:    &external{},
+			want: errors.New(errNotDisk),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockDisksClient{MockGet: getFn(computemgmt.Disk{}, errBoom)}},
+			cr:   disk(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: getFn(azureDisk(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, _ computemgmt.DiskUpdate) (computemgmt.DisksUpdateFuture, error) {
+					return computemgmt.DisksUpdateFuture{}, errBoom
+				},
+			}},
+			cr:   disk(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Resize": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: getFn(azureDisk(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, u computemgmt.DiskUpdate) (computemgmt.DisksUpdateFuture, error) {
+					if to.Int32(u.DiskSizeGB) != 128 {
+						t.Errorf("Update(...): unexpected size %d", to.Int32(u.DiskSizeGB))
+					}
+					return computemgmt.DisksUpdateFuture{}, nil
+				},
+			}},
+			cr: disk(withDiskSizeGB(128)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotDisk": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotDisk),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockDisksClient{
+				MockDelete: func(_ context.Context, _, _ string) (computemgmt.DisksDeleteFuture, error) {
+					return computemgmt.DisksDeleteFuture{}, errNotFound
+				},
+			}},
+			cr: disk(),
+			want: want{
+				cr: disk(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockDelete: func(_ context.Context, _, _ string) (computemgmt.DisksDeleteFuture, error) {
+					return computemgmt.DisksDeleteFuture{}, errBoom
+				},
+			}},
+			cr: disk(),
+			want: want{
+				cr:  disk(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,8 +18,10 @@ package compute
 
 import (
 	"context"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -49,7 +51,12 @@ const (
 	errNotAKSCluster    = "managed resource is not a AKSCluster"
 	errCreateAKSCluster = "cannot create AKSCluster"
 	errGetAKSCluster    = "cannot get AKSCluster"
+	errUpdateAKSCluster = "cannot update AKSCluster"
+	errGrantNetwork     = "cannot grant the AKSCluster identity access to its subnet"
 	errGetKubeConfig    = "cannot get AKSCluster kubeconfig"
+	errGetCredentials   = "cannot get AKSCluster service principal credentials"
+	errRotateSecret     = "cannot rotate AKSCluster service principal secret"
+	errRemoveCredential = "cannot remove expired AKSCluster service principal credentials"
 	errDeleteAKSCluster = "cannot delete AKSCluster"
 	errGetConnSecret    = "cannot get connection secret"
 )
@@ -82,20 +89,29 @@ type connecter struct {
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	cr, ok := mg.(*v1alpha3.AKSCluster)
+	if !ok {
+		return nil, errors.New(errNotAKSCluster)
+	}
+	cc, err := azure.GetCredentials(ctx, c.client, mg)
 	if err != nil {
 		return nil, err
 	}
-	cl, err := compute.NewAggregateClient(creds, auth)
+	d, err := azure.GetResourceDefaults(ctx, c.client, mg)
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.client, client: cl, newPasswordFn: password.Generate}, nil
+	cl, err := compute.NewAggregateClient(cc, azure.SubscriptionID(mg, cc.Credentials()), d, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.client, client: cl, defaults: d, newPasswordFn: password.Generate}, nil
 }
 
 type external struct {
 	kube          client.Client
 	client        compute.AKSClient
+	defaults      azure.ResourceDefaults
 	newPasswordFn func() (password string, err error)
 }
 
@@ -116,29 +132,55 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	cr.Status.ProviderID = to.String(c.ID)
 	cr.Status.State = to.String(c.ProvisioningState)
 	cr.Status.Endpoint = to.String(c.Fqdn)
+	cr.Status.IdentityPrincipalID = compute.IdentityPrincipalID(c)
+
+	current := cr.Spec.AKSClusterParameters.DeepCopy()
+	compute.LateInitialize(&cr.Spec.AKSClusterParameters, c, e.defaults)
+	li := !cmp.Equal(current, &cr.Spec.AKSClusterParameters)
 
-	if cr.Status.State != "Succeeded" {
-		// AKS clusters are always up to date because we can't yet update them.
-		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	if !compute.IsUpdatable(cr.Status.State) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: li}, nil
 	}
 
-	kubeconfig, err := e.client.GetKubeConfig(ctx, cr)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetKubeConfig)
+	kcs := cr.Spec.Kubeconfigs
+	if len(kcs) == 0 {
+		kcs = []v1alpha3.AKSClusterKubeconfig{{Type: v1alpha3.KubeconfigTypeAdmin, Key: xpv1.ResourceCredentialsSecretKubeconfigKey}}
+	}
+	var cd managed.ConnectionDetails
+	for i, kc := range kcs {
+		kubeconfig, err := e.client.GetKubeConfig(ctx, cr, kc)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetKubeConfig)
+		}
+		if i == 0 {
+			if cd, err = connectionDetails(kubeconfig, meta.GetExternalName(cr)); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
+			}
+		}
+		cd[kc.Key] = kubeconfig
 	}
 
-	cd, err := connectionDetails(kubeconfig, meta.GetExternalName(cr))
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
+	upToDate := compute.IsManagedClusterUpToDate(cr, c, e.defaults) && compute.IsNetworkContributorUpToDate(cr, c)
+	if rotatesCredentials(cr) {
+		creds, err := e.client.GetServicePrincipalCredentials(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetCredentials)
+		}
+		cr.Status.Credential = compute.GenerateCredentialStatus(creds)
+		upToDate = upToDate && compute.IsCredentialRotationUpToDate(cr, creds, time.Now())
+	}
+
+	if upToDate {
+		li = azure.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.Tags)) || li
 	}
 
 	cr.SetConditions(xpv1.Available())
 
-	// AKS clusters are always up to date because we can't yet update them.
 	o := managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
-		ConnectionDetails: cd,
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+		ConnectionDetails:       cd,
 	}
 	return o, nil
 }
@@ -150,6 +192,11 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	cr.SetConditions(xpv1.Creating())
 
+	// Clusters with a managed identity have no service principal secret.
+	if compute.UsesManagedIdentity(cr) {
+		return managed.ExternalCreation{}, errors.Wrap(e.client.EnsureManagedCluster(ctx, cr, ""), errCreateAKSCluster)
+	}
+
 	pw, err := e.getPassword(ctx, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -184,11 +231,85 @@ func (e *external) getPassword(ctx context.Context, cr *v1alpha3.AKSCluster) (st
 	return string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]), nil
 }
 
-func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(negz): Support updates.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.AKSCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAKSCluster)
+	}
+
+	c, err := e.client.GetManagedCluster(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAKSCluster)
+	}
+
+	if rotatesCredentials(cr) {
+		pw, err := e.rotateCredentials(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if pw != "" {
+			// Azure rejects updates to clusters while their service principal
+			// profile is being reset, so any other updates wait until the
+			// next reconcile.
+			return managed.ExternalUpdate{
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+				},
+			}, nil
+		}
+	}
+
+	if !compute.IsManagedClusterUpToDate(cr, c, e.defaults) {
+		pw := ""
+		if !compute.UsesManagedIdentity(cr) {
+			if pw, err = e.getPassword(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		if err := e.client.UpdateManagedCluster(ctx, cr, c, pw); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAKSCluster)
+		}
+	}
+
+	if !compute.IsNetworkContributorUpToDate(cr, c) {
+		id := compute.IdentityPrincipalID(c)
+		if err := e.client.EnsureNetworkContributor(ctx, cr, id); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGrantNetwork)
+		}
+		cr.Status.NetworkContributorPrincipalID = id
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
+// rotateCredentials rotates the service principal secret of the supplied
+// AKSCluster if it is due, and returns the new secret. Otherwise it removes any
+// credentials whose grace period has passed once the cluster uses the newest
+// secret.
+func (e *external) rotateCredentials(ctx context.Context, cr *v1alpha3.AKSCluster) (string, error) {
+	creds, err := e.client.GetServicePrincipalCredentials(ctx, cr)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCredentials)
+	}
+	now := time.Now()
+	if compute.IsCredentialRotationDue(cr, creds, now) {
+		pw, err := e.newPasswordFn()
+		if err != nil {
+			return "", errors.Wrap(err, errGenPassword)
+		}
+		id, err := e.client.RotateServicePrincipalSecret(ctx, cr, pw)
+		if err != nil {
+			return "", errors.Wrap(err, errRotateSecret)
+		}
+		cr.Status.ServicePrincipalKeyID = id
+		return pw, nil
+	}
+	if expired := compute.ExpiredCredentials(cr, creds, now); len(expired) > 0 {
+		return "", errors.Wrap(e.client.RemoveServicePrincipalCredentials(ctx, cr, expired), errRemoveCredential)
+	}
+	return "", nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha3.AKSCluster)
 	if !ok {
@@ -198,6 +319,12 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(e.client.DeleteManagedCluster(ctx, cr), errDeleteAKSCluster)
 }
 
+// rotatesCredentials returns true if the service principal secret of the
+// supplied AKSCluster should be rotated.
+func rotatesCredentials(cr *v1alpha3.AKSCluster) bool {
+	return cr.Spec.CredentialRotation != nil && !compute.UsesManagedIdentity(cr)
+}
+
 func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetails, error) {
 	kcfg, err := clientcmd.Load(kubeconfig)
 	if err != nil {
@@ -205,6 +332,9 @@ func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetail
 
 	}
 	kctx, ok := kcfg.Contexts[name]
+	if !ok {
+		kctx, ok = kcfg.Contexts[kcfg.CurrentContext]
+	}
 	if !ok {
 		return nil, errors.Errorf("context configuration is not found for cluster: %s", name)
 	}
@@ -222,6 +352,5 @@ func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetail
 		xpv1.ResourceCredentialsSecretCAKey:         cluster.CertificateAuthorityData,
 		xpv1.ResourceCredentialsSecretClientCertKey: auth.ClientCertificateData,
 		xpv1.ResourceCredentialsSecretClientKeyKey:  auth.ClientKeyData,
-		xpv1.ResourceCredentialsSecretKubeconfigKey: kubeconfig,
 	}, nil
 }
@@ -20,16 +20,21 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -41,8 +46,31 @@ import (
 const (
 	testPasswd         = "pass123"
 	testExistingSecret = "existingSecret"
+	testName           = "cool-cluster"
+	testVersion        = "1.21.7"
+	testSubnet         = "/subscriptions/cool/resourceGroups/cool-rg/providers/Microsoft.Network/virtualNetworks/cool-vnet/subnets/cool-subnet"
+	testPrincipal      = "cool-principal"
+	testKeyID          = "cool-key"
 )
 
+var testCredentialCreated = time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+var testKubeConfig = []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: cool-cluster
+  cluster:
+    server: https://cool-cluster.example.org:443
+contexts:
+- name: cool-cluster
+  context:
+    cluster: cool-cluster
+    user: cool-user
+users:
+- name: cool-user
+  user: {}
+`)
+
 type modifier func(*v1alpha3.AKSCluster)
 
 func withState(state string) modifier {
@@ -63,6 +91,94 @@ func withEndpoint(ep string) modifier {
 	}
 }
 
+func withExternalName(n string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		meta.SetExternalName(c, n)
+	}
+}
+
+func withVersion(v string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.Version = v
+	}
+}
+
+func withNodeCount(n int) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.NodeCount = &n
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(cr *v1alpha3.AKSCluster) {
+		cr.SetConditions(c...)
+	}
+}
+
+func withIdentity(t string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.Identity = &v1alpha3.AKSClusterIdentity{Type: t}
+	}
+}
+
+func withSubnet(id string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.VnetSubnetID = id
+	}
+}
+
+func withIdentityPrincipalID(id string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.IdentityPrincipalID = id
+	}
+}
+
+func withNetworkContributorPrincipalID(id string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.NetworkContributorPrincipalID = id
+	}
+}
+
+func withAutoUpgradeChannel(ch string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.AutoUpgradeChannel = &ch
+	}
+}
+
+func withAddOns(a *v1alpha3.AKSClusterAddOns) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.AddOns = a
+	}
+}
+
+func withKubeconfigs(kcs ...v1alpha3.AKSClusterKubeconfig) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.Kubeconfigs = kcs
+	}
+}
+
+func withCredentialRotation(interval time.Duration) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.CredentialRotation = &v1alpha3.AKSClusterCredentialRotation{Interval: metav1.Duration{Duration: interval}}
+	}
+}
+
+func withCredentialStatus(s *v1alpha3.AKSClusterCredentialStatus) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.Credential = s
+	}
+}
+
+func withServicePrincipalKeyID(id string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.ServicePrincipalKeyID = id
+	}
+}
+
+func credential(keyID string, start time.Time) graphrbac.PasswordCredential {
+	return graphrbac.PasswordCredential{KeyID: to.StringPtr(keyID), StartDate: &date.Time{Time: start}}
+}
+
 func withConnectionSecretRef(ref *xpv1.SecretReference) modifier {
 	return func(c *v1alpha3.AKSCluster) {
 		c.Spec.WriteConnectionSecretToReference = ref
@@ -171,6 +287,332 @@ func TestObserve(t *testing.T) {
 				),
 			},
 		},
+		"LateInitialized": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateWat),
+							AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{Count: to.Int32Ptr(3)}},
+						}}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				mg: aksCluster(
+					withState(stateWat),
+					withNodeCount(3),
+				),
+			},
+		},
+		"OptionsLateInitialized": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState:  to.StringPtr(stateSucceeded),
+							KubernetesVersion:  to.StringPtr("1.21.9"),
+							EnableRBAC:         to.BoolPtr(true),
+							AutoUpgradeProfile: &containerservice.ManagedClusterAutoUpgradeProfile{UpgradeChannel: containerservice.UpgradeChannelPatch},
+							AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+								"azurepolicy": {Enabled: to.BoolPtr(true)},
+							},
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(testName), withVersion(testVersion)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeConfig,
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withAutoUpgradeChannel("patch"),
+					withAddOns(&v1alpha3.AKSClusterAddOns{AzurePolicy: &v1alpha3.AKSClusterAzurePolicyAddOn{Enabled: true}}),
+					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"UpToDate": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+							KubernetesVersion: to.StringPtr(testVersion),
+							EnableRBAC:        to.BoolPtr(true),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(testName), withVersion(testVersion)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeConfig,
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"MultipleKubeconfigs": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+							KubernetesVersion: to.StringPtr(testVersion),
+							EnableRBAC:        to.BoolPtr(true),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, kc v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						if kc.Type == v1alpha3.KubeconfigTypeAdmin {
+							return []byte("admin"), nil
+						}
+						return testKubeConfig, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(withExternalName(testName), withVersion(testVersion), withKubeconfigs(
+					v1alpha3.AKSClusterKubeconfig{Type: v1alpha3.KubeconfigTypeUser, Key: "user"},
+					v1alpha3.AKSClusterKubeconfig{Type: v1alpha3.KubeconfigTypeAdmin, Key: "admin"},
+				)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						"user":  testKubeConfig,
+						"admin": []byte("admin"),
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withKubeconfigs(
+						v1alpha3.AKSClusterKubeconfig{Type: v1alpha3.KubeconfigTypeUser, Key: "user"},
+						v1alpha3.AKSClusterKubeconfig{Type: v1alpha3.KubeconfigTypeAdmin, Key: "admin"},
+					),
+					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"CredentialRotationDue": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+							KubernetesVersion: to.StringPtr(testVersion),
+							EnableRBAC:        to.BoolPtr(true),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return []graphrbac.PasswordCredential{credential(testKeyID, testCredentialCreated)}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(testName), withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeConfig,
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withCredentialRotation(24*time.Hour),
+					withState(stateSucceeded),
+					withCredentialStatus(&v1alpha3.AKSClusterCredentialStatus{KeyID: testKeyID, CreatedAt: &metav1.Time{Time: testCredentialCreated}}),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"ErrGetCredentials": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+							KubernetesVersion: to.StringPtr(testVersion),
+							EnableRBAC:        to.BoolPtr(true),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(testName), withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+			},
+			want: want{
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withCredentialRotation(24*time.Hour),
+					withState(stateSucceeded),
+				),
+				err: errors.Wrap(errBoom, errGetCredentials),
+			},
+		},
+		"NeedsUpgrade": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+							KubernetesVersion: to.StringPtr("1.20.9"),
+							EnableRBAC:        to.BoolPtr(true),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withExternalName(testName), withVersion(testVersion)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeConfig,
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"NeedsNetworkContributor": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{
+							Identity: &containerservice.ManagedClusterIdentity{
+								Type:        containerservice.ResourceIdentityTypeSystemAssigned,
+								PrincipalID: to.StringPtr(testPrincipal),
+							},
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateSucceeded),
+								KubernetesVersion: to.StringPtr(testVersion),
+								EnableRBAC:        to.BoolPtr(true),
+							},
+						}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
+						return testKubeConfig, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withIdentity(v1alpha3.IdentityTypeSystemAssigned),
+					withSubnet(testSubnet),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte("https://cool-cluster.example.org:443"),
+						xpv1.ResourceCredentialsSecretCAKey:         nil,
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeConfig,
+					},
+				},
+				mg: aksCluster(
+					withExternalName(testName),
+					withVersion(testVersion),
+					withIdentity(v1alpha3.IdentityTypeSystemAssigned),
+					withSubnet(testSubnet),
+					withState(stateSucceeded),
+					withIdentityPrincipalID(testPrincipal),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
 		"ErrGetKubeConfig": {
 			e: &external{
 				client: fake.AKSClient{
@@ -179,7 +621,7 @@ func TestObserve(t *testing.T) {
 							ProvisioningState: to.StringPtr(stateSucceeded),
 						}}, nil
 					},
-					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster, _ v1alpha3.AKSClusterKubeconfig) ([]byte, error) {
 						return nil, errBoom
 					},
 				},
@@ -204,7 +646,7 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
 				t.Errorf("tc.e.Observe(...): -want managed, +got managed:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
@@ -296,6 +738,24 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessManagedIdentity": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+				client: fake.AKSClient{
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, secret string) error {
+						if secret != "" {
+							return errors.Errorf("secret: want none, got %q", secret)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withIdentity(v1alpha3.IdentityTypeSystemAssigned)),
+			},
+			want: want{},
+		},
 		"SuccessExistingEmptyAppSecret": {
 			e: &external{
 				newPasswordFn: func() (string, error) { return testPasswd, nil },
@@ -403,6 +863,366 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	upToDate := containerservice.ManagedCluster{
+		Identity: &containerservice.ManagedClusterIdentity{
+			Type:        containerservice.ResourceIdentityTypeSystemAssigned,
+			PrincipalID: to.StringPtr(testPrincipal),
+		},
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			KubernetesVersion: to.StringPtr(testVersion),
+			EnableRBAC:        to.BoolPtr(true),
+		},
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAKSCluster": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotAKSCluster),
+			},
+		},
+		"ErrGetCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg:  aksCluster(),
+				err: errors.Wrap(errBoom, errGetAKSCluster),
+			},
+		},
+		"ErrGetAppSecret": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+			},
+			want: want{
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+				err: errors.Wrap(errBoom, errGetConnSecret),
+			},
+		},
+		"ErrUpdateCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, nil
+					},
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ containerservice.ManagedCluster, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg:  aksCluster(),
+				err: errors.Wrap(errBoom, errUpdateAKSCluster),
+			},
+		},
+		"SuccessExistingAppSecret": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, nil
+					},
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ containerservice.ManagedCluster, secret string) error {
+						if secret != testExistingSecret {
+							return errors.Errorf("secret: want %q, got %q", testExistingSecret, secret)
+						}
+						return nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, o client.Object) error {
+						s, ok := o.(*v1.Secret)
+						if !ok {
+							t.Fatalf("not a *v1.Secret")
+						}
+						s.Data = map[string][]byte{"password": []byte(testExistingSecret)}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+			},
+			want: want{
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+			},
+		},
+		"ErrGetCredentials": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+			},
+			want: want{
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+				err: errors.Wrap(errBoom, errGetCredentials),
+			},
+		},
+		"ErrRotateSecret": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return []graphrbac.PasswordCredential{credential(testKeyID, testCredentialCreated)}, nil
+					},
+					MockRotateServicePrincipalSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) (string, error) {
+						return "", errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+			},
+			want: want{
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour)),
+				err: errors.Wrap(errBoom, errRotateSecret),
+			},
+		},
+		"SuccessRotateSecret": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return []graphrbac.PasswordCredential{credential(testKeyID, testCredentialCreated)}, nil
+					},
+					MockRotateServicePrincipalSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, secret string) (string, error) {
+						if secret != testPasswd {
+							return "", errors.Errorf("secret: want %q, got %q", testPasswd, secret)
+						}
+						return "new-key", nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withCredentialRotation(24 * time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(testPasswd),
+					},
+				},
+				mg: aksCluster(withCredentialRotation(24*time.Hour), withServicePrincipalKeyID("new-key")),
+			},
+		},
+		"ResetInProgress": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return []graphrbac.PasswordCredential{
+							credential(testKeyID, testCredentialCreated),
+							credential("new-key", time.Now().Add(-2*time.Hour)),
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour), withServicePrincipalKeyID("new-key"), withState("Updating")),
+			},
+			want: want{
+				mg: aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour), withServicePrincipalKeyID("new-key"), withState("Updating")),
+			},
+		},
+		"SuccessRemoveExpiredCredentials": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockGetServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]graphrbac.PasswordCredential, error) {
+						return []graphrbac.PasswordCredential{
+							credential(testKeyID, testCredentialCreated),
+							credential("new-key", time.Now().Add(-2*time.Hour)),
+						}, nil
+					},
+					MockRemoveServicePrincipalCredentials: func(_ context.Context, _ *v1alpha3.AKSCluster, keyIDs []string) error {
+						if diff := cmp.Diff([]string{testKeyID}, keyIDs); diff != "" {
+							return errors.Errorf("keyIDs: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour), withServicePrincipalKeyID("new-key"), withState("Succeeded")),
+			},
+			want: want{
+				mg: aksCluster(withVersion(testVersion), withCredentialRotation(24*time.Hour), withServicePrincipalKeyID("new-key"), withState("Succeeded")),
+			},
+		},
+		"ErrGrantNetworkContributor": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockEnsureNetworkContributor: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withIdentity(v1alpha3.IdentityTypeSystemAssigned), withSubnet(testSubnet)),
+			},
+			want: want{
+				mg:  aksCluster(withVersion(testVersion), withIdentity(v1alpha3.IdentityTypeSystemAssigned), withSubnet(testSubnet)),
+				err: errors.Wrap(errBoom, errGrantNetwork),
+			},
+		},
+		"SuccessGrantNetworkContributor": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upToDate, nil
+					},
+					MockEnsureNetworkContributor: func(_ context.Context, _ *v1alpha3.AKSCluster, principalID string) error {
+						if principalID != testPrincipal {
+							return errors.Errorf("principal: want %q, got %q", testPrincipal, principalID)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(testVersion), withIdentity(v1alpha3.IdentityTypeSystemAssigned), withSubnet(testSubnet)),
+			},
+			want: want{
+				mg: aksCluster(
+					withVersion(testVersion),
+					withIdentity(v1alpha3.IdentityTypeSystemAssigned),
+					withSubnet(testSubnet),
+					withNetworkContributorPrincipalID(testPrincipal),
+				),
+			},
+		},
+		"SuccessManagedIdentity": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, nil
+					},
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ containerservice.ManagedCluster, secret string) error {
+						if secret != "" {
+							return errors.Errorf("secret: want none, got %q", secret)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: aksCluster(withIdentity(v1alpha3.IdentityTypeSystemAssigned), withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+			},
+			want: want{
+				mg: aksCluster(withIdentity(v1alpha3.IdentityTypeSystemAssigned), withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("tc.e.Update(...): -want managed resource, +got managed resource:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
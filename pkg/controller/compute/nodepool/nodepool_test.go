@@ -0,0 +1,373 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name          = "gpu"
+	resourceGroup = "coolgroup"
+	clusterName   = "coolcluster"
+	vmSize        = "Standard_NC6s_v3"
+	id            = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.ContainerService/managedClusters/coolcluster/agentPools/gpu"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type nodePoolModifier func(*v1alpha3.AKSNodePool)
+
+func withConditions(c ...xpv1.Condition) nodePoolModifier {
+	return func(cr *v1alpha3.AKSNodePool) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withCount(c int) nodePoolModifier {
+	return func(cr *v1alpha3.AKSNodePool) { cr.Spec.Count = &c }
+}
+
+func withStatus(s v1alpha3.AKSNodePoolStatus) nodePoolModifier {
+	return func(cr *v1alpha3.AKSNodePool) {
+		s.ConditionedStatus = cr.Status.ConditionedStatus
+		cr.Status = s
+	}
+}
+
+func nodePool(m ...nodePoolModifier) *v1alpha3.AKSNodePool {
+	cr := &v1alpha3.AKSNodePool{
+		Spec: v1alpha3.AKSNodePoolSpec{
+			AKSNodePoolParameters: v1alpha3.AKSNodePoolParameters{
+				ResourceGroupName: resourceGroup,
+				AKSClusterName:    clusterName,
+				VMSize:            vmSize,
+				Mode:              to.StringPtr(v1alpha3.NodePoolModeUser),
+				OSType:            to.StringPtr("Linux"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func agentPool(state string, count int32) containerservice.AgentPool {
+	return containerservice.AgentPool{
+		ID: to.StringPtr(id),
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			ProvisioningState:   to.StringPtr(state),
+			OrchestratorVersion: to.StringPtr("1.21.7"),
+			VMSize:              to.StringPtr(vmSize),
+			Count:               to.Int32Ptr(count),
+			Mode:                containerservice.AgentPoolModeUser,
+			OsType:              containerservice.OSTypeLinux,
+		},
+	}
+}
+
+func getFn(ap containerservice.AgentPool, err error) func(context.Context, string, string, string) (containerservice.AgentPool, error) {
+	return func(_ context.Context, rg, cluster, n string) (containerservice.AgentPool, error) {
+		if rg != resourceGroup || cluster != clusterName || n != name {
+			return containerservice.AgentPool{}, errors.Errorf("unexpected agent pool %s/%s/%s", rg, cluster, n)
+		}
+		return ap, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotAKSNodePool": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSNodePool),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(containerservice.AgentPool{}, errNotFound)}},
+			cr: nodePool(),
+			want: want{
+				cr: nodePool(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(containerservice.AgentPool{}, errBoom)}},
+			cr: nodePool(),
+			want: want{
+				cr:  nodePool(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(agentPool(stateSucceeded, 2), nil)}},
+			cr: nodePool(),
+			want: want{
+				cr: nodePool(
+					withCount(2),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.AKSNodePoolStatus{State: stateSucceeded, ProviderID: id, OrchestratorVersion: "1.21.7"}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Scaling": {
+			e:  &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(agentPool("Scaling", 2), nil)}},
+			cr: nodePool(withCount(3)),
+			want: want{
+				cr: nodePool(
+					withCount(3),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha3.AKSNodePoolStatus{State: "Scaling", ProviderID: id, OrchestratorVersion: "1.21.7"}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(agentPool(stateSucceeded, 2), nil)}},
+			cr: nodePool(withCount(3)),
+			want: want{
+				cr: nodePool(
+					withCount(3),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.AKSNodePoolStatus{State: stateSucceeded, ProviderID: id, OrchestratorVersion: "1.21.7"}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotAKSNodePool": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSNodePool),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+					return containerservice.AgentPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr: nodePool(),
+			want: want{
+				cr:  nodePool(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, ap containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+					if to.String(ap.VMSize) != vmSize {
+						t.Errorf("Create(...): unexpected VM size %s", to.String(ap.VMSize))
+					}
+					return containerservice.AgentPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: nodePool(),
+			want: want{
+				cr: nodePool(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotAKSNodePool": {
+			e:    &external{},
+			want: errors.New(errNotAKSNodePool),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockAgentPoolsClient{MockGet: getFn(containerservice.AgentPool{}, errBoom)}},
+			cr:   nodePool(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockGet: getFn(agentPool(stateSucceeded, 2), nil),
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+					return containerservice.AgentPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr:   nodePool(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockGet: getFn(agentPool(stateSucceeded, 2), nil),
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, ap containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+					if to.Int32(ap.Count) != 3 {
+						t.Errorf("Update(...): unexpected count %d", to.Int32(ap.Count))
+					}
+					return containerservice.AgentPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: nodePool(withCount(3)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotAKSNodePool": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSNodePool),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (containerservice.AgentPoolsDeleteFuture, error) {
+					return containerservice.AgentPoolsDeleteFuture{}, errNotFound
+				},
+			}},
+			cr: nodePool(),
+			want: want{
+				cr: nodePool(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAgentPoolsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (containerservice.AgentPoolsDeleteFuture, error) {
+					return containerservice.AgentPoolsDeleteFuture{}, errBoom
+				},
+			}},
+			cr: nodePool(),
+			want: want{
+				cr:  nodePool(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotSnapshot   = "managed resource is not a Snapshot"
+	errConnectFailed = "cannot connect to Azure API"
+	errGetFailed     = "cannot get snapshot"
+	errCreateFailed  = "cannot create snapshot"
+	errUpdateFailed  = "cannot update snapshot"
+	errDeleteFailed  = "cannot delete snapshot"
+)
+
+// Provisioning states of a snapshot.
+const (
+	stateSucceeded = "Succeeded"
+	stateCreating  = "Creating"
+	stateDeleting  = "Deleting"
+)
+
+// Setup adds a controller that reconciles Snapshots.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.SnapshotGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.Snapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.SnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := computemgmt.NewSnapshotsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	_ = cl.AddToUserAgent(azure.UserAgent)
+	d, err := azure.GetResourceDefaults(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	return &external{client: cl, defaults: d}, nil
+}
+
+type external struct {
+	client   compute.SnapshotsClient
+	defaults azure.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshot)
+	}
+	s, err := e.client.Get(ctx, cr.Spec.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(azure.IsNotFound, err), errGetFailed)
+	}
+
+	cr.Status.ProviderID = azure.ToString(s.ID)
+	if s.SnapshotProperties != nil {
+		cr.Status.State = azure.ToString(s.ProvisioningState)
+		cr.Status.UniqueID = azure.ToString(s.UniqueID)
+		cr.Status.DiskSizeGB = azure.ToInt(s.DiskSizeGB)
+	}
+
+	current := cr.Spec.SnapshotParameters.DeepCopy()
+	compute.LateInitializeSnapshot(&cr.Spec.SnapshotParameters, s, e.defaults)
+	li := !cmp.Equal(current, &cr.Spec.SnapshotParameters)
+
+	switch cr.Status.State {
+	case stateSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case stateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case stateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	if !compute.IsUpdatable(cr.Status.State) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: li}, nil
+	}
+
+	upToDate := compute.IsSnapshotUpToDate(cr.Spec.SnapshotParameters, s, e.defaults)
+	if upToDate {
+		li = azure.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.Tags)) || li
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshot)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ResourceGroupName, meta.GetExternalName(cr), compute.NewSnapshot(cr.Spec.SnapshotParameters, e.defaults))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.Snapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshot)
+	}
+	s, err := e.client.Get(ctx, cr.Spec.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+	_, err = e.client.Update(ctx, cr.Spec.ResourceGroupName, meta.GetExternalName(cr), compute.NewSnapshotUpdate(cr.Spec.SnapshotParameters, s, e.defaults))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.Snapshot)
+	if !ok {
+		return errors.New(errNotSnapshot)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, cr.Spec.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteFailed)
+}
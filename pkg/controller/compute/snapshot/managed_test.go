@@ -0,0 +1,376 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name          = "cool-snapshot"
+	resourceGroup = "coolgroup"
+	sku           = "Standard_LRS"
+	id            = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.Compute/snapshots/cool-snapshot"
+	uniqueID      = "cool-snapshot-id"
+	diskID        = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.Compute/disks/cool-disk"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type snapshotModifier func(*v1alpha3.Snapshot)
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(cr *v1alpha3.Snapshot) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSKU(s string) snapshotModifier {
+	return func(cr *v1alpha3.Snapshot) { cr.Spec.SKU = to.StringPtr(s) }
+}
+
+func withIncremental(i bool) snapshotModifier {
+	return func(cr *v1alpha3.Snapshot) { cr.Spec.Incremental = to.BoolPtr(i) }
+}
+
+func withStatus(s v1alpha3.SnapshotStatus) snapshotModifier {
+	return func(cr *v1alpha3.Snapshot) {
+		s.ConditionedStatus = cr.Status.ConditionedStatus
+		cr.Status = s
+	}
+}
+
+func snapshot(m ...snapshotModifier) *v1alpha3.Snapshot {
+	cr := &v1alpha3.Snapshot{
+		Spec: v1alpha3.SnapshotSpec{
+			SnapshotParameters: v1alpha3.SnapshotParameters{
+				ResourceGroupName: resourceGroup,
+				SKU:               to.StringPtr(sku),
+				Source:            v1alpha3.SnapshotSource{DiskID: to.StringPtr(diskID)},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func azureSnapshot(state string, incremental bool) computemgmt.Snapshot {
+	return computemgmt.Snapshot{
+		ID:  to.StringPtr(id),
+		Sku: &computemgmt.SnapshotSku{Name: computemgmt.SnapshotStorageAccountTypes(sku)},
+		SnapshotProperties: &computemgmt.SnapshotProperties{
+			ProvisioningState: to.StringPtr(state),
+			UniqueID:          to.StringPtr(uniqueID),
+			DiskSizeGB:        to.Int32Ptr(64),
+			Incremental:       to.BoolPtr(incremental),
+		},
+	}
+}
+
+func getFn(s computemgmt.Snapshot, err error) func(context.Context, string, string) (computemgmt.Snapshot, error) {
+	return func(_ context.Context, rg, n string) (computemgmt.Snapshot, error) {
+		if rg != resourceGroup || n != name {
+			return computemgmt.Snapshot{}, errors.Errorf("unexpected snapshot %s/%s", rg, n)
+		}
+		return s, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	status := v1alpha3.SnapshotStatus{State: stateSucceeded, ProviderID: id, UniqueID: uniqueID, DiskSizeGB: 64}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotSnapshot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockSnapshotsClient{MockGet: getFn(computemgmt.Snapshot{}, errNotFound)}},
+			cr: snapshot(),
+			want: want{
+				cr: snapshot(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockSnapshotsClient{MockGet: getFn(computemgmt.Snapshot{}, errBoom)}},
+			cr: snapshot(),
+			want: want{
+				cr:  snapshot(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockSnapshotsClient{MockGet: getFn(azureSnapshot(stateSucceeded, true), nil)}},
+			cr: snapshot(),
+			want: want{
+				cr: snapshot(
+					withIncremental(true),
+					withConditions(xpv1.Available()),
+					withStatus(status),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Updating": {
+			e:  &external{client: &fake.MockSnapshotsClient{MockGet: getFn(azureSnapshot("Updating", false), nil)}},
+			cr: snapshot(withSKU("Premium_LRS"), withIncremental(false)),
+			want: want{
+				cr: snapshot(
+					withSKU("Premium_LRS"),
+					withIncremental(false),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha3.SnapshotStatus{State: "Updating", ProviderID: id, UniqueID: uniqueID, DiskSizeGB: 64}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockSnapshotsClient{MockGet: getFn(azureSnapshot(stateSucceeded, false), nil)}},
+			cr: snapshot(withSKU("Premium_LRS"), withIncremental(false)),
+			want: want{
+				cr: snapshot(
+					withSKU("Premium_LRS"),
+					withIncremental(false),
+					withConditions(xpv1.Available()),
+					withStatus(status),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotSnapshot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.Snapshot) (computemgmt.SnapshotsCreateOrUpdateFuture, error) {
+					return computemgmt.SnapshotsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr: snapshot(),
+			want: want{
+				cr:  snapshot(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.Snapshot) (computemgmt.SnapshotsCreateOrUpdateFuture, error) {
+					return computemgmt.SnapshotsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: snapshot(),
+			want: want{
+				cr: snapshot(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotSnapshot": {
+			e:    &external{},
+			want: errors.New(errNotSnapshot),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockSnapshotsClient{MockGet: getFn(computemgmt.Snapshot{}, errBoom)}},
+			cr:   snapshot(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: getFn(azureSnapshot(stateSucceeded, false), nil),
+				MockUpdate: func(_ context.Context, _, _ string, _ computemgmt.SnapshotUpdate) (computemgmt.SnapshotsUpdateFuture, error) {
+					return computemgmt.SnapshotsUpdateFuture{}, errBoom
+				},
+			}},
+			cr:   snapshot(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: getFn(azureSnapshot(stateSucceeded, false), nil),
+				MockUpdate: func(_ context.Context, _, _ string, u computemgmt.SnapshotUpdate) (computemgmt.SnapshotsUpdateFuture, error) {
+					if u.Sku.Name != computemgmt.SnapshotStorageAccountTypesPremiumLRS {
+						t.Errorf("Update(...): unexpected SKU %s", u.Sku.Name)
+					}
+					return computemgmt.SnapshotsUpdateFuture{}, nil
+				},
+			}},
+			cr: snapshot(withSKU("Premium_LRS"), withIncremental(false)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotSnapshot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockDelete: func(_ context.Context, _, _ string) (computemgmt.SnapshotsDeleteFuture, error) {
+					return computemgmt.SnapshotsDeleteFuture{}, errNotFound
+				},
+			}},
+			cr: snapshot(),
+			want: want{
+				cr: snapshot(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockDelete: func(_ context.Context, _, _ string) (computemgmt.SnapshotsDeleteFuture, error) {
+					return computemgmt.SnapshotsDeleteFuture{}, errBoom
+				},
+			}},
+			cr: snapshot(),
+			want: want{
+				cr:  snapshot(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
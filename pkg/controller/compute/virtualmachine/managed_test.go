@@ -0,0 +1,397 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachine
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name          = "cool-vm"
+	resourceGroup = "coolgroup"
+	size          = "Standard_B2s"
+	id            = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.Compute/virtualMachines/cool-vm"
+	vmID          = "cool-vm-id"
+	sshKey        = "ssh-ed25519 AAAA cool@example.org"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type vmModifier func(*v1alpha3.VirtualMachine)
+
+func withConditions(c ...xpv1.Condition) vmModifier {
+	return func(cr *v1alpha3.VirtualMachine) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSize(s string) vmModifier {
+	return func(cr *v1alpha3.VirtualMachine) { cr.Spec.Size = s }
+}
+
+func withZones(z ...string) vmModifier {
+	return func(cr *v1alpha3.VirtualMachine) { cr.Spec.Zones = z }
+}
+
+func withStatus(s v1alpha3.VirtualMachineStatus) vmModifier {
+	return func(cr *v1alpha3.VirtualMachine) {
+		s.ConditionedStatus = cr.Status.ConditionedStatus
+		cr.Status = s
+	}
+}
+
+func virtualMachine(m ...vmModifier) *v1alpha3.VirtualMachine {
+	cr := &v1alpha3.VirtualMachine{
+		Spec: v1alpha3.VirtualMachineSpec{
+			VirtualMachineParameters: v1alpha3.VirtualMachineParameters{
+				ResourceGroupName: resourceGroup,
+				Size:              size,
+				AdminUsername:     "cool",
+				SSHPublicKeys: []v1alpha3.SSHPublicKey{{SecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+					Key:             "id_ed25519.pub",
+				}}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func vm(state string, zones ...string) computemgmt.VirtualMachine {
+	v := computemgmt.VirtualMachine{
+		ID: to.StringPtr(id),
+		VirtualMachineProperties: &computemgmt.VirtualMachineProperties{
+			ProvisioningState: to.StringPtr(state),
+			VMID:              to.StringPtr(vmID),
+			HardwareProfile:   &computemgmt.HardwareProfile{VMSize: size},
+		},
+	}
+	if len(zones) > 0 {
+		v.Zones = &zones
+	}
+	return v
+}
+
+func getFn(v computemgmt.VirtualMachine, err error) func(context.Context, string, string, computemgmt.InstanceViewTypes) (computemgmt.VirtualMachine, error) {
+	return func(_ context.Context, rg, n string, _ computemgmt.InstanceViewTypes) (computemgmt.VirtualMachine, error) {
+		if rg != resourceGroup || n != name {
+			return computemgmt.VirtualMachine{}, errors.Errorf("unexpected virtual machine %s/%s", rg, n)
+		}
+		return v, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotVirtualMachine": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotVirtualMachine),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(computemgmt.VirtualMachine{}, errNotFound)}},
+			cr: virtualMachine(),
+			want: want{
+				cr: virtualMachine(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(computemgmt.VirtualMachine{}, errBoom)}},
+			cr: virtualMachine(),
+			want: want{
+				cr:  virtualMachine(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(vm(stateSucceeded, "1"), nil)}},
+			cr: virtualMachine(),
+			want: want{
+				cr: virtualMachine(
+					withZones("1"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.VirtualMachineStatus{State: stateSucceeded, ProviderID: id, VMID: vmID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Updating": {
+			e:  &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(vm("Updating"), nil)}},
+			cr: virtualMachine(withSize("Standard_D4s_v3")),
+			want: want{
+				cr: virtualMachine(
+					withSize("Standard_D4s_v3"),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha3.VirtualMachineStatus{State: "Updating", ProviderID: id, VMID: vmID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(vm(stateSucceeded), nil)}},
+			cr: virtualMachine(withSize("Standard_D4s_v3")),
+			want: want{
+				cr: virtualMachine(
+					withSize("Standard_D4s_v3"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.VirtualMachineStatus{State: stateSucceeded, ProviderID: id, VMID: vmID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	secret := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"id_ed25519.pub": []byte(sshKey)}
+		return nil
+	})}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotVirtualMachine": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotVirtualMachine),
+			},
+		},
+		"SecretError": {
+			e:  &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			cr: virtualMachine(),
+			want: want{
+				cr:  virtualMachine(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), "cannot get SSH public key"), errCreateFailed),
+			},
+		},
+		"CreateError": {
+			e: &external{kube: secret, client: &fake.MockVirtualMachinesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.VirtualMachine) (computemgmt.VirtualMachinesCreateOrUpdateFuture, error) {
+					return computemgmt.VirtualMachinesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr: virtualMachine(),
+			want: want{
+				cr:  virtualMachine(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{kube: secret, client: &fake.MockVirtualMachinesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, v computemgmt.VirtualMachine) (computemgmt.VirtualMachinesCreateOrUpdateFuture, error) {
+					keys := *v.OsProfile.LinuxConfiguration.SSH.PublicKeys
+					if to.String(keys[0].KeyData) != sshKey {
+						t.Errorf("Create(...): unexpected SSH public key %s", to.String(keys[0].KeyData))
+					}
+					return computemgmt.VirtualMachinesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: virtualMachine(),
+			want: want{
+				cr: virtualMachine(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotVirtualMachine": {
+			e:    &external{},
+			want: errors.New(errNotVirtualMachine),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockVirtualMachinesClient{MockGet: getFn(computemgmt.VirtualMachine{}, errBoom)}},
+			cr:   virtualMachine(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockVirtualMachinesClient{
+				MockGet: getFn(vm(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, _ computemgmt.VirtualMachineUpdate) (computemgmt.VirtualMachinesUpdateFuture, error) {
+					return computemgmt.VirtualMachinesUpdateFuture{}, errBoom
+				},
+			}},
+			cr:   virtualMachine(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockVirtualMachinesClient{
+				MockGet: getFn(vm(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, u computemgmt.VirtualMachineUpdate) (computemgmt.VirtualMachinesUpdateFuture, error) {
+					if u.HardwareProfile.VMSize != "Standard_D4s_v3" {
+						t.Errorf("Update(...): unexpected size %s", u.HardwareProfile.VMSize)
+					}
+					return computemgmt.VirtualMachinesUpdateFuture{}, nil
+				},
+			}},
+			cr: virtualMachine(withSize("Standard_D4s_v3")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotVirtualMachine": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotVirtualMachine),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualMachinesClient{
+				MockDelete: func(_ context.Context, _, _ string, _ *bool) (computemgmt.VirtualMachinesDeleteFuture, error) {
+					return computemgmt.VirtualMachinesDeleteFuture{}, errNotFound
+				},
+			}},
+			cr: virtualMachine(),
+			want: want{
+				cr: virtualMachine(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockVirtualMachinesClient{
+				MockDelete: func(_ context.Context, _, _ string, _ *bool) (computemgmt.VirtualMachinesDeleteFuture, error) {
+					return computemgmt.VirtualMachinesDeleteFuture{}, errBoom
+				},
+			}},
+			cr: virtualMachine(),
+			want: want{
+				cr:  virtualMachine(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachinescaleset
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computemgmt "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name          = "cool-vmss"
+	resourceGroup = "coolgroup"
+	size          = "Standard_B2s"
+	id            = "/subscriptions/sub/resourceGroups/coolgroup/providers/Microsoft.Compute/virtualMachineScaleSets/cool-vmss"
+	uniqueID      = "cool-vmss-id"
+	sshKey        = "ssh-ed25519 AAAA cool@example.org"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type ssModifier func(*v1alpha3.VirtualMachineScaleSet)
+
+func withConditions(c ...xpv1.Condition) ssModifier {
+	return func(cr *v1alpha3.VirtualMachineScaleSet) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSize(s string) ssModifier {
+	return func(cr *v1alpha3.VirtualMachineScaleSet) { cr.Spec.Size = s }
+}
+
+func withZones(z ...string) ssModifier {
+	return func(cr *v1alpha3.VirtualMachineScaleSet) { cr.Spec.Zones = z }
+}
+
+func withStatus(s v1alpha3.VirtualMachineScaleSetStatus) ssModifier {
+	return func(cr *v1alpha3.VirtualMachineScaleSet) {
+		s.ConditionedStatus = cr.Status.ConditionedStatus
+		cr.Status = s
+	}
+}
+
+func scaleSet(m ...ssModifier) *v1alpha3.VirtualMachineScaleSet {
+	cr := &v1alpha3.VirtualMachineScaleSet{
+		Spec: v1alpha3.VirtualMachineScaleSetSpec{
+			VirtualMachineScaleSetParameters: v1alpha3.VirtualMachineScaleSetParameters{
+				ResourceGroupName: resourceGroup,
+				Size:              size,
+				Capacity:          to.IntPtr(1),
+				UpgradeMode:       to.StringPtr(v1alpha3.UpgradeModeManual),
+				AdminUsername:     "cool",
+				SSHPublicKeys: []v1alpha3.SSHPublicKey{{SecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+					Key:             "id_ed25519.pub",
+				}}},
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func ss(state string, zones ...string) computemgmt.VirtualMachineScaleSet {
+	s := computemgmt.VirtualMachineScaleSet{
+		ID: to.StringPtr(id),
+		VirtualMachineScaleSetProperties: &computemgmt.VirtualMachineScaleSetProperties{
+			ProvisioningState: to.StringPtr(state),
+			UniqueID:          to.StringPtr(uniqueID),
+			UpgradePolicy:     &computemgmt.UpgradePolicy{Mode: computemgmt.UpgradeModeManual},
+		},
+		Sku: &computemgmt.Sku{Name: to.StringPtr(size), Capacity: to.Int64Ptr(1)},
+	}
+	if len(zones) > 0 {
+		s.Zones = &zones
+	}
+	return s
+}
+
+func getFn(v computemgmt.VirtualMachineScaleSet, err error) func(context.Context, string, string, computemgmt.ExpandTypesForGetVMScaleSets) (computemgmt.VirtualMachineScaleSet, error) {
+	return func(_ context.Context, rg, n string, _ computemgmt.ExpandTypesForGetVMScaleSets) (computemgmt.VirtualMachineScaleSet, error) {
+		if rg != resourceGroup || n != name {
+			return computemgmt.VirtualMachineScaleSet{}, errors.Errorf("unexpected virtual machine scale set %s/%s", rg, n)
+		}
+		return v, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotScaleSet": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotScaleSet),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(computemgmt.VirtualMachineScaleSet{}, errNotFound)}},
+			cr: scaleSet(),
+			want: want{
+				cr: scaleSet(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(computemgmt.VirtualMachineScaleSet{}, errBoom)}},
+			cr: scaleSet(),
+			want: want{
+				cr:  scaleSet(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(ss(stateSucceeded, "1"), nil)}},
+			cr: scaleSet(),
+			want: want{
+				cr: scaleSet(
+					withZones("1"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.VirtualMachineScaleSetStatus{State: stateSucceeded, ProviderID: id, UniqueID: uniqueID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Updating": {
+			e:  &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(ss("Updating"), nil)}},
+			cr: scaleSet(withSize("Standard_D4s_v3")),
+			want: want{
+				cr: scaleSet(
+					withSize("Standard_D4s_v3"),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha3.VirtualMachineScaleSetStatus{State: "Updating", ProviderID: id, UniqueID: uniqueID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(ss(stateSucceeded), nil)}},
+			cr: scaleSet(withSize("Standard_D4s_v3")),
+			want: want{
+				cr: scaleSet(
+					withSize("Standard_D4s_v3"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha3.VirtualMachineScaleSetStatus{State: stateSucceeded, ProviderID: id, UniqueID: uniqueID}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	secret := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"id_ed25519.pub": []byte(sshKey)}
+		return nil
+	})}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotScaleSet": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotScaleSet),
+			},
+		},
+		"SecretError": {
+			e:  &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			cr: scaleSet(),
+			want: want{
+				cr:  scaleSet(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), "cannot get SSH public key"), errCreateFailed),
+			},
+		},
+		"CreateError": {
+			e: &external{kube: secret, client: &fake.MockVirtualMachineScaleSetsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computemgmt.VirtualMachineScaleSet) (computemgmt.VirtualMachineScaleSetsCreateOrUpdateFuture, error) {
+					return computemgmt.VirtualMachineScaleSetsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			cr: scaleSet(),
+			want: want{
+				cr:  scaleSet(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{kube: secret, client: &fake.MockVirtualMachineScaleSetsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, s computemgmt.VirtualMachineScaleSet) (computemgmt.VirtualMachineScaleSetsCreateOrUpdateFuture, error) {
+					keys := *s.VirtualMachineProfile.OsProfile.LinuxConfiguration.SSH.PublicKeys
+					if to.String(keys[0].KeyData) != sshKey {
+						t.Errorf("Create(...): unexpected SSH public key %s", to.String(keys[0].KeyData))
+					}
+					return computemgmt.VirtualMachineScaleSetsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			cr: scaleSet(),
+			want: want{
+				cr: scaleSet(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotScaleSet": {
+			e:    &external{},
+			want: errors.New(errNotScaleSet),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockVirtualMachineScaleSetsClient{MockGet: getFn(computemgmt.VirtualMachineScaleSet{}, errBoom)}},
+			cr:   scaleSet(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockVirtualMachineScaleSetsClient{
+				MockGet: getFn(ss(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, _ computemgmt.VirtualMachineScaleSetUpdate) (computemgmt.VirtualMachineScaleSetsUpdateFuture, error) {
+					return computemgmt.VirtualMachineScaleSetsUpdateFuture{}, errBoom
+				},
+			}},
+			cr:   scaleSet(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockVirtualMachineScaleSetsClient{
+				MockGet: getFn(ss(stateSucceeded), nil),
+				MockUpdate: func(_ context.Context, _, _ string, u computemgmt.VirtualMachineScaleSetUpdate) (computemgmt.VirtualMachineScaleSetsUpdateFuture, error) {
+					if to.String(u.Sku.Name) != "Standard_D4s_v3" {
+						t.Errorf("Update(...): unexpected size %s", to.String(u.Sku.Name))
+					}
+					return computemgmt.VirtualMachineScaleSetsUpdateFuture{}, nil
+				},
+			}},
+			cr: scaleSet(withSize("Standard_D4s_v3")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotScaleSet": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotScaleSet),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualMachineScaleSetsClient{
+				MockDelete: func(_ context.Context, _, _ string, _ *bool) (computemgmt.VirtualMachineScaleSetsDeleteFuture, error) {
+					return computemgmt.VirtualMachineScaleSetsDeleteFuture{}, errNotFound
+				},
+			}},
+			cr: scaleSet(),
+			want: want{
+				cr: scaleSet(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockVirtualMachineScaleSetsClient{
+				MockDelete: func(_ context.Context, _, _ string, _ *bool) (computemgmt.VirtualMachineScaleSetsDeleteFuture, error) {
+					return computemgmt.VirtualMachineScaleSetsDeleteFuture{}, errBoom
+				},
+			}},
+			cr: scaleSet(),
+			want: want{
+				cr:  scaleSet(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package config
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -29,8 +31,12 @@ import (
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage, and one that validates their credentials.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if err := SetupCredentials(mgr, o); err != nil {
+		return err
+	}
+
 	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
 
 	of := resource.ProviderConfigKinds{
@@ -47,3 +53,25 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
 			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
+
+// SetupCredentials adds a controller that reconciles ProviderConfigs by
+// validating their credentials.
+func SetupCredentials(mgr ctrl.Manager, o controller.Options) error {
+	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind) + "/credentials"
+
+	r := &CredentialsReconciler{
+		kube:      mgr.GetClient(),
+		validator: &ARMValidator{kube: mgr.GetClient()},
+		poll:      o.PollInterval,
+		log:       o.Logger.WithValues("controller", name),
+		record:    event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	// Only spec changes trigger validation. The reconciler polls to notice
+	// credentials that expire or are revoked.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	validationTimeout = 2 * time.Minute
+
+	errGetPC           = "cannot get ProviderConfig"
+	errUpdateStatus    = "cannot update ProviderConfig status"
+	errGetToken        = "cannot acquire Azure AD token"
+	errListGroups      = "cannot list resource groups"
+	errFmtCodedMessage = "%s: %s"
+)
+
+// Condition reasons.
+const (
+	ReasonCredentialsValid   xpv1.ConditionReason = "CredentialsValid"
+	ReasonCredentialsInvalid xpv1.ConditionReason = "CredentialsInvalid"
+)
+
+// Event reasons.
+const (
+	reasonValidate event.Reason = "ValidateCredentials"
+)
+
+// CredentialsValid indicates that the credentials of a ProviderConfig could
+// be used to access Azure Resource Manager.
+func CredentialsValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsValid,
+	}
+}
+
+// CredentialsInvalid indicates that the credentials of a ProviderConfig could
+// not be used to access Azure Resource Manager. The Azure AD or Azure Resource
+// Manager error code is included in the message when known.
+func CredentialsInvalid(err error) xpv1.Condition {
+	msg := err.Error()
+	if code := azure.ErrorCode(err); code != "" {
+		msg = fmt.Sprintf(errFmtCodedMessage, code, msg)
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsInvalid,
+		Message:            msg,
+	}
+}
+
+// A Validation is the result of validating the credentials of a
+// ProviderConfig.
+type Validation struct {
+	TenantID       string
+	SubscriptionID string
+	TokenExpiry    time.Time
+}
+
+// A CredentialsValidator validates the credentials of a ProviderConfig.
+type CredentialsValidator interface {
+	Validate(ctx context.Context, pc *v1beta1.ProviderConfig) (Validation, error)
+}
+
+// A CredentialsValidatorFn is a function that satisfies the
+// CredentialsValidator interface.
+type CredentialsValidatorFn func(ctx context.Context, pc *v1beta1.ProviderConfig) (Validation, error)
+
+// Validate the credentials of the supplied ProviderConfig.
+func (fn CredentialsValidatorFn) Validate(ctx context.Context, pc *v1beta1.ProviderConfig) (Validation, error) {
+	return fn(ctx, pc)
+}
+
+// An ARMValidator validates credentials by acquiring an Azure AD token for
+// Azure Resource Manager and using it to list the resource groups of the
+// ProviderConfig's subscription. Tokens are shared with managed resource
+// controllers by the default credentials cache.
+type ARMValidator struct {
+	kube client.Client
+}
+
+// Validate the credentials of the supplied ProviderConfig.
+func (v *ARMValidator) Validate(ctx context.Context, pc *v1beta1.ProviderConfig) (Validation, error) {
+	cc, err := azure.DefaultCredentialsCache.ProviderConfig(ctx, v.kube, pc)
+	if err != nil {
+		return Validation{}, err
+	}
+	creds := cc.Credentials()
+	val := Validation{TenantID: creds[azure.CredentialsKeyTenantID], SubscriptionID: creds[azure.CredentialsKeySubscriptionID]}
+
+	t, err := cc.Token(creds[azure.CredentialsKeyResourceManagerEndpointURL])
+	if err != nil {
+		return val, errors.Wrap(err, errGetToken)
+	}
+	if err := t.EnsureFreshWithContext(ctx); err != nil {
+		return val, errors.Wrap(err, errGetToken)
+	}
+	tk := t.Token()
+	val.TokenExpiry = tk.Expires()
+	// Managed identities do not know their tenant until they are issued a
+	// token.
+	if tid := azure.TokenTenantID(tk.AccessToken); tid != "" {
+		val.TenantID = tid
+	}
+
+	c := &azure.Client{
+		Authorizer: autorest.NewBearerAuthorizer(t),
+		Credentials: azure.Credentials{
+			SubscriptionID:             val.SubscriptionID,
+			ResourceManagerEndpointURL: creds[azure.CredentialsKeyResourceManagerEndpointURL],
+		},
+	}
+	return val, errors.Wrap(azure.ValidateClient(ctx, c), errListGroups)
+}
+
+// A CredentialsReconciler validates the credentials of ProviderConfigs, and
+// reports the result in their status.
+type CredentialsReconciler struct {
+	kube      client.Client
+	validator CredentialsValidator
+	poll      time.Duration
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// Reconcile a ProviderConfig by validating its credentials.
+func (r *CredentialsReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, validationTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		log.Debug(errGetPC, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	val, err := r.validator.Validate(ctx, pc)
+	pc.Status.TenantID = val.TenantID
+	pc.Status.SubscriptionID = val.SubscriptionID
+	pc.Status.TokenExpiry = nil
+	if !val.TokenExpiry.IsZero() {
+		pc.Status.TokenExpiry = &metav1.Time{Time: val.TokenExpiry}
+	}
+	if err != nil {
+		log.Debug("Invalid credentials", "error", err)
+		r.record.Event(pc, event.Warning(reasonValidate, err))
+		pc.SetConditions(CredentialsInvalid(err))
+	} else {
+		pc.SetConditions(CredentialsValid())
+	}
+
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateStatus)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+const (
+	testName         = "cool-config"
+	testTenant       = "cool-tenant"
+	testSubscription = "cool-subscription"
+	testPoll         = time.Minute
+)
+
+var (
+	errBoom    = errors.New("boom")
+	testExpiry = time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC)
+)
+
+type pcModifier func(*v1beta1.ProviderConfig)
+
+func withConditionsFrom(err error) pcModifier {
+	return func(pc *v1beta1.ProviderConfig) {
+		if err != nil {
+			pc.SetConditions(CredentialsInvalid(err))
+			return
+		}
+		pc.SetConditions(CredentialsValid())
+	}
+}
+
+func withValidation(v Validation) pcModifier {
+	return func(pc *v1beta1.ProviderConfig) {
+		pc.Status.TenantID = v.TenantID
+		pc.Status.SubscriptionID = v.SubscriptionID
+		if !v.TokenExpiry.IsZero() {
+			pc.Status.TokenExpiry = &metav1.Time{Time: v.TokenExpiry}
+		}
+	}
+}
+
+func providerConfig(m ...pcModifier) *v1beta1.ProviderConfig {
+	pc := &v1beta1.ProviderConfig{}
+	for _, f := range m {
+		f(pc)
+	}
+	return pc
+}
+
+func TestCredentialsReconcile(t *testing.T) {
+	now := metav1.Now()
+	valid := Validation{TenantID: testTenant, SubscriptionID: testSubscription, TokenExpiry: testExpiry}
+	aadErr := errors.New("adal: Refresh request failed. Status Code = '401'. Response body: {\"error\":\"invalid_client\",\"error_description\":\"AADSTS7000215: Invalid client secret provided.\"}")
+
+	type args struct {
+		kube      client.Client
+		validator CredentialsValidator
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+		pc     *v1beta1.ProviderConfig
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetPC)},
+		},
+		"Deleted": {
+			reason: "Deleted ProviderConfigs should not be validated.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetDeletionTimestamp(&now)
+					return nil
+				})},
+			},
+			want: want{},
+		},
+		"Valid": {
+			reason: "Valid credentials should mark the ProviderConfig ready and report what they resolved to.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
+				validator: CredentialsValidatorFn(func(_ context.Context, _ *v1beta1.ProviderConfig) (Validation, error) {
+					return valid, nil
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: testPoll},
+				pc:     providerConfig(withValidation(valid), withConditionsFrom(nil)),
+			},
+		},
+		"Invalid": {
+			reason: "Invalid credentials should mark the ProviderConfig not ready, including the Azure AD error code.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
+				validator: CredentialsValidatorFn(func(_ context.Context, _ *v1beta1.ProviderConfig) (Validation, error) {
+					return Validation{TenantID: testTenant, SubscriptionID: testSubscription}, aadErr
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: testPoll},
+				pc:     providerConfig(withValidation(Validation{TenantID: testTenant, SubscriptionID: testSubscription}), withConditionsFrom(aadErr)),
+			},
+		},
+		"StatusUpdateError": {
+			reason: "Errors updating the ProviderConfig's status should be returned.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				validator: CredentialsValidatorFn(func(_ context.Context, _ *v1beta1.ProviderConfig) (Validation, error) {
+					return valid, nil
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: testPoll},
+				err:    errors.Wrap(errBoom, errUpdateStatus),
+				pc:     providerConfig(withValidation(valid), withConditionsFrom(nil)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *v1beta1.ProviderConfig
+			if mc, ok := tc.args.kube.(*test.MockClient); ok && mc.MockStatusUpdate != nil {
+				fn := mc.MockStatusUpdate
+				mc.MockStatusUpdate = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					got = obj.(*v1beta1.ProviderConfig).DeepCopy()
+					return fn(ctx, obj, opts...)
+				}
+			}
+
+			r := &CredentialsReconciler{
+				kube:      tc.args.kube,
+				validator: tc.args.validator,
+				poll:      testPoll,
+				log:       logging.NewNopLogger(),
+				record:    event.NewNopRecorder(),
+			}
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: testName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.pc, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want ProviderConfig, +got ProviderConfig:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialsInvalid(t *testing.T) {
+	err := errors.New("adal: Refresh request failed. Status Code = '400'. Response body: {\"error_description\":\"AADSTS700016: Application not found.\"}")
+	got := CredentialsInvalid(err)
+	want := "AADSTS700016: " + err.Error()
+	if diff := cmp.Diff(want, got.Message); diff != "" {
+		t.Errorf("CredentialsInvalid(...): -want message, +got message:\n%s", diff)
+	}
+}
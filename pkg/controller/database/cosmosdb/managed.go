@@ -81,15 +81,20 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := documentdb.NewDatabaseAccountsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := documentdb.NewDatabaseAccountsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{kube: c.kube, client: cl}, nil
+	d, err := azure.GetResourceDefaults(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: cl, defaults: d}, nil
 }
 
 // external is a createsyncdeleter using the Azure API.
 type external struct {
-	kube   client.Client
-	client cosmosdb.AccountClient
+	kube     client.Client
+	client   cosmosdb.AccountClient
+	defaults azure.ResourceDefaults
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -118,8 +123,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		r.SetConditions(xpv1.Unavailable())
 	}
-	resourceUpToDate := cosmosdb.CheckEqualDatabaseProperties(r.Spec.ForProvider.Properties, account)
-	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: resourceUpToDate}, nil
+	tags := e.defaults.MergeTags(r.Spec.ForProvider.Tags)
+	resourceUpToDate := cosmosdb.CheckEqualDatabaseProperties(r.Spec.ForProvider.Properties, account) &&
+		e.defaults.TagsUpToDate(tags, account.Tags)
+	li := resourceUpToDate && azure.SetManagedTags(r, tags)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: resourceUpToDate, ResourceLateInitialized: li}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -132,7 +140,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	_, err := e.client.CreateOrUpdate(ctx,
 		r.Spec.ForProvider.ResourceGroupName,
 		meta.GetExternalName(r),
-		cosmosdb.ToDatabaseAccountCreateOrUpdate(&r.Spec))
+		cosmosdb.ToDatabaseAccountCreateOrUpdate(&r.Spec, e.defaults))
 	// TODO(artursouza): handle secrets.
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNoSQLAccount)
 }
@@ -85,14 +85,19 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := mysql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := mysql.NewServersClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{kube: c.client, client: database.NewMySQLServerClient(cl), newPasswordFn: password.Generate}, nil
+	d, err := azure.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.client, client: database.NewMySQLServerClient(cl, d), defaults: d, newPasswordFn: password.Generate}, nil
 }
 
 type external struct {
 	kube          client.Client
 	client        database.MySQLServerAPI
+	defaults      azure.ResourceDefaults
 	newPasswordFn func() (password string, err error)
 }
 
@@ -118,7 +123,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLServer)
 	}
-	database.LateInitializeMySQL(&cr.Spec.ForProvider, server)
+	database.LateInitializeMySQL(&cr.Spec.ForProvider, server, e.defaults)
+	upToDate := database.IsMySQLUpToDate(cr.Spec.ForProvider, server, e.defaults)
+	if upToDate {
+		azure.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags))
+	}
 	if err := e.kube.Update(ctx, cr); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
 	}
@@ -139,7 +148,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: database.IsMySQLUpToDate(cr.Spec.ForProvider, server),
+		ResourceUpToDate: upToDate,
 		ConnectionDetails: managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
 			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr))),
@@ -86,12 +86,12 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := mysql.NewConfigurationsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := mysql.NewConfigurationsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
 	return &external{
 		kube:           c.client,
 		client:         configuration.NewMySQLConfigurationClient(cl),
-		subscriptionID: creds[azure.CredentialsKeySubscriptionID],
+		subscriptionID: azure.SubscriptionID(mg, creds),
 	}, nil
 }
 
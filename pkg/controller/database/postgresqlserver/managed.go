@@ -88,14 +88,19 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := postgresql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := postgresql.NewServersClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{kube: c.client, client: database.NewPostgreSQLServerClient(cl), newPasswordFn: password.Generate}, nil
+	d, err := azure.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.client, client: database.NewPostgreSQLServerClient(cl, d), defaults: d, newPasswordFn: password.Generate}, nil
 }
 
 type external struct {
 	kube          client.Client
 	client        database.PostgreSQLServerAPI
+	defaults      azure.ResourceDefaults
 	newPasswordFn func() (password string, err error)
 }
 
@@ -120,7 +125,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetPostgreSQLServer)
 	}
-	database.LateInitializePostgreSQL(&cr.Spec.ForProvider, server)
+	database.LateInitializePostgreSQL(&cr.Spec.ForProvider, server, e.defaults)
+	upToDate := database.IsPostgreSQLUpToDate(cr.Spec.ForProvider, server, e.defaults)
+	if upToDate {
+		azure.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags))
+	}
 	if err := e.kube.Update(ctx, cr); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
 	}
@@ -142,7 +151,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	o := managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: database.IsPostgreSQLUpToDate(cr.Spec.ForProvider, server), // NOTE(negz): We don't yet support updating Azure SQL servers.
+		ResourceUpToDate: upToDate, // NOTE(negz): We don't yet support updating Azure SQL servers.
 		ConnectionDetails: managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
 			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr))),
@@ -85,12 +85,12 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := postgresql.NewConfigurationsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := postgresql.NewConfigurationsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
 	return &external{
 		kube:           c.client,
 		client:         configuration.NewPostgreSQLConfigurationClient(cl),
-		subscriptionID: creds[azure.CredentialsKeySubscriptionID],
+		subscriptionID: azure.SubscriptionID(mg, creds),
 	}, nil
 }
 
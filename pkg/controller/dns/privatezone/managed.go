@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatezone
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/dns"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotPrivateZone    = "managed resource is not a Private DNS Zone"
+	errCreatePrivateZone = "cannot create Private DNS Zone"
+	errUpdatePrivateZone = "cannot update Private DNS Zone"
+	errGetPrivateZone    = "cannot get Private DNS Zone"
+	errDeletePrivateZone = "cannot delete Private DNS Zone"
+)
+
+// Setup adds a controller that reconciles Private DNS Zones.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(dnsv1alpha1.PrivateZoneGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&dnsv1alpha1.PrivateZone{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(dnsv1alpha1.PrivateZoneGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := privatedns.NewPrivateZonesClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		client:   dns.NewPrivateZoneClient(cl, d),
+		defaults: d,
+	}, nil
+}
+
+type external struct {
+	client   dns.PrivateZoneAPI
+	defaults azureclients.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	z, ok := mg.(*dnsv1alpha1.PrivateZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPrivateZone)
+	}
+
+	az, err := e.client.Get(ctx, z)
+	if azureclients.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPrivateZone)
+	}
+
+	dns.UpdatePrivateZoneStatusFromAzure(z, az)
+
+	if z.Status.AtProvider.ProvisioningState != string(privatedns.Succeeded) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	z.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dns.PrivateZoneIsUpToDate(z, az, e.defaults),
+	}
+	if o.ResourceUpToDate {
+		o.ResourceLateInitialized = azureclients.SetManagedTags(z, e.defaults.MergeTags(azureclients.ToStringMap(z.Spec.ForProvider.Tags)))
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	z, ok := mg.(*dnsv1alpha1.PrivateZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPrivateZone)
+	}
+
+	z.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, errors.Wrap(e.client.CreateOrUpdate(ctx, z), errCreatePrivateZone)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	z, ok := mg.(*dnsv1alpha1.PrivateZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPrivateZone)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.client.CreateOrUpdate(ctx, z), errUpdatePrivateZone)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	z, ok := mg.(*dnsv1alpha1.PrivateZone)
+	if !ok {
+		return errors.New(errNotPrivateZone)
+	}
+
+	z.SetConditions(xpv1.Deleting())
+	if z.Status.AtProvider.ProvisioningState == string(privatedns.Deleting) {
+		return nil
+	}
+
+	err := e.client.Delete(ctx, z)
+
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePrivateZone)
+}
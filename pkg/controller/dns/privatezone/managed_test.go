@@ -0,0 +1,479 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatezone
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+type MockPrivateZoneAPI struct {
+	MockGet            func(ctx context.Context, z *v1alpha1.PrivateZone) (privatedns.PrivateZone, error)
+	MockCreateOrUpdate func(ctx context.Context, z *v1alpha1.PrivateZone) error
+	MockDelete         func(ctx context.Context, z *v1alpha1.PrivateZone) error
+}
+
+func (m *MockPrivateZoneAPI) Get(ctx context.Context, z *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+	return m.MockGet(ctx, z)
+}
+
+func (m *MockPrivateZoneAPI) CreateOrUpdate(ctx context.Context, z *v1alpha1.PrivateZone) error {
+	return m.MockCreateOrUpdate(ctx, z)
+}
+
+func (m *MockPrivateZoneAPI) Delete(ctx context.Context, z *v1alpha1.PrivateZone) error {
+	return m.MockDelete(ctx, z)
+}
+
+type modifier func(*v1alpha1.PrivateZone)
+
+func withExternalName(name string) modifier {
+	return func(p *v1alpha1.PrivateZone) {
+		meta.SetExternalName(p, name)
+	}
+}
+
+func withTags(tags map[string]string) modifier {
+	return func(p *v1alpha1.PrivateZone) {
+		p.Spec.ForProvider.Tags = azure.ToStringPtrMap(tags)
+	}
+}
+
+func withManagedTags(keys string) modifier {
+	return func(p *v1alpha1.PrivateZone) {
+		meta.AddAnnotations(p, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(p *v1alpha1.PrivateZone) {
+		p.Status.SetConditions(c...)
+	}
+}
+
+func withAtProvider(o v1alpha1.PrivateZoneObservation) modifier {
+	return func(p *v1alpha1.PrivateZone) {
+		p.Status.AtProvider = o
+	}
+}
+
+func privateZone(m ...modifier) *v1alpha1.PrivateZone {
+	p := &v1alpha1.PrivateZone{}
+
+	for _, mod := range m {
+		mod(p)
+	}
+	return p
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	name := "privatelink.blob.core.windows.net"
+	id := "a-very-cool-id"
+	tags := map[string]string{"one": "test"}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPrivateZone": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPrivateZone),
+			},
+		},
+		"ErrGetPrivateZone": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockGet: func(_ context.Context, _ *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+						return privatedns.PrivateZone{}, errBoom
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				mg:  privateZone(),
+				err: errors.Wrap(errBoom, errGetPrivateZone),
+			},
+		},
+		"PrivateZoneNotFound": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockGet: func(_ context.Context, _ *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+						return privatedns.PrivateZone{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				mg: privateZone(),
+				eo: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"PrivateZoneCreating": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockGet: func(_ context.Context, _ *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+						return privatedns.PrivateZone{
+							ID: azure.ToStringPtr(id),
+							PrivateZoneProperties: &privatedns.PrivateZoneProperties{
+								ProvisioningState: privatedns.Creating,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(withExternalName(name)),
+			},
+			want: want{
+				mg: privateZone(
+					withExternalName(name),
+					withAtProvider(v1alpha1.PrivateZoneObservation{ID: id, ProvisioningState: string(privatedns.Creating)}),
+				),
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PrivateZoneAvailable": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockGet: func(_ context.Context, _ *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+						return privatedns.PrivateZone{
+							ID:   azure.ToStringPtr(id),
+							Tags: azure.ToStringPtrMap(tags),
+							PrivateZoneProperties: &privatedns.PrivateZoneProperties{
+								ProvisioningState: privatedns.Succeeded,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(withExternalName(name), withTags(tags)),
+			},
+			want: want{
+				mg: privateZone(
+					withExternalName(name),
+					withTags(tags),
+					withManagedTags(`["one"]`),
+					withConditions(xpv1.Available()),
+					withAtProvider(v1alpha1.PrivateZoneObservation{ID: id, ProvisioningState: string(privatedns.Succeeded)}),
+				),
+				eo: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PrivateZoneTagsChanged": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockGet: func(_ context.Context, _ *v1alpha1.PrivateZone) (privatedns.PrivateZone, error) {
+						return privatedns.PrivateZone{
+							ID: azure.ToStringPtr(id),
+							PrivateZoneProperties: &privatedns.PrivateZoneProperties{
+								ProvisioningState: privatedns.Succeeded,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(withExternalName(name), withTags(tags)),
+			},
+			want: want{
+				mg: privateZone(
+					withExternalName(name),
+					withTags(tags),
+					withConditions(xpv1.Available()),
+					withAtProvider(v1alpha1.PrivateZoneObservation{ID: id, ProvisioningState: string(privatedns.Succeeded)}),
+				),
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		ec  managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPrivateZone": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPrivateZone),
+			},
+		},
+		"ErrCreatePrivateZone": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.PrivateZone) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreatePrivateZone),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.PrivateZone) error { return nil },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := tc.e.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPrivateZone": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPrivateZone),
+			},
+		},
+		"ErrUpdatePrivateZone": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.PrivateZone) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdatePrivateZone),
+			},
+		},
+		"Successful": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.PrivateZone) error { return nil },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want error
+	}{
+		"ErrNotAPrivateZone": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: errors.New(errNotPrivateZone),
+		},
+		"ErrDeletePrivateZone": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockDelete: func(_ context.Context, _ *v1alpha1.PrivateZone) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: errors.Wrap(errBoom, errDeletePrivateZone),
+		},
+		"AlreadyDeleting": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(withAtProvider(v1alpha1.PrivateZoneObservation{ProvisioningState: string(privatedns.Deleting)})),
+			},
+			want: nil,
+		},
+		"NotFound": {
+			e: &external{
+				client: &MockPrivateZoneAPI{
+					MockDelete: func(_ context.Context, _ *v1alpha1.PrivateZone) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  privateZone(),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.e.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
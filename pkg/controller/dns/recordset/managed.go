@@ -80,7 +80,7 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := dns.NewRecordSetsClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl := dns.NewRecordSetsClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
 	return &external{
 		client: dnsclients.NewRecordSetClient(cl),
@@ -80,15 +80,21 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := dnsapi.NewZonesClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl := dnsapi.NewZonesClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
 	return &external{
-		client: dns.NewZoneClient(cl),
+		client:   dns.NewZoneClient(cl, d),
+		defaults: d,
 	}, nil
 }
 
 type external struct {
-	client dns.ZoneAPI
+	client   dns.ZoneAPI
+	defaults azureclients.ResourceDefaults
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -111,7 +117,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	o := managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: dns.ZoneIsUpToDate(z, az),
+		ResourceUpToDate: dns.ZoneIsUpToDate(z, az, e.defaults),
+	}
+	if o.ResourceUpToDate {
+		o.ResourceLateInitialized = azureclients.SetManagedTags(z, e.defaults.MergeTags(azureclients.ToStringMap(z.Spec.ForProvider.Tags)))
 	}
 
 	return o, nil
@@ -148,7 +148,8 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				eo: managed.ExternalObservation{
-					ResourceExists: true,
+					ResourceExists:   true,
+					ResourceUpToDate: true,
 				},
 			},
 		},
@@ -78,18 +78,35 @@ type connector struct {
 }
 
 func (c connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	cc, err := azure.GetCredentials(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	creds := cc.Credentials()
+	// Key Vault data plane requests require a token for the Key Vault
+	// resource of the environment rather than Azure Resource Manager.
+	res, err := azure.KeyVaultResource(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	auth, err := cc.Authorizer(res)
 	if err != nil {
 		return nil, errors.Wrap(err, errConnectFailed)
 	}
 	cl := keyvault.New()
 	cl.Authorizer = auth
-	return &external{kube: c.kube, client: cl}, nil
+	_ = cl.AddToUserAgent(azure.UserAgent)
+	return &external{kube: c.kube, client: cl, dnsSuffix: creds[azure.CredentialsKeyKeyVaultDNSSuffix]}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client keyvaultapi.BaseClientAPI
+	kube      client.Client
+	client    keyvaultapi.BaseClientAPI
+	dnsSuffix string
+}
+
+func (c *external) vaultBaseURL(cr *keyvaultv1alpha1.KeyVaultSecret) string {
+	return secretclients.VaultBaseURL(cr.Spec.ForProvider.VaultBaseURL, c.dnsSuffix)
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -98,7 +115,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotSecret)
 	}
 
-	secret, err := c.client.GetSecret(ctx, cr.Spec.ForProvider.VaultBaseURL, cr.Spec.ForProvider.Name, "" /* latest */)
+	secret, err := c.client.GetSecret(ctx, c.vaultBaseURL(cr), cr.Spec.ForProvider.Name, "" /* latest */)
 	if err != nil {
 		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(azure.IsNotFound, err), errGetFailed)
 	}
@@ -137,7 +154,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, err
 	}
 
-	_, err = c.client.SetSecret(ctx, cr.Spec.ForProvider.VaultBaseURL, cr.Spec.ForProvider.Name, keyvault.SecretSetParameters{
+	_, err = c.client.SetSecret(ctx, c.vaultBaseURL(cr), cr.Spec.ForProvider.Name, keyvault.SecretSetParameters{
 		Value:            azure.ToStringPtr(val),
 		Tags:             azure.ToStringPtrMap(cr.Spec.ForProvider.Tags),
 		ContentType:      cr.Spec.ForProvider.ContentType,
@@ -162,7 +179,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 
-	_, err = c.client.SetSecret(ctx, cr.Spec.ForProvider.VaultBaseURL, cr.Spec.ForProvider.Name, keyvault.SecretSetParameters{
+	_, err = c.client.SetSecret(ctx, c.vaultBaseURL(cr), cr.Spec.ForProvider.Name, keyvault.SecretSetParameters{
 		Value:            azure.ToStringPtr(val),
 		Tags:             azure.ToStringPtrMap(cr.Spec.ForProvider.Tags),
 		ContentType:      cr.Spec.ForProvider.ContentType,
@@ -182,7 +199,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotSecret)
 	}
 
-	_, err := c.client.DeleteSecret(ctx, cr.Spec.ForProvider.VaultBaseURL, cr.Spec.ForProvider.Name)
+	_, err := c.client.DeleteSecret(ctx, c.vaultBaseURL(cr), cr.Spec.ForProvider.Name)
 
 	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteFailed)
 }
@@ -0,0 +1,361 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/managedidentity/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/managedidentity/fake"
+)
+
+const (
+	name          = "cool-identity"
+	resourceGroup = "cool-group"
+	location      = "westeurope"
+	id            = "/subscriptions/sub/resourceGroups/cool-group/providers/Microsoft.ManagedIdentity/userAssignedIdentities/cool-identity"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+	principalID = uuid.Must(uuid.FromString("3f1f2a3b-5c4d-4e6f-8a9b-0c1d2e3f4a5b"))
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type identityModifier func(*v1alpha1.UserAssignedIdentity)
+
+func withConditions(c ...xpv1.Condition) identityModifier {
+	return func(cr *v1alpha1.UserAssignedIdentity) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(t map[string]string) identityModifier {
+	return func(cr *v1alpha1.UserAssignedIdentity) { cr.Spec.ForProvider.Tags = t }
+}
+
+func withManagedTags(keys string) identityModifier {
+	return func(r *v1alpha1.UserAssignedIdentity) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withAtProvider(o v1alpha1.UserAssignedIdentityObservation) identityModifier {
+	return func(cr *v1alpha1.UserAssignedIdentity) { cr.Status.AtProvider = o }
+}
+
+func identity(m ...identityModifier) *v1alpha1.UserAssignedIdentity {
+	cr := &v1alpha1.UserAssignedIdentity{
+		Spec: v1alpha1.UserAssignedIdentitySpec{
+			ForProvider: v1alpha1.UserAssignedIdentityParameters{
+				ResourceGroupName: resourceGroup,
+				Location:          location,
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func msiIdentity(tags map[string]*string) msi.Identity {
+	return msi.Identity{
+		ID:       to.StringPtr(id),
+		Location: to.StringPtr(location),
+		Tags:     tags,
+		UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{
+			PrincipalID: &principalID,
+		},
+	}
+}
+
+func getFn(i msi.Identity, err error) func(context.Context, string, string) (msi.Identity, error) {
+	return func(_ context.Context, rg, n string) (msi.Identity, error) {
+		if rg != resourceGroup || n != name {
+			return msi.Identity{}, errors.Errorf("unexpected identity %s/%s", rg, n)
+		}
+		return i, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	observation := v1alpha1.UserAssignedIdentityObservation{ID: id, PrincipalID: principalID.String()}
+
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"NotFound": {
+			e:  &external{client: &fake.MockUserAssignedIdentitiesClient{MockGet: getFn(msi.Identity{}, errNotFound)}},
+			cr: identity(),
+			want: want{
+				cr: identity(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e:  &external{client: &fake.MockUserAssignedIdentitiesClient{MockGet: getFn(msi.Identity{}, errBoom)}},
+			cr: identity(),
+			want: want{
+				cr:  identity(),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitialized": {
+			e:  &external{client: &fake.MockUserAssignedIdentitiesClient{MockGet: getFn(msiIdentity(map[string]*string{"team": to.StringPtr("cool")}), nil)}},
+			cr: identity(),
+			want: want{
+				cr: identity(
+					withTags(map[string]string{"team": "cool"}),
+					withManagedTags(`["team"]`),
+					withConditions(xpv1.Available()),
+					withAtProvider(observation),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			e:  &external{client: &fake.MockUserAssignedIdentitiesClient{MockGet: getFn(msiIdentity(map[string]*string{"team": to.StringPtr("cool")}), nil)}},
+			cr: identity(withTags(map[string]string{"team": "cooler"})),
+			want: want{
+				cr: identity(
+					withTags(map[string]string{"team": "cooler"}),
+					withConditions(xpv1.Available()),
+					withAtProvider(observation),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ msi.Identity) (msi.Identity, error) {
+					return msi.Identity{}, errBoom
+				},
+			}},
+			cr: identity(),
+			want: want{
+				cr:  identity(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, i msi.Identity) (msi.Identity, error) {
+					if to.String(i.Location) != location {
+						t.Errorf("Create(...): unexpected location %s", to.String(i.Location))
+					}
+					return i, nil
+				},
+			}},
+			cr: identity(),
+			want: want{
+				cr: identity(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
+	}{
+		"NotUserAssignedIdentity": {
+			e:    &external{},
+			want: errors.New(errNotUserAssignedIdentity),
+		},
+		"GetError": {
+			e:    &external{client: &fake.MockUserAssignedIdentitiesClient{MockGet: getFn(msi.Identity{}, errBoom)}},
+			cr:   identity(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockGet: getFn(msiIdentity(nil), nil),
+				MockUpdate: func(_ context.Context, _, _ string, _ msi.IdentityUpdate) (msi.Identity, error) {
+					return msi.Identity{}, errBoom
+				},
+			}},
+			cr:   identity(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockGet: getFn(msiIdentity(map[string]*string{"owner": to.StringPtr("finance")}), nil),
+				MockUpdate: func(_ context.Context, _, _ string, u msi.IdentityUpdate) (msi.Identity, error) {
+					want := map[string]*string{"owner": to.StringPtr("finance"), "team": to.StringPtr("cool")}
+					if diff := cmp.Diff(want, u.Tags); diff != "" {
+						t.Errorf("Update(...): -want tags, +got tags:\n%s", diff)
+					}
+					return msi.Identity{}, nil
+				},
+			}},
+			cr: identity(withTags(map[string]string{"team": "cool"})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
+	}{
+		"NotUserAssignedIdentity": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotUserAssignedIdentity),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockDelete: func(_ context.Context, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errNotFound
+				},
+			}},
+			cr: identity(),
+			want: want{
+				cr: identity(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockUserAssignedIdentitiesClient{
+				MockDelete: func(_ context.Context, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			cr: identity(),
+			want: want{
+				cr:  identity(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotLoadBalancer    = "managed resource is not a LoadBalancer"
+	errCreateLoadBalancer = "cannot create LoadBalancer"
+	errUpdateLoadBalancer = "cannot update LoadBalancer"
+	errGetLoadBalancer    = "cannot get LoadBalancer"
+	errDeleteLoadBalancer = "cannot delete LoadBalancer"
+)
+
+// fmtLoadBalancerID is the format of the ID of a load balancer, from which the
+// IDs of the elements its rules refer to are derived.
+const fmtLoadBalancerID = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s"
+
+// Setup adds a controller that reconciles LoadBalancers.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.LoadBalancerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := azureclients.SubscriptionID(mg, creds)
+	cl := azurenetwork.NewLoadBalancersClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], subscriptionID)
+	cl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, subscriptionID: subscriptionID, defaults: d}, nil
+}
+
+type external struct {
+	client         networkapi.LoadBalancersClientAPI
+	subscriptionID string
+	defaults       azureclients.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLoadBalancer)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetLoadBalancer)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	network.LateInitializeLoadBalancer(&cr.Spec.ForProvider, az, e.defaults)
+
+	cr.Status.AtProvider = network.GenerateLoadBalancerObservation(az)
+	switch azurenetwork.ProvisioningState(cr.Status.AtProvider.State) {
+	case azurenetwork.Succeeded:
+		cr.SetConditions(xpv1.Available())
+	case azurenetwork.Deleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := network.IsLoadBalancerUpToDate(cr.Spec.ForProvider, az, e.defaults)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+	if upToDate {
+		li = azureclients.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags)) || li
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLoadBalancer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	lb := network.NewLoadBalancerParameters(e.id(cr), cr.Spec.ForProvider, e.defaults)
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), lb)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLoadBalancer)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLoadBalancer)
+	}
+
+	// The load balancer is read first so that its location, SKU and current
+	// tags are retained.
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetLoadBalancer)
+	}
+	lb := network.NewLoadBalancerUpdate(e.id(cr), cr.Spec.ForProvider, az, e.defaults)
+	_, err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), lb)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLoadBalancer)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.LoadBalancer)
+	if !ok {
+		return errors.New(errNotLoadBalancer)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeleteLoadBalancer)
+}
+
+// id returns the ID of the supplied LoadBalancer.
+func (e *external) id(cr *v1alpha3.LoadBalancer) string {
+	return fmt.Sprintf(fmtLoadBalancerID, e.subscriptionID, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+}
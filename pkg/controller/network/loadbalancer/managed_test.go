@@ -0,0 +1,432 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolLB"
+	resourceGroupName = "coolRG"
+	subscriptionID    = "sub"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/loadBalancers/coolLB"
+	publicIPAddressID = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/publicIPAddresses/coolIP"
+	frontendID        = id + "/frontendIPConfigurations/public"
+	backendID         = id + "/backendAddressPools/web"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type loadBalancerModifier func(*v1alpha3.LoadBalancer)
+
+func withConditions(c ...xpv1.Condition) loadBalancerModifier {
+	return func(r *v1alpha3.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSKU(sku string) loadBalancerModifier {
+	return func(r *v1alpha3.LoadBalancer) { r.Spec.ForProvider.SKU = &v1alpha3.SKU{Name: sku} }
+}
+
+func withBackendAddressPools(names ...string) loadBalancerModifier {
+	return func(r *v1alpha3.LoadBalancer) {
+		r.Spec.ForProvider.BackendAddressPools = nil
+		for _, n := range names {
+			r.Spec.ForProvider.BackendAddressPools = append(r.Spec.ForProvider.BackendAddressPools, v1alpha3.BackendAddressPool{Name: n})
+		}
+	}
+}
+
+func withObservation(o v1alpha3.LoadBalancerObservation) loadBalancerModifier {
+	return func(r *v1alpha3.LoadBalancer) { r.Status.AtProvider = o }
+}
+
+func loadBalancer(m ...loadBalancerModifier) *v1alpha3.LoadBalancer {
+	r := &v1alpha3.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.LoadBalancerSpec{
+			ForProvider: v1alpha3.LoadBalancerParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				FrontendIPConfigurations: []v1alpha3.FrontendIPConfiguration{
+					{Name: "public", PublicIPAddressID: azure.ToStringPtr(publicIPAddressID)},
+				},
+				BackendAddressPools: []v1alpha3.BackendAddressPool{{Name: "web"}},
+				LoadBalancingRules: []v1alpha3.LoadBalancingRule{{
+					Name:                        "http",
+					FrontendIPConfigurationName: "public",
+					BackendAddressPoolName:      azure.ToStringPtr("web"),
+					Protocol:                    "Tcp",
+					FrontendPort:                80,
+				}},
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azureLoadBalancer(state network.ProvisioningState) network.LoadBalancer {
+	return network.LoadBalancer{
+		ID:       azure.ToStringPtr(id),
+		Location: azure.ToStringPtr(location),
+		Sku:      &network.LoadBalancerSku{Name: network.LoadBalancerSkuNameStandard},
+		LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+			ProvisioningState: azure.ToStringPtr(string(state)),
+			FrontendIPConfigurations: &[]network.FrontendIPConfiguration{{
+				Name: azure.ToStringPtr("public"),
+				ID:   azure.ToStringPtr(frontendID),
+				FrontendIPConfigurationPropertiesFormat: &network.FrontendIPConfigurationPropertiesFormat{
+					PublicIPAddress: &network.PublicIPAddress{ID: azure.ToStringPtr(publicIPAddressID)},
+				},
+			}},
+			BackendAddressPools: &[]network.BackendAddressPool{{
+				Name: azure.ToStringPtr("web"),
+				ID:   azure.ToStringPtr(backendID),
+			}},
+			LoadBalancingRules: &[]network.LoadBalancingRule{{
+				Name: azure.ToStringPtr("http"),
+				LoadBalancingRulePropertiesFormat: &network.LoadBalancingRulePropertiesFormat{
+					FrontendIPConfiguration: &network.SubResource{ID: azure.ToStringPtr(frontendID)},
+					BackendAddressPool:      &network.SubResource{ID: azure.ToStringPtr(backendID)},
+					Protocol:                network.TransportProtocolTCP,
+					FrontendPort:            azure.ToInt32Ptr(80),
+					BackendPort:             azure.ToInt32Ptr(80),
+				},
+			}},
+		},
+	}
+}
+
+func observation(state network.ProvisioningState) v1alpha3.LoadBalancerObservation {
+	return v1alpha3.LoadBalancerObservation{
+		State:                    string(state),
+		ID:                       id,
+		FrontendIPConfigurations: []v1alpha3.FrontendIPConfigurationObservation{{Name: "public", ID: frontendID}},
+		BackendAddressPools:      []v1alpha3.LoadBalancerSubResource{{Name: "web", ID: backendID}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotLoadBalancer": {
+			e: &external{client: &fake.MockLoadBalancersClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotLoadBalancer),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+					return network.LoadBalancer{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: loadBalancer(),
+			want: want{
+				cr: loadBalancer(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+					return network.LoadBalancer{}, errorBoom
+				},
+			}},
+			r: loadBalancer(),
+			want: want{
+				cr:  loadBalancer(),
+				err: errors.Wrap(errorBoom, errGetLoadBalancer),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, rg, n, _ string) (network.LoadBalancer, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q", rg, n)
+					}
+					return azureLoadBalancer(network.Succeeded), nil
+				},
+			}},
+			r: loadBalancer(),
+			want: want{
+				cr: loadBalancer(
+					withSKU("Standard"),
+					withConditions(xpv1.Available()),
+					withObservation(observation(network.Succeeded)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"BackendAddressPoolAdded": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+					return azureLoadBalancer(network.Updating), nil
+				},
+			}},
+			r: loadBalancer(withSKU("Standard"), withBackendAddressPools("web", "api")),
+			want: want{
+				cr: loadBalancer(
+					withSKU("Standard"),
+					withBackendAddressPools("web", "api"),
+					withConditions(xpv1.Unavailable()),
+					withObservation(observation(network.Updating)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotLoadBalancer": {
+			e:    &external{client: &fake.MockLoadBalancersClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotLoadBalancer),
+		},
+		"Successful": {
+			e: &external{
+				subscriptionID: subscriptionID,
+				client: &fake.MockLoadBalancersClient{
+					MockCreateOrUpdate: func(_ context.Context, rg, n string, lb network.LoadBalancer) (network.LoadBalancersCreateOrUpdateFuture, error) {
+						if rg != resourceGroupName || n != name {
+							t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q", rg, n)
+						}
+						rule := (*lb.LoadBalancingRules)[0]
+						if diff := cmp.Diff(azure.ToStringPtr(backendID), rule.BackendAddressPool.ID); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						return network.LoadBalancersCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			r:    loadBalancer(),
+			want: loadBalancer(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.LoadBalancer) (network.LoadBalancersCreateOrUpdateFuture, error) {
+					return network.LoadBalancersCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    loadBalancer(),
+			want: loadBalancer(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateLoadBalancer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotLoadBalancer": {
+			e:   &external{client: &fake.MockLoadBalancersClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotLoadBalancer),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+					return network.LoadBalancer{}, errorBoom
+				},
+			}},
+			r:   loadBalancer(),
+			err: errors.Wrap(errorBoom, errGetLoadBalancer),
+		},
+		"BackendAddressPoolAdded": {
+			e: &external{
+				subscriptionID: subscriptionID,
+				client: &fake.MockLoadBalancersClient{
+					MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+						return azureLoadBalancer(network.Succeeded), nil
+					},
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, lb network.LoadBalancer) (network.LoadBalancersCreateOrUpdateFuture, error) {
+						want := &[]network.BackendAddressPool{{Name: azure.ToStringPtr("web")}, {Name: azure.ToStringPtr("api")}}
+						if diff := cmp.Diff(want, lb.BackendAddressPools); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(&network.LoadBalancerSku{Name: network.LoadBalancerSkuNameStandard}, lb.Sku); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						return network.LoadBalancersCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			r: loadBalancer(withBackendAddressPools("web", "api")),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.LoadBalancer, error) {
+					return azureLoadBalancer(network.Succeeded), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.LoadBalancer) (network.LoadBalancersCreateOrUpdateFuture, error) {
+					return network.LoadBalancersCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   loadBalancer(),
+			err: errors.Wrap(errorBoom, errUpdateLoadBalancer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotLoadBalancer": {
+			e:    &external{client: &fake.MockLoadBalancersClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotLoadBalancer),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.LoadBalancersDeleteFuture, error) {
+					return network.LoadBalancersDeleteFuture{}, nil
+				},
+			}},
+			r:    loadBalancer(),
+			want: loadBalancer(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.LoadBalancersDeleteFuture, error) {
+					return network.LoadBalancersDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    loadBalancer(),
+			want: loadBalancer(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockLoadBalancersClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.LoadBalancersDeleteFuture, error) {
+					return network.LoadBalancersDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    loadBalancer(),
+			want: loadBalancer(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteLoadBalancer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotNATGateway    = "managed resource is not a NATGateway"
+	errCreateNATGateway = "cannot create NATGateway"
+	errUpdateNATGateway = "cannot update NATGateway"
+	errGetNATGateway    = "cannot get NATGateway"
+	errDeleteNATGateway = "cannot delete NATGateway"
+)
+
+// Setup adds a controller that reconciles NATGateways.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.NATGatewayGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.NATGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.NATGatewayGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewNatGatewaysClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, defaults: d}, nil
+}
+
+type external struct {
+	client   networkapi.NatGatewaysClientAPI
+	defaults azureclients.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNATGateway)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetNATGateway)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	network.LateInitializeNATGateway(&cr.Spec.ForProvider, az, e.defaults)
+
+	cr.Status.AtProvider = network.GenerateNATGatewayObservation(az)
+	switch azurenetwork.ProvisioningState(cr.Status.AtProvider.State) {
+	case azurenetwork.Succeeded:
+		cr.SetConditions(xpv1.Available())
+	case azurenetwork.Deleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := network.IsNATGatewayUpToDate(cr.Spec.ForProvider, az, e.defaults)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+	if upToDate {
+		li = azureclients.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags)) || li
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNATGateway)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	ng := network.NewNATGatewayParameters(cr.Spec.ForProvider, e.defaults)
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), ng)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNATGateway)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.NATGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNATGateway)
+	}
+
+	// The NAT gateway is read first so that its location, zones and current
+	// tags are retained.
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNATGateway)
+	}
+	ng := network.NewNATGatewayUpdate(cr.Spec.ForProvider, az, e.defaults)
+	_, err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), ng)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNATGateway)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.NATGateway)
+	if !ok {
+		return errors.New(errNotNATGateway)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeleteNATGateway)
+}
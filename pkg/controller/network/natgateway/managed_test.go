@@ -0,0 +1,389 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolNATGateway"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/natGateways/coolNATGateway"
+	publicIPAddressID = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/publicIPAddresses/coolIP"
+	publicIPPrefixID  = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/publicIPPrefixes/coolPrefix"
+	subnetID          = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/virtualNetworks/coolVNet/subnets/coolSubnet"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type natGatewayModifier func(*v1alpha3.NATGateway)
+
+func withConditions(c ...xpv1.Condition) natGatewayModifier {
+	return func(r *v1alpha3.NATGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withIdleTimeout(minutes int) natGatewayModifier {
+	return func(r *v1alpha3.NATGateway) { r.Spec.ForProvider.IdleTimeoutInMinutes = azure.ToInt32Ptr(minutes) }
+}
+
+func withPublicIPPrefixIDs(ids ...string) natGatewayModifier {
+	return func(r *v1alpha3.NATGateway) { r.Spec.ForProvider.PublicIPPrefixIDs = ids }
+}
+
+func withObservation(o v1alpha3.NATGatewayObservation) natGatewayModifier {
+	return func(r *v1alpha3.NATGateway) { r.Status.AtProvider = o }
+}
+
+func natGateway(m ...natGatewayModifier) *v1alpha3.NATGateway {
+	r := &v1alpha3.NATGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.NATGatewaySpec{
+			ForProvider: v1alpha3.NATGatewayParameters{
+				ResourceGroupName:  resourceGroupName,
+				Location:           location,
+				PublicIPAddressIDs: []string{publicIPAddressID},
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azureNATGateway(state network.ProvisioningState) network.NatGateway {
+	return network.NatGateway{
+		ID:       azure.ToStringPtr(id),
+		Location: azure.ToStringPtr(location),
+		NatGatewayPropertiesFormat: &network.NatGatewayPropertiesFormat{
+			ProvisioningState:    azure.ToStringPtr(string(state)),
+			IdleTimeoutInMinutes: azure.ToInt32Ptr(4),
+			PublicIPAddresses:    &[]network.SubResource{{ID: azure.ToStringPtr(publicIPAddressID)}},
+			Subnets:              &[]network.SubResource{{ID: azure.ToStringPtr(subnetID)}},
+		},
+	}
+}
+
+func observation(state network.ProvisioningState) v1alpha3.NATGatewayObservation {
+	return v1alpha3.NATGatewayObservation{
+		State:     string(state),
+		ID:        id,
+		SubnetIDs: []string{subnetID},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotNATGateway": {
+			e: &external{client: &fake.MockNatGatewaysClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotNATGateway),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return network.NatGateway{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: natGateway(),
+			want: want{
+				cr: natGateway(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return network.NatGateway{}, errorBoom
+				},
+			}},
+			r: natGateway(),
+			want: want{
+				cr:  natGateway(),
+				err: errors.Wrap(errorBoom, errGetNATGateway),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, rg, n, _ string) (network.NatGateway, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q", rg, n)
+					}
+					return azureNATGateway(network.Succeeded), nil
+				},
+			}},
+			r: natGateway(),
+			want: want{
+				cr: natGateway(
+					withIdleTimeout(4),
+					withConditions(xpv1.Available()),
+					withObservation(observation(network.Succeeded)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PublicIPPrefixAdded": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return azureNATGateway(network.Updating), nil
+				},
+			}},
+			r: natGateway(withIdleTimeout(4), withPublicIPPrefixIDs(publicIPPrefixID)),
+			want: want{
+				cr: natGateway(
+					withIdleTimeout(4),
+					withPublicIPPrefixIDs(publicIPPrefixID),
+					withConditions(xpv1.Unavailable()),
+					withObservation(observation(network.Updating)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotNATGateway": {
+			e:    &external{client: &fake.MockNatGatewaysClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotNATGateway),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, n string, ng network.NatGateway) (network.NatGatewaysCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q", rg, n)
+					}
+					want := &[]network.SubResource{{ID: azure.ToStringPtr(publicIPAddressID)}}
+					if diff := cmp.Diff(want, ng.PublicIPAddresses); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.NatGatewaysCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    natGateway(),
+			want: natGateway(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.NatGateway) (network.NatGatewaysCreateOrUpdateFuture, error) {
+					return network.NatGatewaysCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    natGateway(),
+			want: natGateway(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateNATGateway),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotNATGateway": {
+			e:   &external{client: &fake.MockNatGatewaysClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotNATGateway),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return network.NatGateway{}, errorBoom
+				},
+			}},
+			r:   natGateway(),
+			err: errors.Wrap(errorBoom, errGetNATGateway),
+		},
+		"IdleTimeoutChanged": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return azureNATGateway(network.Succeeded), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, ng network.NatGateway) (network.NatGatewaysCreateOrUpdateFuture, error) {
+					if diff := cmp.Diff(azure.ToInt32Ptr(10), ng.IdleTimeoutInMinutes); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(azure.ToStringPtr(location), ng.Location); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.NatGatewaysCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: natGateway(withIdleTimeout(10)),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.NatGateway, error) {
+					return azureNATGateway(network.Succeeded), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.NatGateway) (network.NatGatewaysCreateOrUpdateFuture, error) {
+					return network.NatGatewaysCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   natGateway(),
+			err: errors.Wrap(errorBoom, errUpdateNATGateway),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotNATGateway": {
+			e:    &external{client: &fake.MockNatGatewaysClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotNATGateway),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.NatGatewaysDeleteFuture, error) {
+					return network.NatGatewaysDeleteFuture{}, nil
+				},
+			}},
+			r:    natGateway(),
+			want: natGateway(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.NatGatewaysDeleteFuture, error) {
+					return network.NatGatewaysDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    natGateway(),
+			want: natGateway(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockNatGatewaysClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.NatGatewaysDeleteFuture, error) {
+					return network.NatGatewaysDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    natGateway(),
+			want: natGateway(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteNATGateway),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterface
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolNIC"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/networkInterfaces/coolNIC"
+	subnetID          = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/virtualNetworks/coolVNet/subnets/coolSubnet"
+	macAddress        = "00-0D-3A-00-00-01"
+	privateIPAddress  = "10.0.0.4"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type networkInterfaceModifier func(*v1alpha3.NetworkInterface)
+
+func withConditions(c ...xpv1.Condition) networkInterfaceModifier {
+	return func(r *v1alpha3.NetworkInterface) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAcceleratedNetworking(enabled bool) networkInterfaceModifier {
+	return func(r *v1alpha3.NetworkInterface) {
+		r.Spec.ForProvider.EnableAcceleratedNetworking = azure.ToBoolPtr(enabled, azure.FieldRequired)
+	}
+}
+
+func withDNSServers(s ...string) networkInterfaceModifier {
+	return func(r *v1alpha3.NetworkInterface) { r.Spec.ForProvider.DNSServers = s }
+}
+
+func withObservation(o v1alpha3.NetworkInterfaceObservation) networkInterfaceModifier {
+	return func(r *v1alpha3.NetworkInterface) { r.Status.AtProvider = o }
+}
+
+func networkInterface(m ...networkInterfaceModifier) *v1alpha3.NetworkInterface {
+	r := &v1alpha3.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.NetworkInterfaceSpec{
+			ForProvider: v1alpha3.NetworkInterfaceParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				IPConfigurations: []v1alpha3.NetworkInterfaceIPConfiguration{
+					{Name: "primary", SubnetID: subnetID},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azureNetworkInterface(state network.ProvisioningState) network.Interface {
+	return network.Interface{
+		ID:       azure.ToStringPtr(id),
+		Location: azure.ToStringPtr(location),
+		InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+			ProvisioningState: azure.ToStringPtr(string(state)),
+			IPConfigurations: &[]network.InterfaceIPConfiguration{{
+				Name: azure.ToStringPtr("primary"),
+				InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+					Primary:                   azure.ToBoolPtr(true),
+					Subnet:                    &network.Subnet{ID: azure.ToStringPtr(subnetID)},
+					PrivateIPAddress:          azure.ToStringPtr(privateIPAddress),
+					PrivateIPAllocationMethod: network.Dynamic,
+				},
+			}},
+			EnableAcceleratedNetworking: azure.ToBoolPtr(true),
+			DNSSettings:                 &network.InterfaceDNSSettings{DNSServers: &[]string{"10.0.0.10"}},
+			MacAddress:                  azure.ToStringPtr(macAddress),
+		},
+	}
+}
+
+func observation(state network.ProvisioningState) v1alpha3.NetworkInterfaceObservation {
+	return v1alpha3.NetworkInterfaceObservation{
+		State:              string(state),
+		ID:                 id,
+		MACAddress:         macAddress,
+		PrivateIPAddress:   privateIPAddress,
+		PrivateIPAddresses: []string{privateIPAddress},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotNetworkInterface": {
+			e: &external{client: &fake.MockInterfacesClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotNetworkInterface),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return network.Interface{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: networkInterface(),
+			want: want{
+				cr: networkInterface(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return network.Interface{}, errorBoom
+				},
+			}},
+			r: networkInterface(),
+			want: want{
+				cr:  networkInterface(),
+				err: errors.Wrap(errorBoom, errGetNetworkInterface),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, rg, n, _ string) (network.Interface, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q", rg, n)
+					}
+					return azureNetworkInterface(network.Succeeded), nil
+				},
+			}},
+			r: networkInterface(),
+			want: want{
+				cr: networkInterface(
+					withAcceleratedNetworking(true),
+					withDNSServers("10.0.0.10"),
+					withConditions(xpv1.Available()),
+					withObservation(observation(network.Succeeded)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AcceleratedNetworkingDisabled": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return azureNetworkInterface(network.Updating), nil
+				},
+			}},
+			r: networkInterface(withAcceleratedNetworking(false), withDNSServers("10.0.0.10")),
+			want: want{
+				cr: networkInterface(
+					withAcceleratedNetworking(false),
+					withDNSServers("10.0.0.10"),
+					withConditions(xpv1.Unavailable()),
+					withObservation(observation(network.Updating)),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotNetworkInterface": {
+			e:    &external{client: &fake.MockInterfacesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotNetworkInterface),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, n string, nic network.Interface) (network.InterfacesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q", rg, n)
+					}
+					subnet := (*nic.IPConfigurations)[0].Subnet
+					if diff := cmp.Diff(azure.ToStringPtr(subnetID), subnet.ID); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.InterfacesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    networkInterface(),
+			want: networkInterface(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.Interface) (network.InterfacesCreateOrUpdateFuture, error) {
+					return network.InterfacesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    networkInterface(),
+			want: networkInterface(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateNetworkInterface),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotNetworkInterface": {
+			e:   &external{client: &fake.MockInterfacesClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotNetworkInterface),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return network.Interface{}, errorBoom
+				},
+			}},
+			r:   networkInterface(),
+			err: errors.Wrap(errorBoom, errGetNetworkInterface),
+		},
+		"DNSServersChanged": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return azureNetworkInterface(network.Succeeded), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, nic network.Interface) (network.InterfacesCreateOrUpdateFuture, error) {
+					want := &network.InterfaceDNSSettings{DNSServers: &[]string{"10.0.0.11", "10.0.0.12"}}
+					if diff := cmp.Diff(want, nic.DNSSettings); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(azure.ToStringPtr(location), nic.Location); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.InterfacesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: networkInterface(withDNSServers("10.0.0.11", "10.0.0.12")),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Interface, error) {
+					return azureNetworkInterface(network.Succeeded), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.Interface) (network.InterfacesCreateOrUpdateFuture, error) {
+					return network.InterfacesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   networkInterface(),
+			err: errors.Wrap(errorBoom, errUpdateNetworkInterface),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotNetworkInterface": {
+			e:    &external{client: &fake.MockInterfacesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotNetworkInterface),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.InterfacesDeleteFuture, error) {
+					return network.InterfacesDeleteFuture{}, nil
+				},
+			}},
+			r:    networkInterface(),
+			want: networkInterface(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.InterfacesDeleteFuture, error) {
+					return network.InterfacesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    networkInterface(),
+			want: networkInterface(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockInterfacesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.InterfacesDeleteFuture, error) {
+					return network.InterfacesDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    networkInterface(),
+			want: networkInterface(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteNetworkInterface),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
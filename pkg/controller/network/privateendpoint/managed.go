@@ -0,0 +1,264 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateendpoint
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network/networkapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	databasev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotPrivateEndpoint        = "managed resource is not a PrivateEndpoint"
+	errCreatePrivateEndpoint     = "cannot create PrivateEndpoint"
+	errUpdatePrivateEndpoint     = "cannot update PrivateEndpoint"
+	errGetPrivateEndpoint        = "cannot get PrivateEndpoint"
+	errDeletePrivateEndpoint     = "cannot delete PrivateEndpoint"
+	errGetPrivateDNSZoneGroup    = "cannot get private DNS zone group of PrivateEndpoint"
+	errUpdatePrivateDNSZoneGroup = "cannot update private DNS zone group of PrivateEndpoint"
+	errDeletePrivateDNSZoneGroup = "cannot delete private DNS zone group of PrivateEndpoint"
+	errResolveReferences         = "cannot resolve references of PrivateEndpoint"
+	errUpdateManaged             = "cannot update managed PrivateEndpoint"
+)
+
+// connectionApproved is the status of a connection to a private link resource
+// that traffic may flow through.
+const connectionApproved = "Approved"
+
+// Setup adds a controller that reconciles PrivateEndpoints.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.PrivateEndpointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.PrivateEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.PrivateEndpointGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(&referenceResolver{client: mgr.GetClient()}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+// A referenceResolver resolves the references of a PrivateEndpoint. Its
+// CosmosDBAccount reference is resolved here rather than by the
+// PrivateEndpoint itself, because the database API group imports the network
+// API group.
+type referenceResolver struct {
+	client client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return errors.New(errNotPrivateEndpoint)
+	}
+	existing := cr.DeepCopy()
+	if err := cr.ResolveReferences(ctx, r.client); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	rsp, err := reference.NewAPIResolver(r.client, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: cr.Spec.ForProvider.PrivateLinkResourceID,
+		Reference:    cr.Spec.ForProvider.CosmosDBAccountRef,
+		Selector:     cr.Spec.ForProvider.CosmosDBAccountSelector,
+		To:           reference.To{Managed: &databasev1alpha3.CosmosDBAccount{}, List: &databasev1alpha3.CosmosDBAccountList{}},
+		Extract:      databasev1alpha3.CosmosDBAccountID(),
+	})
+	if err != nil {
+		return errors.Wrap(errors.Wrap(err, "spec.forProvider.privateLinkResourceID"), errResolveReferences)
+	}
+	cr.Spec.ForProvider.PrivateLinkResourceID = rsp.ResolvedValue
+	cr.Spec.ForProvider.CosmosDBAccountRef = rsp.ResolvedReference
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.client.Update(ctx, cr), errUpdateManaged)
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewPrivateEndpointsClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	zcl := azurenetwork.NewPrivateDNSZoneGroupsClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	zcl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, zoneGroups: zcl, defaults: d}, nil
+}
+
+type external struct {
+	client     networkapi.PrivateEndpointsClientAPI
+	zoneGroups networkapi.PrivateDNSZoneGroupsClientAPI
+	defaults   azureclients.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetPrivateEndpoint)
+	}
+	zg, err := e.getZoneGroup(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	network.LateInitializePrivateEndpoint(&cr.Spec.ForProvider, az, e.defaults)
+
+	cr.Status.AtProvider = network.GeneratePrivateEndpointObservation(az, zg)
+	// Traffic only flows once the owner of the private link resource has
+	// approved the connection.
+	switch {
+	case cr.Status.AtProvider.State == string(azurenetwork.ProvisioningStateDeleting):
+		cr.SetConditions(xpv1.Deleting())
+	case cr.Status.AtProvider.State == string(azurenetwork.ProvisioningStateSucceeded) &&
+		cr.Status.AtProvider.ConnectionState == connectionApproved:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := network.IsPrivateEndpointUpToDate(cr.Spec.ForProvider, az, e.defaults) &&
+		network.IsPrivateDNSZoneGroupUpToDate(cr.Spec.ForProvider, zg)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+	if upToDate {
+		li = azureclients.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags)) || li
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	// The DNS zone group is created by Update once the endpoint exists.
+	cr.SetConditions(xpv1.Creating())
+	pe := network.NewPrivateEndpointParameters(meta.GetExternalName(cr), cr.Spec.ForProvider, e.defaults)
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), pe)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePrivateEndpoint)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	rg, name := cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr)
+	az, err := e.client.Get(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPrivateEndpoint)
+	}
+	if !network.IsPrivateEndpointUpToDate(cr.Spec.ForProvider, az, e.defaults) {
+		pe := network.NewPrivateEndpointUpdate(cr.Spec.ForProvider, az, e.defaults)
+		if _, err := e.client.CreateOrUpdate(ctx, rg, name, pe); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePrivateEndpoint)
+		}
+	}
+
+	zg, err := e.getZoneGroup(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if network.IsPrivateDNSZoneGroupUpToDate(cr.Spec.ForProvider, zg) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if cr.Spec.ForProvider.PrivateDNSZoneID == nil {
+		_, err := e.zoneGroups.Delete(ctx, rg, name, network.PrivateDNSZoneGroupName)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePrivateDNSZoneGroup)
+	}
+	_, err = e.zoneGroups.CreateOrUpdate(ctx, rg, name, network.PrivateDNSZoneGroupName, network.NewPrivateDNSZoneGroupParameters(*cr.Spec.ForProvider.PrivateDNSZoneID))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePrivateDNSZoneGroup)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return errors.New(errNotPrivateEndpoint)
+	}
+
+	// Azure deletes the DNS zone group, and its records, with the endpoint.
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePrivateEndpoint)
+}
+
+// getZoneGroup returns the DNS zone group of the supplied PrivateEndpoint, or
+// nil if it does not exist.
+func (e *external) getZoneGroup(ctx context.Context, cr *v1alpha3.PrivateEndpoint) (*azurenetwork.PrivateDNSZoneGroup, error) {
+	zg, err := e.zoneGroups.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), network.PrivateDNSZoneGroupName)
+	if azureclients.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPrivateDNSZoneGroup)
+	}
+	return &zg, nil
+}
@@ -0,0 +1,604 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	databasev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	networkclient "github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "cool-endpoint"
+	resourceGroupName = "cool-rg"
+	location          = "westeurope"
+	subnetID          = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Network/virtualNetworks/cool-vnet/subnets/cool-subnet"
+	storageAccountID  = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Storage/storageAccounts/cool"
+	cosmosDBAccountID = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.DocumentDB/databaseAccounts/cool"
+	zoneID            = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"
+	id                = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Network/privateEndpoints/cool-endpoint"
+	fqdn              = "cool.privatelink.blob.core.windows.net"
+	ipAddress         = "10.0.0.4"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type endpointModifier func(*v1alpha3.PrivateEndpoint)
+
+func withConditions(c ...xpv1.Condition) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withZone(id string) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Spec.ForProvider.PrivateDNSZoneID = azure.ToStringPtr(id) }
+}
+
+func withCosmosDBAccount(ref string, id string) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) {
+		r.Spec.ForProvider.CosmosDBAccountRef = &xpv1.Reference{Name: ref}
+		r.Spec.ForProvider.PrivateLinkResourceID = id
+	}
+}
+
+func withPrivateZone(ref string) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) {
+		r.Spec.ForProvider.PrivateDNSZoneIDRef = &xpv1.Reference{Name: ref}
+	}
+}
+
+func withTags(t map[string]string) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Spec.ForProvider.Tags = t }
+}
+
+func withManagedTags(keys string) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withObservation(o v1alpha3.PrivateEndpointObservation) endpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Status.AtProvider = o }
+}
+
+func endpoint(m ...endpointModifier) *v1alpha3.PrivateEndpoint {
+	r := &v1alpha3.PrivateEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.PrivateEndpointSpec{
+			ForProvider: v1alpha3.PrivateEndpointParameters{
+				ResourceGroupName:     resourceGroupName,
+				Location:              location,
+				SubnetID:              subnetID,
+				PrivateLinkResourceID: storageAccountID,
+				GroupIDs:              []string{"blob"},
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azureEndpoint(connectionState string) network.PrivateEndpoint {
+	return network.PrivateEndpoint{
+		ID:       azure.ToStringPtr(id),
+		Location: azure.ToStringPtr(location),
+		Tags:     map[string]*string{"env": azure.ToStringPtr("prod")},
+		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+			ProvisioningState: network.ProvisioningStateSucceeded,
+			PrivateLinkServiceConnections: &[]network.PrivateLinkServiceConnection{{
+				PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+					PrivateLinkServiceID: azure.ToStringPtr(storageAccountID),
+					PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{
+						Status: azure.ToStringPtr(connectionState),
+					},
+				},
+			}},
+		},
+	}
+}
+
+func azureZoneGroup() network.PrivateDNSZoneGroup {
+	zg := networkclient.NewPrivateDNSZoneGroupParameters(zoneID)
+	(*zg.PrivateDNSZoneConfigs)[0].RecordSets = &[]network.RecordSet{{
+		Fqdn:        azure.ToStringPtr(fqdn),
+		IPAddresses: &[]string{ipAddress},
+	}}
+	return zg
+}
+
+func zoneGroupNotFound(_ context.Context, _, _, _ string) (network.PrivateDNSZoneGroup, error) {
+	return network.PrivateDNSZoneGroup{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+}
+
+func TestResolveReferences(t *testing.T) {
+	errGet := errors.Wrap(errors.Wrap(errors.Wrap(errorBoom, "cannot get referenced resource"), "spec.forProvider.privateLinkResourceID"), errResolveReferences)
+
+	cases := map[string]struct {
+		c    *test.MockClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotPrivateEndpoint": {
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotPrivateEndpoint),
+		},
+		"AlreadyResolved": {
+			c:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			r:    endpoint(),
+			want: endpoint(),
+		},
+		"CosmosDBAccount": {
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					a := obj.(*databasev1alpha3.CosmosDBAccount)
+					a.Status.AtProvider = &databasev1alpha3.CosmosDBAccountObservation{ID: cosmosDBAccountID}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			r:    endpoint(withCosmosDBAccount("cool-account", "")),
+			want: endpoint(withCosmosDBAccount("cool-account", cosmosDBAccountID)),
+		},
+		"PrivateZone": {
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					z := obj.(*dnsv1alpha1.PrivateZone)
+					z.Status.AtProvider.ID = zoneID
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			r:    endpoint(withPrivateZone("privatelink.blob.core.windows.net")),
+			want: endpoint(withPrivateZone("privatelink.blob.core.windows.net"), withZone(zoneID)),
+		},
+		"ErrGetCosmosDBAccount": {
+			c:    &test.MockClient{MockGet: test.NewMockGetFn(errorBoom)},
+			r:    endpoint(withCosmosDBAccount("cool-account", "")),
+			want: endpoint(withCosmosDBAccount("cool-account", "")),
+			err:  errGet,
+		},
+		"ErrUpdate": {
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					a := obj.(*databasev1alpha3.CosmosDBAccount)
+					a.Status.AtProvider = &databasev1alpha3.CosmosDBAccountObservation{ID: cosmosDBAccountID}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(errorBoom),
+			},
+			r:    endpoint(withCosmosDBAccount("cool-account", "")),
+			want: endpoint(withCosmosDBAccount("cool-account", cosmosDBAccountID)),
+			err:  errors.Wrap(errorBoom, errUpdateManaged),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &referenceResolver{client: tc.c}
+			err := r.ResolveReferences(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveReferences(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, tc.r); diff != "" {
+				t.Errorf("ResolveReferences(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotPrivateEndpoint": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotPrivateEndpoint),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PrivateEndpoint, error) {
+					return network.PrivateEndpoint{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: endpoint(),
+			want: want{
+				cr: endpoint(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PrivateEndpoint, error) {
+					return network.PrivateEndpoint{}, errorBoom
+				},
+			}},
+			r: endpoint(),
+			want: want{
+				cr:  endpoint(),
+				err: errors.Wrap(errorBoom, errGetPrivateEndpoint),
+			},
+		},
+		"GetZoneGroupFailed": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _, _, _ string) (network.PrivateEndpoint, error) {
+						return azureEndpoint(connectionApproved), nil
+					},
+				},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: func(_ context.Context, _, _, _ string) (network.PrivateDNSZoneGroup, error) {
+						return network.PrivateDNSZoneGroup{}, errorBoom
+					},
+				},
+			},
+			r: endpoint(),
+			want: want{
+				cr:  endpoint(),
+				err: errors.Wrap(errorBoom, errGetPrivateDNSZoneGroup),
+			},
+		},
+		"ApprovedAndRegistered": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, rg, n, _ string) (network.PrivateEndpoint, error) {
+						if rg != resourceGroupName || n != name {
+							t.Errorf("Get(...): unexpected arguments %q, %q", rg, n)
+						}
+						return azureEndpoint(connectionApproved), nil
+					},
+				},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: func(_ context.Context, rg, n, zg string) (network.PrivateDNSZoneGroup, error) {
+						if rg != resourceGroupName || n != name || zg != networkclient.PrivateDNSZoneGroupName {
+							t.Errorf("Get(...): unexpected arguments %q, %q, %q", rg, n, zg)
+						}
+						return azureZoneGroup(), nil
+					},
+				},
+			},
+			r: endpoint(withZone(zoneID)),
+			want: want{
+				cr: endpoint(
+					withZone(zoneID),
+					withTags(map[string]string{"env": "prod"}),
+					withManagedTags(`["env"]`),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.PrivateEndpointObservation{
+						State:            string(network.ProvisioningStateSucceeded),
+						ConnectionState:  connectionApproved,
+						PrivateIPAddress: ipAddress,
+						FQDN:             fqdn,
+						DNSConfigs:       []v1alpha3.PrivateEndpointDNSConfig{{FQDN: fqdn, IPAddresses: []string{ipAddress}}},
+						ID:               id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PendingAndNotRegistered": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _, _, _ string) (network.PrivateEndpoint, error) {
+						return azureEndpoint("Pending"), nil
+					},
+				},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{MockGet: zoneGroupNotFound},
+			},
+			r: endpoint(withZone(zoneID), withTags(map[string]string{"env": "prod"})),
+			want: want{
+				cr: endpoint(
+					withZone(zoneID),
+					withTags(map[string]string{"env": "prod"}),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.PrivateEndpointObservation{
+						State:           string(network.ProvisioningStateSucceeded),
+						ConnectionState: "Pending",
+						ID:              id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotPrivateEndpoint": {
+			e:    &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotPrivateEndpoint),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, n string, p network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q", rg, n)
+					}
+					if diff := cmp.Diff(azure.ToStringPtr(subnetID), p.Subnet.ID); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.PrivateEndpointsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    endpoint(),
+			want: endpoint(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+					return network.PrivateEndpointsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    endpoint(),
+			want: endpoint(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreatePrivateEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	getEndpoint := func(_ context.Context, _, _, _ string) (network.PrivateEndpoint, error) {
+		return azureEndpoint(connectionApproved), nil
+	}
+	getZoneGroup := func(_ context.Context, _, _, _ string) (network.PrivateDNSZoneGroup, error) {
+		return azureZoneGroup(), nil
+	}
+
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotPrivateEndpoint": {
+			e:   &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotPrivateEndpoint),
+		},
+		"UpdateTags": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: getEndpoint,
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, p network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+						if diff := cmp.Diff(map[string]*string{"env": azure.ToStringPtr("test")}, p.Tags); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						return network.PrivateEndpointsCreateOrUpdateFuture{}, nil
+					},
+				},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{MockGet: zoneGroupNotFound},
+			},
+			r: endpoint(withTags(map[string]string{"env": "test"})),
+		},
+		"UpdateTagsFailed": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: getEndpoint,
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+						return network.PrivateEndpointsCreateOrUpdateFuture{}, errorBoom
+					},
+				},
+			},
+			r:   endpoint(withTags(map[string]string{"env": "test"})),
+			err: errors.Wrap(errorBoom, errUpdatePrivateEndpoint),
+		},
+		"CreateZoneGroup": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{MockGet: getEndpoint},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: zoneGroupNotFound,
+					MockCreateOrUpdate: func(_ context.Context, rg, n, zg string, p network.PrivateDNSZoneGroup) (network.PrivateDNSZoneGroupsCreateOrUpdateFuture, error) {
+						if rg != resourceGroupName || n != name || zg != networkclient.PrivateDNSZoneGroupName {
+							t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q, %q", rg, n, zg)
+						}
+						if diff := cmp.Diff(networkclient.NewPrivateDNSZoneGroupParameters(zoneID), p); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						return network.PrivateDNSZoneGroupsCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			r: endpoint(withZone(zoneID), withTags(map[string]string{"env": "prod"})),
+		},
+		"CreateZoneGroupFailed": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{MockGet: getEndpoint},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: zoneGroupNotFound,
+					MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.PrivateDNSZoneGroup) (network.PrivateDNSZoneGroupsCreateOrUpdateFuture, error) {
+						return network.PrivateDNSZoneGroupsCreateOrUpdateFuture{}, errorBoom
+					},
+				},
+			},
+			r:   endpoint(withZone(zoneID), withTags(map[string]string{"env": "prod"})),
+			err: errors.Wrap(errorBoom, errUpdatePrivateDNSZoneGroup),
+		},
+		"DeleteZoneGroup": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{MockGet: getEndpoint},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: getZoneGroup,
+					MockDelete: func(_ context.Context, _, _, _ string) (network.PrivateDNSZoneGroupsDeleteFuture, error) {
+						return network.PrivateDNSZoneGroupsDeleteFuture{}, nil
+					},
+				},
+			},
+			r: endpoint(withTags(map[string]string{"env": "prod"})),
+		},
+		"DeleteZoneGroupFailed": {
+			e: &external{
+				client: &fake.MockPrivateEndpointsClient{MockGet: getEndpoint},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: getZoneGroup,
+					MockDelete: func(_ context.Context, _, _, _ string) (network.PrivateDNSZoneGroupsDeleteFuture, error) {
+						return network.PrivateDNSZoneGroupsDeleteFuture{}, errorBoom
+					},
+				},
+			},
+			r:   endpoint(withTags(map[string]string{"env": "prod"})),
+			err: errors.Wrap(errorBoom, errDeletePrivateDNSZoneGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotPrivateEndpoint": {
+			e:    &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotPrivateEndpoint),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, nil
+				},
+			}},
+			r:    endpoint(),
+			want: endpoint(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    endpoint(),
+			want: endpoint(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    endpoint(),
+			want: endpoint(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeletePrivateEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -83,14 +83,19 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := azurenetwork.NewPublicIPAddressesClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl := azurenetwork.NewPublicIPAddressesClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{kube: c.client, client: cl}, nil
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.client, client: cl, defaults: d}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client networkapi.PublicIPAddressesClientAPI
+	kube     client.Client
+	client   networkapi.PublicIPAddressesClientAPI
+	defaults azureclients.ResourceDefaults
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -104,7 +109,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetPublicIPAddress)
 	}
 
-	network.LateInitializePublicIPAddress(&s.Spec.ForProvider, &az)
+	network.LateInitializePublicIPAddress(&s.Spec.ForProvider, &az, e.defaults)
+	upToDate := network.IsPublicIPAddressUpToDate(s.Spec.ForProvider, az, e.defaults)
+	if upToDate {
+		azureclients.SetManagedTags(s, e.defaults.MergeTags(s.Spec.ForProvider.Tags))
+	}
 	if err := e.kube.Update(ctx, s); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
 	}
@@ -114,7 +123,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: network.IsPublicIPAddressUpToDate(s.Spec.ForProvider, az),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -124,7 +133,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotPublicIPAddress)
 	}
 
-	snet := network.NewPublicIPAddressParameters(s)
+	snet := network.NewPublicIPAddressParameters(s, e.defaults)
 	if _, err := e.client.CreateOrUpdate(ctx, s.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(s), snet); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePublicIPAddress)
 	}
@@ -138,7 +147,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotPublicIPAddress)
 	}
 
-	snet := network.NewPublicIPAddressParameters(cr)
+	snet := network.NewPublicIPAddressParameters(cr, e.defaults)
 	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), snet)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePublicIPAddress)
 }
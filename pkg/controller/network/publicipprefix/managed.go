@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicipprefix
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotPublicIPPrefix    = "managed resource is not a PublicIPPrefix"
+	errCreatePublicIPPrefix = "cannot create PublicIPPrefix"
+	errUpdatePublicIPPrefix = "cannot update PublicIPPrefix"
+	errGetPublicIPPrefix    = "cannot get PublicIPPrefix"
+	errDeletePublicIPPrefix = "cannot delete PublicIPPrefix"
+)
+
+// Setup adds a controller that reconciles PublicIPPrefixes.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.PublicIPPrefixGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.PublicIPPrefix{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.PublicIPPrefixGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewPublicIPPrefixesClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, defaults: d}, nil
+}
+
+type external struct {
+	client   networkapi.PublicIPPrefixesClientAPI
+	defaults azureclients.ResourceDefaults
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.PublicIPPrefix)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPublicIPPrefix)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetPublicIPPrefix)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	network.LateInitializePublicIPPrefix(&cr.Spec.ForProvider, az, e.defaults)
+
+	cr.Status.AtProvider = network.GeneratePublicIPPrefixObservation(az)
+	switch azurenetwork.ProvisioningState(cr.Status.AtProvider.State) {
+	case azurenetwork.Succeeded:
+		cr.SetConditions(xpv1.Available())
+	case azurenetwork.Deleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := network.IsPublicIPPrefixUpToDate(cr.Spec.ForProvider, az, e.defaults)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+	if upToDate {
+		li = azureclients.SetManagedTags(cr, e.defaults.MergeTags(cr.Spec.ForProvider.Tags)) || li
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.PublicIPPrefix)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPublicIPPrefix)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	prefix := network.NewPublicIPPrefixParameters(cr.Spec.ForProvider, e.defaults)
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), prefix)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePublicIPPrefix)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.PublicIPPrefix)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPublicIPPrefix)
+	}
+
+	// Only tags are updatable. The public IP prefix is read first so that its
+	// current tags can be updated.
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPublicIPPrefix)
+	}
+	tags := network.NewPublicIPPrefixTags(cr.Spec.ForProvider, az, e.defaults)
+	_, err = e.client.UpdateTags(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), tags)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePublicIPPrefix)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.PublicIPPrefix)
+	if !ok {
+		return errors.New(errNotPublicIPPrefix)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePublicIPPrefix)
+}
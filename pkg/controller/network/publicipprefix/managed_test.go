@@ -0,0 +1,376 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicipprefix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolPrefix"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/publicIPPrefixes/coolPrefix"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type publicIPPrefixModifier func(*v1alpha3.PublicIPPrefix)
+
+func withConditions(c ...xpv1.Condition) publicIPPrefixModifier {
+	return func(r *v1alpha3.PublicIPPrefix) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(t map[string]string) publicIPPrefixModifier {
+	return func(r *v1alpha3.PublicIPPrefix) { r.Spec.ForProvider.Tags = t }
+}
+
+func withManagedTags(keys string) publicIPPrefixModifier {
+	return func(r *v1alpha3.PublicIPPrefix) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withObservation(o v1alpha3.PublicIPPrefixObservation) publicIPPrefixModifier {
+	return func(r *v1alpha3.PublicIPPrefix) { r.Status.AtProvider = o }
+}
+
+func publicIPPrefix(m ...publicIPPrefixModifier) *v1alpha3.PublicIPPrefix {
+	r := &v1alpha3.PublicIPPrefix{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.PublicIPPrefixSpec{
+			ForProvider: v1alpha3.PublicIPPrefixParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				PrefixLength:      28,
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotPublicIPPrefix": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotPublicIPPrefix),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: publicIPPrefix(),
+			want: want{
+				cr: publicIPPrefix(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{}, errorBoom
+				},
+			}},
+			r: publicIPPrefix(),
+			want: want{
+				cr:  publicIPPrefix(),
+				err: errors.Wrap(errorBoom, errGetPublicIPPrefix),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{
+						ID:   azure.ToStringPtr(id),
+						Tags: map[string]*string{"env": azure.ToStringPtr("test")},
+						PublicIPPrefixPropertiesFormat: &network.PublicIPPrefixPropertiesFormat{
+							ProvisioningState: azure.ToStringPtr(string(network.Succeeded)),
+						},
+					}, nil
+				},
+			}},
+			r: publicIPPrefix(),
+			want: want{
+				cr: publicIPPrefix(
+					withTags(map[string]string{"env": "test"}),
+					withManagedTags(`["env"]`),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.PublicIPPrefixObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{
+						ID:   azure.ToStringPtr(id),
+						Tags: map[string]*string{"env": azure.ToStringPtr("test")},
+						PublicIPPrefixPropertiesFormat: &network.PublicIPPrefixPropertiesFormat{
+							ProvisioningState: azure.ToStringPtr(string(network.Updating)),
+						},
+					}, nil
+				},
+			}},
+			r: publicIPPrefix(withTags(map[string]string{"env": "prod"})),
+			want: want{
+				cr: publicIPPrefix(
+					withTags(map[string]string{"env": "prod"}),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.PublicIPPrefixObservation{
+						State: string(network.Updating),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotPublicIPPrefix": {
+			e:    &external{client: &fake.MockPublicIPPrefixesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotPublicIPPrefix),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, p network.PublicIPPrefix) (network.PublicIPPrefixesCreateOrUpdateFuture, error) {
+					if diff := cmp.Diff(azure.ToStringPtr(location), p.Location); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want location, +got location:\n%s", diff)
+					}
+					return network.PublicIPPrefixesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    publicIPPrefix(),
+			want: publicIPPrefix(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.PublicIPPrefix) (network.PublicIPPrefixesCreateOrUpdateFuture, error) {
+					return network.PublicIPPrefixesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    publicIPPrefix(),
+			want: publicIPPrefix(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreatePublicIPPrefix),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotPublicIPPrefix": {
+			e:   &external{client: &fake.MockPublicIPPrefixesClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotPublicIPPrefix),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{}, errorBoom
+				},
+			}},
+			r:   publicIPPrefix(),
+			err: errors.Wrap(errorBoom, errGetPublicIPPrefix),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{Tags: map[string]*string{"external": azure.ToStringPtr("true")}}, nil
+				},
+				MockUpdateTags: func(_ context.Context, _, _ string, p network.TagsObject) (network.PublicIPPrefixesUpdateTagsFuture, error) {
+					want := map[string]*string{"external": azure.ToStringPtr("true"), "env": azure.ToStringPtr("prod")}
+					if diff := cmp.Diff(want, p.Tags); diff != "" {
+						t.Errorf("UpdateTags(...): -want tags, +got tags:\n%s", diff)
+					}
+					return network.PublicIPPrefixesUpdateTagsFuture{}, nil
+				},
+			}},
+			r: publicIPPrefix(withTags(map[string]string{"env": "prod"})),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.PublicIPPrefix, error) {
+					return network.PublicIPPrefix{}, nil
+				},
+				MockUpdateTags: func(_ context.Context, _, _ string, _ network.TagsObject) (network.PublicIPPrefixesUpdateTagsFuture, error) {
+					return network.PublicIPPrefixesUpdateTagsFuture{}, errorBoom
+				},
+			}},
+			r:   publicIPPrefix(),
+			err: errors.Wrap(errorBoom, errUpdatePublicIPPrefix),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotPublicIPPrefix": {
+			e:    &external{client: &fake.MockPublicIPPrefixesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotPublicIPPrefix),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PublicIPPrefixesDeleteFuture, error) {
+					return network.PublicIPPrefixesDeleteFuture{}, nil
+				},
+			}},
+			r:    publicIPPrefix(),
+			want: publicIPPrefix(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PublicIPPrefixesDeleteFuture, error) {
+					return network.PublicIPPrefixesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    publicIPPrefix(),
+			want: publicIPPrefix(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockPublicIPPrefixesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.PublicIPPrefixesDeleteFuture, error) {
+					return network.PublicIPPrefixesDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    publicIPPrefix(),
+			want: publicIPPrefix(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeletePublicIPPrefix),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "to-firewall"
+	resourceGroupName = "coolRG"
+	routeTableName    = "coolRouteTable"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/routeTables/coolRouteTable/routes/to-firewall"
+	firewallIP        = "10.0.0.4"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type routeModifier func(*v1alpha3.Route)
+
+func withConditions(c ...xpv1.Condition) routeModifier {
+	return func(r *v1alpha3.Route) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withNextHopIPAddress(ip string) routeModifier {
+	return func(r *v1alpha3.Route) { r.Spec.ForProvider.NextHopIPAddress = azure.ToStringPtr(ip) }
+}
+
+func withObservation(o v1alpha3.RouteObservation) routeModifier {
+	return func(r *v1alpha3.Route) { r.Status.AtProvider = o }
+}
+
+func route(m ...routeModifier) *v1alpha3.Route {
+	r := &v1alpha3.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.RouteSpec{
+			ForProvider: v1alpha3.RouteParameters{
+				ResourceGroupName: resourceGroupName,
+				RouteTableName:    routeTableName,
+				AddressPrefix:     "0.0.0.0/0",
+				NextHopType:       string(network.RouteNextHopTypeVirtualAppliance),
+				NextHopIPAddress:  azure.ToStringPtr(firewallIP),
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// azureRoute returns the Azure representation of route().
+func azureRoute() network.Route {
+	return network.Route{
+		ID: azure.ToStringPtr(id),
+		RoutePropertiesFormat: &network.RoutePropertiesFormat{
+			AddressPrefix:     azure.ToStringPtr("0.0.0.0/0"),
+			NextHopType:       network.RouteNextHopTypeVirtualAppliance,
+			NextHopIPAddress:  azure.ToStringPtr(firewallIP),
+			ProvisioningState: azure.ToStringPtr(string(network.Succeeded)),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotRoute": {
+			e: &external{client: &fake.MockRoutesClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotRoute),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Route, error) {
+					return network.Route{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: route(),
+			want: want{
+				cr: route(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Route, error) {
+					return network.Route{}, errorBoom
+				},
+			}},
+			r: route(),
+			want: want{
+				cr:  route(),
+				err: errors.Wrap(errorBoom, errGetRoute),
+			},
+		},
+		"Available": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockGet: func(_ context.Context, rg, rt, n string) (network.Route, error) {
+					if rg != resourceGroupName || rt != routeTableName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q, %q", rg, rt, n)
+					}
+					return azureRoute(), nil
+				},
+			}},
+			r: route(),
+			want: want{
+				cr: route(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.RouteObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NextHopChanged": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.Route, error) {
+					return azureRoute(), nil
+				},
+			}},
+			r: route(withNextHopIPAddress("10.0.0.5")),
+			want: want{
+				cr: route(
+					withNextHopIPAddress("10.0.0.5"),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.RouteObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotRoute": {
+			e:    &external{client: &fake.MockRoutesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotRoute),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, rt, n string, _ network.Route) (network.RoutesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || rt != routeTableName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q, %q", rg, rt, n)
+					}
+					return network.RoutesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    route(),
+			want: route(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.Route) (network.RoutesCreateOrUpdateFuture, error) {
+					return network.RoutesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    route(),
+			want: route(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateRoute),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotRoute": {
+			e:   &external{client: &fake.MockRoutesClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotRoute),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.Route) (network.RoutesCreateOrUpdateFuture, error) {
+					return network.RoutesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: route(),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.Route) (network.RoutesCreateOrUpdateFuture, error) {
+					return network.RoutesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   route(),
+			err: errors.Wrap(errorBoom, errUpdateRoute),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotRoute": {
+			e:    &external{client: &fake.MockRoutesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotRoute),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.RoutesDeleteFuture, error) {
+					return network.RoutesDeleteFuture{}, nil
+				},
+			}},
+			r:    route(),
+			want: route(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.RoutesDeleteFuture, error) {
+					return network.RoutesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    route(),
+			want: route(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockRoutesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.RoutesDeleteFuture, error) {
+					return network.RoutesDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    route(),
+			want: route(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteRoute),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routetable
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolRouteTable"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/routeTables/coolRouteTable"
+	subnetID          = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/virtualNetworks/vnet/subnets/spoke"
+	routeID           = id + "/routes/to-firewall"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type routeTableModifier func(*v1alpha3.RouteTable)
+
+func withConditions(c ...xpv1.Condition) routeTableModifier {
+	return func(r *v1alpha3.RouteTable) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDisableBGPRoutePropagation(b bool) routeTableModifier {
+	return func(r *v1alpha3.RouteTable) { r.Spec.ForProvider.DisableBGPRoutePropagation = &b }
+}
+
+func withObservation(o v1alpha3.RouteTableObservation) routeTableModifier {
+	return func(r *v1alpha3.RouteTable) { r.Status.AtProvider = o }
+}
+
+func routeTable(m ...routeTableModifier) *v1alpha3.RouteTable {
+	r := &v1alpha3.RouteTable{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.RouteTableSpec{
+			ForProvider: v1alpha3.RouteTableParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azureRouteTable(state network.ProvisioningState, disableBGP bool) network.RouteTable {
+	return network.RouteTable{
+		ID:       azure.ToStringPtr(id),
+		Location: azure.ToStringPtr(location),
+		RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{
+			DisableBgpRoutePropagation: azure.ToBoolPtr(disableBGP, azure.FieldRequired),
+			Routes:                     &[]network.Route{{ID: azure.ToStringPtr(routeID)}},
+			Subnets:                    &[]network.Subnet{{ID: azure.ToStringPtr(subnetID)}},
+			ProvisioningState:          azure.ToStringPtr(string(state)),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotRouteTable": {
+			e: &external{client: &fake.MockRouteTablesClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotRouteTable),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return network.RouteTable{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: routeTable(),
+			want: want{
+				cr: routeTable(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return network.RouteTable{}, errorBoom
+				},
+			}},
+			r: routeTable(),
+			want: want{
+				cr:  routeTable(),
+				err: errors.Wrap(errorBoom, errGetRouteTable),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return azureRouteTable(network.Succeeded, false), nil
+				},
+			}},
+			r: routeTable(),
+			want: want{
+				cr: routeTable(
+					withDisableBGPRoutePropagation(false),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.RouteTableObservation{
+						State:     string(network.Succeeded),
+						ID:        id,
+						SubnetIDs: []string{subnetID},
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"BGPRoutePropagationChanged": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return azureRouteTable(network.Updating, false), nil
+				},
+			}},
+			r: routeTable(withDisableBGPRoutePropagation(true)),
+			want: want{
+				cr: routeTable(
+					withDisableBGPRoutePropagation(true),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.RouteTableObservation{
+						State:     string(network.Updating),
+						ID:        id,
+						SubnetIDs: []string{subnetID},
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotRouteTable": {
+			e:    &external{client: &fake.MockRouteTablesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotRouteTable),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, p network.RouteTable) (network.RouteTablesCreateOrUpdateFuture, error) {
+					if diff := cmp.Diff(azure.ToBoolPtr(true), p.DisableBgpRoutePropagation); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.RouteTablesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    routeTable(withDisableBGPRoutePropagation(true)),
+			want: routeTable(withDisableBGPRoutePropagation(true), withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.RouteTable) (network.RouteTablesCreateOrUpdateFuture, error) {
+					return network.RouteTablesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    routeTable(),
+			want: routeTable(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateRouteTable),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotRouteTable": {
+			e:   &external{client: &fake.MockRouteTablesClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotRouteTable),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return network.RouteTable{}, errorBoom
+				},
+			}},
+			r:   routeTable(),
+			err: errors.Wrap(errorBoom, errGetRouteTable),
+		},
+		"RoutesRetained": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return azureRouteTable(network.Succeeded, false), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, p network.RouteTable) (network.RouteTablesCreateOrUpdateFuture, error) {
+					want := network.RouteTable{
+						Location: azure.ToStringPtr(location),
+						Tags:     map[string]*string{},
+						RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{
+							DisableBgpRoutePropagation: azure.ToBoolPtr(true),
+							Routes:                     &[]network.Route{{ID: azure.ToStringPtr(routeID)}},
+						},
+					}
+					if diff := cmp.Diff(want, p); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.RouteTablesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: routeTable(withDisableBGPRoutePropagation(true)),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.RouteTable, error) {
+					return network.RouteTable{}, nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.RouteTable) (network.RouteTablesCreateOrUpdateFuture, error) {
+					return network.RouteTablesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   routeTable(),
+			err: errors.Wrap(errorBoom, errUpdateRouteTable),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotRouteTable": {
+			e:    &external{client: &fake.MockRouteTablesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotRouteTable),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.RouteTablesDeleteFuture, error) {
+					return network.RouteTablesDeleteFuture{}, nil
+				},
+			}},
+			r:    routeTable(),
+			want: routeTable(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.RouteTablesDeleteFuture, error) {
+					return network.RouteTablesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    routeTable(),
+			want: routeTable(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockRouteTablesClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.RouteTablesDeleteFuture, error) {
+					return network.RouteTablesDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    routeTable(),
+			want: routeTable(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteRouteTable),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolSecurityGroup"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/networkSecurityGroups/coolSecurityGroup"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type securityGroupModifier func(*v1alpha3.SecurityGroup)
+
+func withConditions(c ...xpv1.Condition) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(t map[string]string) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Spec.ForProvider.Tags = t }
+}
+
+func withManagedTags(keys string) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withObservation(o v1alpha3.SecurityGroupObservation) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Status.AtProvider = o }
+}
+
+func securityGroup(m ...securityGroupModifier) *v1alpha3.SecurityGroup {
+	r := &v1alpha3.SecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.SecurityGroupSpec{
+			ForProvider: v1alpha3.SecurityGroupParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotSecurityGroup": {
+			e: &external{client: &fake.MockSecurityGroupsClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotSecurityGroup),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: securityGroup(),
+			want: want{
+				cr: securityGroup(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, errorBoom
+				},
+			}},
+			r: securityGroup(),
+			want: want{
+				cr:  securityGroup(),
+				err: errors.Wrap(errorBoom, errGetSecurityGroup),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{
+						ID:   azure.ToStringPtr(id),
+						Tags: map[string]*string{"env": azure.ToStringPtr("test")},
+						SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
+							ProvisioningState: azure.ToStringPtr(string(network.Succeeded)),
+						},
+					}, nil
+				},
+			}},
+			r: securityGroup(),
+			want: want{
+				cr: securityGroup(
+					withTags(map[string]string{"env": "test"}),
+					withManagedTags(`["env"]`),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.SecurityGroupObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{
+						ID:   azure.ToStringPtr(id),
+						Tags: map[string]*string{"env": azure.ToStringPtr("test")},
+						SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
+							ProvisioningState: azure.ToStringPtr(string(network.Updating)),
+						},
+					}, nil
+				},
+			}},
+			r: securityGroup(withTags(map[string]string{"env": "prod"})),
+			want: want{
+				cr: securityGroup(
+					withTags(map[string]string{"env": "prod"}),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.SecurityGroupObservation{
+						State: string(network.Updating),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotSecurityGroup": {
+			e:    &external{client: &fake.MockSecurityGroupsClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotSecurityGroup),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, p network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					if diff := cmp.Diff(azure.ToStringPtr(location), p.Location); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want location, +got location:\n%s", diff)
+					}
+					return network.SecurityGroupsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					return network.SecurityGroupsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateSecurityGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotSecurityGroup": {
+			e:   &external{client: &fake.MockSecurityGroupsClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotSecurityGroup),
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, errorBoom
+				},
+			}},
+			r:   securityGroup(),
+			err: errors.Wrap(errorBoom, errGetSecurityGroup),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{Tags: map[string]*string{"external": azure.ToStringPtr("true")}}, nil
+				},
+				MockUpdateTags: func(_ context.Context, _, _ string, p network.TagsObject) (network.SecurityGroupsUpdateTagsFuture, error) {
+					want := map[string]*string{"external": azure.ToStringPtr("true"), "env": azure.ToStringPtr("prod")}
+					if diff := cmp.Diff(want, p.Tags); diff != "" {
+						t.Errorf("UpdateTags(...): -want tags, +got tags:\n%s", diff)
+					}
+					return network.SecurityGroupsUpdateTagsFuture{}, nil
+				},
+			}},
+			r: securityGroup(withTags(map[string]string{"env": "prod"})),
+		},
+		"UpdateFailed": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, nil
+				},
+				MockUpdateTags: func(_ context.Context, _, _ string, _ network.TagsObject) (network.SecurityGroupsUpdateTagsFuture, error) {
+					return network.SecurityGroupsUpdateTagsFuture{}, errorBoom
+				},
+			}},
+			r:   securityGroup(),
+			err: errors.Wrap(errorBoom, errUpdateSecurityGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotSecurityGroup": {
+			e:    &external{client: &fake.MockSecurityGroupsClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotSecurityGroup),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, nil
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteSecurityGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "allow-https"
+	resourceGroupName = "coolRG"
+	securityGroupName = "coolSecurityGroup"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/networkSecurityGroups/coolSecurityGroup/securityRules/allow-https"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type securityRuleModifier func(*v1alpha3.SecurityRule)
+
+func withConditions(c ...xpv1.Condition) securityRuleModifier {
+	return func(r *v1alpha3.SecurityRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) securityRuleModifier {
+	return func(r *v1alpha3.SecurityRule) { r.Spec.ForProvider.Description = azure.ToStringPtr(d) }
+}
+
+func withSourceAddressPrefixes(p ...string) securityRuleModifier {
+	return func(r *v1alpha3.SecurityRule) { r.Spec.ForProvider.SourceAddressPrefixes = p }
+}
+
+func withObservation(o v1alpha3.SecurityRuleObservation) securityRuleModifier {
+	return func(r *v1alpha3.SecurityRule) { r.Status.AtProvider = o }
+}
+
+func securityRule(m ...securityRuleModifier) *v1alpha3.SecurityRule {
+	r := &v1alpha3.SecurityRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.SecurityRuleSpec{
+			ForProvider: v1alpha3.SecurityRuleParameters{
+				ResourceGroupName:          resourceGroupName,
+				SecurityGroupName:          securityGroupName,
+				Priority:                   100,
+				Direction:                  string(network.SecurityRuleDirectionInbound),
+				Access:                     string(network.SecurityRuleAccessAllow),
+				Protocol:                   string(network.SecurityRuleProtocolTCP),
+				SourcePortRanges:           []string{"*"},
+				DestinationPortRanges:      []string{"443"},
+				SourceAddressPrefixes:      []string{"10.0.0.0/24", "10.0.1.0/24"},
+				DestinationAddressPrefixes: []string{"VirtualNetwork"},
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// azureSecurityRule returns the Azure representation of securityRule().
+func azureSecurityRule() network.SecurityRule {
+	return network.SecurityRule{
+		ID: azure.ToStringPtr(id),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Description:              azure.ToStringPtr("allow https"),
+			Priority:                 azure.ToInt32Ptr(100),
+			Direction:                network.SecurityRuleDirectionInbound,
+			Access:                   network.SecurityRuleAccessAllow,
+			Protocol:                 network.SecurityRuleProtocolTCP,
+			SourcePortRange:          azure.ToStringPtr("*"),
+			DestinationPortRange:     azure.ToStringPtr("443"),
+			SourceAddressPrefixes:    &[]string{"10.0.1.0/24", "10.0.0.0/24"},
+			DestinationAddressPrefix: azure.ToStringPtr("VirtualNetwork"),
+			ProvisioningState:        azure.ToStringPtr(string(network.Succeeded)),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotSecurityRule": {
+			e: &external{client: &fake.MockSecurityRulesClient{}},
+			r: &v1alpha3.Subnet{},
+			want: want{
+				cr:  &v1alpha3.Subnet{},
+				err: errors.New(errNotSecurityRule),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityRule, error) {
+					return network.SecurityRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: securityRule(),
+			want: want{
+				cr: securityRule(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityRule, error) {
+					return network.SecurityRule{}, errorBoom
+				},
+			}},
+			r: securityRule(),
+			want: want{
+				cr:  securityRule(),
+				err: errors.Wrap(errorBoom, errGetSecurityRule),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockGet: func(_ context.Context, rg, nsg, n string) (network.SecurityRule, error) {
+					if rg != resourceGroupName || nsg != securityGroupName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q, %q", rg, nsg, n)
+					}
+					return azureSecurityRule(), nil
+				},
+			}},
+			r: securityRule(),
+			want: want{
+				cr: securityRule(
+					withDescription("allow https"),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.SecurityRuleObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PrefixesChanged": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.SecurityRule, error) {
+					return azureSecurityRule(), nil
+				},
+			}},
+			r: securityRule(withDescription("allow https"), withSourceAddressPrefixes("10.0.0.0/24")),
+			want: want{
+				cr: securityRule(
+					withDescription("allow https"),
+					withSourceAddressPrefixes("10.0.0.0/24"),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.SecurityRuleObservation{
+						State: string(network.Succeeded),
+						ID:    id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotSecurityRule": {
+			e:    &external{client: &fake.MockSecurityRulesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotSecurityRule),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, nsg, n string, _ network.SecurityRule) (network.SecurityRulesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || nsg != securityGroupName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q, %q", rg, nsg, n)
+					}
+					return network.SecurityRulesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    securityRule(),
+			want: securityRule(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.SecurityRule) (network.SecurityRulesCreateOrUpdateFuture, error) {
+					return network.SecurityRulesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    securityRule(),
+			want: securityRule(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateSecurityRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotSecurityRule": {
+			e:   &external{client: &fake.MockSecurityRulesClient{}},
+			r:   &v1alpha3.Subnet{},
+			err: errors.New(errNotSecurityRule),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.SecurityRule) (network.SecurityRulesCreateOrUpdateFuture, error) {
+					return network.SecurityRulesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: securityRule(),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.SecurityRule) (network.SecurityRulesCreateOrUpdateFuture, error) {
+					return network.SecurityRulesCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   securityRule(),
+			err: errors.Wrap(errorBoom, errUpdateSecurityRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotSecurityRule": {
+			e:    &external{client: &fake.MockSecurityRulesClient{}},
+			r:    &v1alpha3.Subnet{},
+			want: &v1alpha3.Subnet{},
+			err:  errors.New(errNotSecurityRule),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.SecurityRulesDeleteFuture, error) {
+					return network.SecurityRulesDeleteFuture{}, nil
+				},
+			}},
+			r:    securityRule(),
+			want: securityRule(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.SecurityRulesDeleteFuture, error) {
+					return network.SecurityRulesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    securityRule(),
+			want: securityRule(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockSecurityRulesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.SecurityRulesDeleteFuture, error) {
+					return network.SecurityRulesDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    securityRule(),
+			want: securityRule(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteSecurityRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -82,13 +82,18 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := azurenetwork.NewVirtualNetworksClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl := azurenetwork.NewVirtualNetworksClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{client: cl}, nil
+	d, err := azureclients.GetResourceDefaults(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, defaults: d}, nil
 }
 
 type external struct {
-	client networkapi.VirtualNetworksClientAPI
+	client   networkapi.VirtualNetworksClientAPI
+	defaults azureclients.ResourceDefaults
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -113,6 +118,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		ResourceExists:    true,
 		ConnectionDetails: managed.ConnectionDetails{},
 	}
+	if !network.VirtualNetworkNeedsUpdate(v, az, e.defaults) {
+		o.ResourceLateInitialized = azureclients.SetManagedTags(v, e.defaults.MergeTags(v.Spec.Tags))
+	}
 
 	return o, nil
 }
@@ -125,7 +133,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	v.Status.SetConditions(xpv1.Creating())
 
-	vnet := network.NewVirtualNetworkParameters(v)
+	vnet := network.NewVirtualNetworkParameters(v, e.defaults)
 	if _, err := e.client.CreateOrUpdate(ctx, v.Spec.ResourceGroupName, meta.GetExternalName(v), vnet); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateVirtualNetwork)
 	}
@@ -144,8 +152,8 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetVirtualNetwork)
 	}
 
-	if network.VirtualNetworkNeedsUpdate(v, az) {
-		vnet := network.NewVirtualNetworkParameters(v)
+	if network.VirtualNetworkNeedsUpdate(v, az, e.defaults) {
+		vnet := network.NewVirtualNetworkParameters(v, e.defaults)
 		if _, err := e.client.CreateOrUpdate(ctx, v.Spec.ResourceGroupName, meta.GetExternalName(v), vnet); err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVirtualNetwork)
 		}
@@ -71,6 +71,12 @@ func withState(s string) virtualNetworkModifier {
 	return func(r *v1alpha3.VirtualNetwork) { r.Status.State = s }
 }
 
+func withManagedTags(keys string) virtualNetworkModifier {
+	return func(r *v1alpha3.VirtualNetwork) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
 func virtualNetwork(vm ...virtualNetworkModifier) *v1alpha3.VirtualNetwork {
 	r := &v1alpha3.VirtualNetwork{
 		ObjectMeta: metav1.ObjectMeta{
@@ -205,6 +211,7 @@ func TestObserve(t *testing.T) {
 			}},
 			r: virtualNetwork(),
 			want: virtualNetwork(
+				withManagedTags(`["one","two"]`),
 				withConditions(xpv1.Available()),
 				withState(string(network.Available)),
 			),
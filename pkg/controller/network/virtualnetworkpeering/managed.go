@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualnetworkpeering
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network/networkapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotVirtualNetworkPeering    = "managed resource is not a VirtualNetworkPeering"
+	errCreateVirtualNetworkPeering = "cannot create VirtualNetworkPeering"
+	errUpdateVirtualNetworkPeering = "cannot update VirtualNetworkPeering"
+	errGetVirtualNetworkPeering    = "cannot get VirtualNetworkPeering"
+	errDeleteVirtualNetworkPeering = "cannot delete VirtualNetworkPeering"
+)
+
+// Setup adds a controller that reconciles VirtualNetworkPeerings.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.VirtualNetworkPeeringGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.VirtualNetworkPeering{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.VirtualNetworkPeeringGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewVirtualNetworkPeeringsClientWithBaseURI(creds[azureclients.CredentialsKeyResourceManagerEndpointURL], azureclients.SubscriptionID(mg, creds))
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client networkapi.VirtualNetworkPeeringsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.VirtualNetworkPeering)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVirtualNetworkPeering)
+	}
+
+	p := cr.Spec.ForProvider
+	az, err := e.client.Get(ctx, p.ResourceGroupName, p.VirtualNetworkName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errGetVirtualNetworkPeering)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	network.LateInitializeVirtualNetworkPeering(cr, az)
+
+	network.UpdateVirtualNetworkPeeringStatusFromAzure(cr, az)
+	// Traffic only flows once the remote virtual network is peered back.
+	switch {
+	case cr.Status.AtProvider.State == string(azurenetwork.ProvisioningStateDeleting):
+		cr.SetConditions(xpv1.Deleting())
+	case cr.Status.AtProvider.State == string(azurenetwork.ProvisioningStateSucceeded) &&
+		cr.Status.AtProvider.PeeringState == string(azurenetwork.VirtualNetworkPeeringStateConnected):
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !network.VirtualNetworkPeeringNeedsUpdate(cr, az),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.VirtualNetworkPeering)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVirtualNetworkPeering)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	_, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.VirtualNetworkName, meta.GetExternalName(cr), network.NewVirtualNetworkPeeringParameters(cr), "")
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateVirtualNetworkPeering)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.VirtualNetworkPeering)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVirtualNetworkPeering)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.VirtualNetworkName, meta.GetExternalName(cr), network.NewVirtualNetworkPeeringParameters(cr), "")
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVirtualNetworkPeering)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.VirtualNetworkPeering)
+	if !ok {
+		return errors.New(errNotVirtualNetworkPeering)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	p := cr.Spec.ForProvider
+	_, err := e.client.Delete(ctx, p.ResourceGroupName, p.VirtualNetworkName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeleteVirtualNetworkPeering)
+}
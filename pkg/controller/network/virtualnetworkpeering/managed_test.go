@@ -0,0 +1,373 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualnetworkpeering
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name               = "spoke-to-hub"
+	resourceGroupName  = "spoke-rg"
+	virtualNetworkName = "spoke"
+	remoteID           = "/subscriptions/hub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub"
+	id                 = "/subscriptions/spoke/resourceGroups/spoke-rg/providers/Microsoft.Network/virtualNetworks/spoke/virtualNetworkPeerings/spoke-to-hub"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+type peeringModifier func(*v1alpha3.VirtualNetworkPeering)
+
+func withConditions(c ...xpv1.Condition) peeringModifier {
+	return func(r *v1alpha3.VirtualNetworkPeering) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withFlags(access, forwarded, transit, remoteGateways bool) peeringModifier {
+	return func(r *v1alpha3.VirtualNetworkPeering) {
+		r.Spec.ForProvider.AllowVirtualNetworkAccess = &access
+		r.Spec.ForProvider.AllowForwardedTraffic = &forwarded
+		r.Spec.ForProvider.AllowGatewayTransit = &transit
+		r.Spec.ForProvider.UseRemoteGateways = &remoteGateways
+	}
+}
+
+func withObservation(o v1alpha3.VirtualNetworkPeeringObservation) peeringModifier {
+	return func(r *v1alpha3.VirtualNetworkPeering) { r.Status.AtProvider = o }
+}
+
+func peering(m ...peeringModifier) *v1alpha3.VirtualNetworkPeering {
+	r := &v1alpha3.VirtualNetworkPeering{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.VirtualNetworkPeeringSpec{
+			ForProvider: v1alpha3.VirtualNetworkPeeringParameters{
+				ResourceGroupName:      resourceGroupName,
+				VirtualNetworkName:     virtualNetworkName,
+				RemoteVirtualNetworkID: remoteID,
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func azurePeering(state network.VirtualNetworkPeeringState) network.VirtualNetworkPeering {
+	return network.VirtualNetworkPeering{
+		ID: azure.ToStringPtr(id),
+		VirtualNetworkPeeringPropertiesFormat: &network.VirtualNetworkPeeringPropertiesFormat{
+			RemoteVirtualNetwork:      &network.SubResource{ID: azure.ToStringPtr(remoteID)},
+			AllowVirtualNetworkAccess: azure.ToBoolPtr(true),
+			AllowForwardedTraffic:     azure.ToBoolPtr(false, azure.FieldRequired),
+			AllowGatewayTransit:       azure.ToBoolPtr(false, azure.FieldRequired),
+			UseRemoteGateways:         azure.ToBoolPtr(false, azure.FieldRequired),
+			PeeringState:              state,
+			PeeringSyncLevel:          network.VirtualNetworkPeeringLevelFullyInSync,
+			ProvisioningState:         network.ProvisioningStateSucceeded,
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want want
+	}{
+		"NotVirtualNetworkPeering": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{}},
+			r: &v1alpha3.VirtualNetwork{},
+			want: want{
+				cr:  &v1alpha3.VirtualNetwork{},
+				err: errors.New(errNotVirtualNetworkPeering),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeering, error) {
+					return network.VirtualNetworkPeering{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r: peering(),
+			want: want{
+				cr: peering(),
+			},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeering, error) {
+					return network.VirtualNetworkPeering{}, errorBoom
+				},
+			}},
+			r: peering(),
+			want: want{
+				cr:  peering(),
+				err: errors.Wrap(errorBoom, errGetVirtualNetworkPeering),
+			},
+		},
+		"ConnectedAndLateInitialized": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockGet: func(_ context.Context, rg, vnet, n string) (network.VirtualNetworkPeering, error) {
+					if rg != resourceGroupName || vnet != virtualNetworkName || n != name {
+						t.Errorf("Get(...): unexpected arguments %q, %q, %q", rg, vnet, n)
+					}
+					return azurePeering(network.VirtualNetworkPeeringStateConnected), nil
+				},
+			}},
+			r: peering(),
+			want: want{
+				cr: peering(
+					withFlags(true, false, false, false),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.VirtualNetworkPeeringObservation{
+						State:            string(network.ProvisioningStateSucceeded),
+						PeeringState:     string(network.VirtualNetworkPeeringStateConnected),
+						PeeringSyncLevel: string(network.VirtualNetworkPeeringLevelFullyInSync),
+						ID:               id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"InitiatedAndNeedsUpdate": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeering, error) {
+					return azurePeering(network.VirtualNetworkPeeringStateInitiated), nil
+				},
+			}},
+			r: peering(withFlags(true, true, false, false)),
+			want: want{
+				cr: peering(
+					withFlags(true, true, false, false),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.VirtualNetworkPeeringObservation{
+						State:            string(network.ProvisioningStateSucceeded),
+						PeeringState:     string(network.VirtualNetworkPeeringStateInitiated),
+						PeeringSyncLevel: string(network.VirtualNetworkPeeringLevelFullyInSync),
+						ID:               id,
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotVirtualNetworkPeering": {
+			e:    &external{client: &fake.MockVirtualNetworkPeeringsClient{}},
+			r:    &v1alpha3.VirtualNetwork{},
+			want: &v1alpha3.VirtualNetwork{},
+			err:  errors.New(errNotVirtualNetworkPeering),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, vnet, n string, p network.VirtualNetworkPeering, _ network.SyncRemoteAddressSpace) (network.VirtualNetworkPeeringsCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || vnet != virtualNetworkName || n != name {
+						t.Errorf("CreateOrUpdate(...): unexpected arguments %q, %q, %q", rg, vnet, n)
+					}
+					if diff := cmp.Diff(azure.ToStringPtr(remoteID), p.RemoteVirtualNetwork.ID); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.VirtualNetworkPeeringsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    peering(),
+			want: peering(withConditions(xpv1.Creating())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.VirtualNetworkPeering, _ network.SyncRemoteAddressSpace) (network.VirtualNetworkPeeringsCreateOrUpdateFuture, error) {
+					return network.VirtualNetworkPeeringsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:    peering(),
+			want: peering(withConditions(xpv1.Creating())),
+			err:  errors.Wrap(errorBoom, errCreateVirtualNetworkPeering),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e   managed.ExternalClient
+		r   resource.Managed
+		err error
+	}{
+		"NotVirtualNetworkPeering": {
+			e:   &external{client: &fake.MockVirtualNetworkPeeringsClient{}},
+			r:   &v1alpha3.VirtualNetwork{},
+			err: errors.New(errNotVirtualNetworkPeering),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, p network.VirtualNetworkPeering, _ network.SyncRemoteAddressSpace) (network.VirtualNetworkPeeringsCreateOrUpdateFuture, error) {
+					if diff := cmp.Diff(azure.ToBoolPtr(true), p.AllowForwardedTraffic); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+					}
+					return network.VirtualNetworkPeeringsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r: peering(withFlags(true, true, false, false)),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ network.VirtualNetworkPeering, _ network.SyncRemoteAddressSpace) (network.VirtualNetworkPeeringsCreateOrUpdateFuture, error) {
+					return network.VirtualNetworkPeeringsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:   peering(),
+			err: errors.Wrap(errorBoom, errUpdateVirtualNetworkPeering),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		r    resource.Managed
+		want resource.Managed
+		err  error
+	}{
+		"NotVirtualNetworkPeering": {
+			e:    &external{client: &fake.MockVirtualNetworkPeeringsClient{}},
+			r:    &v1alpha3.VirtualNetwork{},
+			want: &v1alpha3.VirtualNetwork{},
+			err:  errors.New(errNotVirtualNetworkPeering),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeeringsDeleteFuture, error) {
+					return network.VirtualNetworkPeeringsDeleteFuture{}, nil
+				},
+			}},
+			r:    peering(),
+			want: peering(withConditions(xpv1.Deleting())),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeeringsDeleteFuture, error) {
+					return network.VirtualNetworkPeeringsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    peering(),
+			want: peering(withConditions(xpv1.Deleting())),
+		},
+		"Failed": {
+			e: &external{client: &fake.MockVirtualNetworkPeeringsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (network.VirtualNetworkPeeringsDeleteFuture, error) {
+					return network.VirtualNetworkPeeringsDeleteFuture{}, errorBoom
+				},
+			}},
+			r:    peering(),
+			want: peering(withConditions(xpv1.Deleting())),
+			err:  errors.Wrap(errorBoom, errDeleteVirtualNetworkPeering),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,7 +49,11 @@ const (
 	errCreateResourceGroup = "cannot create ResourceGroup"
 	errCheckResourceGroup  = "cannot check existence of ResourceGroup"
 	errGetResourceGroup    = "cannot get ResourceGroup"
+	errUpdateResourceGroup = "cannot update ResourceGroup"
 	errDeleteResourceGroup = "cannot delete ResourceGroup"
+	errGetLock             = "cannot get management lock of ResourceGroup"
+	errCreateLock          = "cannot create management lock of ResourceGroup"
+	errDeleteLock          = "cannot delete management lock of ResourceGroup"
 )
 
 // Setup adds a controller that reconciles ResourceGroups.
@@ -82,14 +88,23 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	cl := resources.NewGroupsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl := resources.NewGroupsClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
 	cl.Authorizer = auth
-	return &external{client: cl}, nil
+	lcl := locks.NewManagementLocksClientWithBaseURI(creds[azure.CredentialsKeyResourceManagerEndpointURL], azure.SubscriptionID(mg, creds))
+	lcl.Authorizer = auth
+	d, err := azure.GetResourceDefaults(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: cl, locks: lcl, defaults: d}, nil
 }
 
-// external is a createsyncdeleter using the Azure Groups API.
+// external is a createsyncdeleter using the Azure Groups and Management Locks
+// APIs.
 type external struct {
-	client resourcegroup.GroupsClient
+	client   resourcegroup.GroupsClient
+	locks    resourcegroup.LocksClient
+	defaults azure.ResourceDefaults
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -114,9 +129,26 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if g.Properties != nil {
 		r.Status.ProvisioningState = v1alpha3.ProvisioningState(to.String(g.Properties.ProvisioningState))
 	}
+	l, err := e.getLock(ctx, meta.GetExternalName(r))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := r.Spec.DeepCopy()
+	resourcegroup.LateInitialize(r, g, e.defaults)
+
+	upToDate := resourcegroup.IsUpToDate(r, g, e.defaults) && resourcegroup.IsLockUpToDate(r.Spec.Lock, l)
+	li := !cmp.Equal(current, &r.Spec)
+	if upToDate {
+		li = azure.SetManagedTags(r, e.defaults.MergeTags(r.Spec.Tags)) || li
+	}
 
 	r.SetConditions(xpv1.Available())
-	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -126,13 +158,42 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	r.Status.SetConditions(xpv1.Creating())
-	_, err := e.client.CreateOrUpdate(ctx, meta.GetExternalName(r), resourcegroup.NewParameters(r))
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceGroup)
+	if _, err := e.client.CreateOrUpdate(ctx, meta.GetExternalName(r), resourcegroup.NewParameters(r, e.defaults)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourceGroup)
+	}
+	return managed.ExternalCreation{}, e.syncLock(ctx, meta.GetExternalName(r), r.Spec.Lock, nil)
 }
 
-func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(negz): Support updates, if applicable.
-	return managed.ExternalUpdate{}, nil
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1alpha3.ResourceGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourceGroup)
+	}
+
+	name := meta.GetExternalName(r)
+	g, err := e.client.Get(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetResourceGroup)
+	}
+	l, err := e.getLock(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !resourcegroup.IsUpToDate(r, g, e.defaults) {
+		// A ReadOnly lock prevents the tags of the resource group from being
+		// updated. It is recreated below.
+		if l != nil && l.ManagementLockProperties != nil && l.Level == locks.ReadOnly {
+			if _, err := e.locks.DeleteAtResourceGroupLevel(ctx, name, resourcegroup.LockName); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteLock)
+			}
+			l = nil
+		}
+		if _, err := e.client.Update(ctx, name, resourcegroup.NewPatchParameters(r, g, e.defaults)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateResourceGroup)
+		}
+	}
+	return managed.ExternalUpdate{}, e.syncLock(ctx, name, r.Spec.Lock, l)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -149,6 +210,38 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	r.Status.SetConditions(xpv1.Deleting())
+
+	// A management lock prevents the resource group from being deleted.
+	if _, err := e.locks.DeleteAtResourceGroupLevel(ctx, meta.GetExternalName(r), resourcegroup.LockName); resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteLock)
+	}
 	_, err := e.client.Delete(ctx, meta.GetExternalName(r))
 	return errors.Wrap(err, errDeleteResourceGroup)
 }
+
+// getLock returns the management lock Crossplane created for the supplied
+// resource group, or nil if it is not locked.
+func (e *external) getLock(ctx context.Context, name string) (*locks.ManagementLockObject, error) {
+	l, err := e.locks.GetAtResourceGroupLevel(ctx, name, resourcegroup.LockName)
+	if azure.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetLock)
+	}
+	return &l, nil
+}
+
+// syncLock creates, updates or deletes the management lock of the supplied
+// resource group so that it matches the desired lock.
+func (e *external) syncLock(ctx context.Context, name string, l *v1alpha3.ResourceGroupLock, o *locks.ManagementLockObject) error {
+	if resourcegroup.IsLockUpToDate(l, o) {
+		return nil
+	}
+	if l == nil {
+		_, err := e.locks.DeleteAtResourceGroupLevel(ctx, name, resourcegroup.LockName)
+		return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteLock)
+	}
+	_, err := e.locks.CreateOrUpdateAtResourceGroupLevel(ctx, name, resourcegroup.LockName, resourcegroup.NewLockParameters(l))
+	return errors.Wrap(err, errCreateLock)
+}
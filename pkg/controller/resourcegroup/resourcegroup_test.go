@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
@@ -36,6 +37,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/resourcegroup"
 	fakerg "github.com/crossplane-contrib/provider-azure/pkg/clients/resourcegroup/fake"
 )
 
@@ -45,6 +48,8 @@ const (
 	location = "coolplace"
 )
 
+var errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
 type resourceGroupModifier func(*v1alpha3.ResourceGroup)
 
 func withConditions(c ...xpv1.Condition) resourceGroupModifier {
@@ -55,6 +60,34 @@ func withProvisioningstate(s v1alpha3.ProvisioningState) resourceGroupModifier {
 	return func(r *v1alpha3.ResourceGroup) { r.Status.ProvisioningState = s }
 }
 
+func withTags(t map[string]string) resourceGroupModifier {
+	return func(r *v1alpha3.ResourceGroup) { r.Spec.Tags = t }
+}
+
+func withManagedTags(keys string) resourceGroupModifier {
+	return func(r *v1alpha3.ResourceGroup) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
+	}
+}
+
+func withLock(l *v1alpha3.ResourceGroupLock) resourceGroupModifier {
+	return func(r *v1alpha3.ResourceGroup) { r.Spec.Lock = l }
+}
+
+func notLocked() *fakerg.MockLocksClient {
+	return &fakerg.MockLocksClient{
+		MockGetAtResourceGroupLevel: func(_ context.Context, _, _ string) (locks.ManagementLockObject, error) {
+			return locks.ManagementLockObject{}, errNotFound
+		},
+	}
+}
+
+func lockedWith(level locks.LockLevel) func(_ context.Context, _, _ string) (locks.ManagementLockObject, error) {
+	return func(_ context.Context, _, _ string) (locks.ManagementLockObject, error) {
+		return locks.ManagementLockObject{ManagementLockProperties: &locks.ManagementLockProperties{Level: level}}, nil
+	}
+}
+
 func resourceGrp(rm ...resourceGroupModifier) *v1alpha3.ResourceGroup {
 	r := &v1alpha3.ResourceGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -156,7 +189,86 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetResourceGroup),
 			},
 		},
-
+		"GetLockError": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockCheckExistence: func(_ context.Context, _ string) (result autorest.Response, err error) {
+						return autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockGetAtResourceGroupLevel: func(_ context.Context, _, _ string) (locks.ManagementLockObject, error) {
+						return locks.ManagementLockObject{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{
+				mg:  resourceGrp(),
+				err: errors.Wrap(errBoom, errGetLock),
+			},
+		},
+		"LateInitialize": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockCheckExistence: func(_ context.Context, _ string) (result autorest.Response, err error) {
+						return autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{Tags: map[string]*string{"team": to.StringPtr("platform")}}, nil
+					},
+				},
+				locks: notLocked(),
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				mg: resourceGrp(
+					withTags(map[string]string{"team": "platform"}),
+					withManagedTags(`["team"]`),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"LockNotUpToDate": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockCheckExistence: func(_ context.Context, _ string) (result autorest.Response, err error) {
+						return autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockGetAtResourceGroupLevel: lockedWith(locks.CanNotDelete),
+				},
+			},
+			args: args{
+				mg: resourceGrp(withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelReadOnly})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: resourceGrp(
+					withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelReadOnly}),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
 		"Success": {
 			e: &external{
 				client: &fakerg.MockClient{
@@ -169,6 +281,7 @@ func TestObserve(t *testing.T) {
 						}}, nil
 					},
 				},
+				locks: notLocked(),
 			},
 			args: args{
 				mg: resourceGrp(),
@@ -250,6 +363,59 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateResourceGroup),
 			},
 		},
+		"CreateLockError": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ resources.Group) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockCreateOrUpdateAtResourceGroupLevel: func(_ context.Context, _, _ string, _ locks.ManagementLockObject) (locks.ManagementLockObject, error) {
+						return locks.ManagementLockObject{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete})),
+			},
+			want: want{
+				mg: resourceGrp(
+					withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete}),
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateLock),
+			},
+		},
+		"Success": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ resources.Group) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockCreateOrUpdateAtResourceGroupLevel: func(_ context.Context, rg, lock string, p locks.ManagementLockObject) (locks.ManagementLockObject, error) {
+						if rg != name || lock != resourcegroup.LockName {
+							t.Errorf("CreateOrUpdateAtResourceGroupLevel(...): unexpected lock %s/%s", rg, lock)
+						}
+						if p.Level != locks.CanNotDelete {
+							t.Errorf("CreateOrUpdateAtResourceGroupLevel(...): unexpected level %s", p.Level)
+						}
+						return p, nil
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete})),
+			},
+			want: want{
+				mg: resourceGrp(
+					withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete}),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -271,6 +437,158 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	defaults := azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		u   managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotResourceGroup": {
+			e: &external{},
+			args: args{
+				mg: nil,
+			},
+			want: want{
+				err: errors.New(errNotResourceGroup),
+			},
+		},
+		"GetError": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetResourceGroup),
+			},
+		},
+		"UpdateError": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+					MockUpdate: func(_ context.Context, _ string, _ resources.GroupPatchable) (result resources.Group, err error) {
+						return resources.Group{}, errBoom
+					},
+				},
+				locks:    notLocked(),
+				defaults: defaults,
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateResourceGroup),
+			},
+		},
+		"Success": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{Tags: map[string]*string{"policy": to.StringPtr("set")}}, nil
+					},
+					MockUpdate: func(_ context.Context, _ string, p resources.GroupPatchable) (result resources.Group, err error) {
+						want := map[string]*string{"owner": to.StringPtr("finance"), "policy": to.StringPtr("set")}
+						if diff := cmp.Diff(want, p.Tags); diff != "" {
+							t.Errorf("Update(...): -want tags, +got tags:\n%s", diff)
+						}
+						return resources.Group{}, nil
+					},
+				},
+				locks:    notLocked(),
+				defaults: defaults,
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{},
+		},
+		"ReadOnlyLock": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+					MockUpdate: func(_ context.Context, _ string, _ resources.GroupPatchable) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockGetAtResourceGroupLevel: lockedWith(locks.ReadOnly),
+					MockDeleteAtResourceGroupLevel: func(_ context.Context, _, _ string) (autorest.Response, error) {
+						return autorest.Response{}, nil
+					},
+					MockCreateOrUpdateAtResourceGroupLevel: func(_ context.Context, _, _ string, p locks.ManagementLockObject) (locks.ManagementLockObject, error) {
+						if p.Level != locks.ReadOnly {
+							t.Errorf("CreateOrUpdateAtResourceGroupLevel(...): unexpected level %s", p.Level)
+						}
+						return p, nil
+					},
+				},
+				defaults: defaults,
+			},
+			args: args{
+				mg: resourceGrp(withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelReadOnly})),
+			},
+			want: want{},
+		},
+		"RemoveLockError": {
+			e: &external{
+				client: &fakerg.MockClient{
+					MockGet: func(_ context.Context, _ string) (result resources.Group, err error) {
+						return resources.Group{}, nil
+					},
+				},
+				locks: &fakerg.MockLocksClient{
+					MockGetAtResourceGroupLevel: lockedWith(locks.CanNotDelete),
+					MockDeleteAtResourceGroupLevel: func(_ context.Context, _, _ string) (autorest.Response, error) {
+						return autorest.Response{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteLock),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.u, got); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -320,6 +638,11 @@ func TestDelete(t *testing.T) {
 						return resources.GroupsDeleteFuture{}, errBoom
 					},
 				},
+				locks: &fakerg.MockLocksClient{
+					MockDeleteAtResourceGroupLevel: func(_ context.Context, _, _ string) (autorest.Response, error) {
+						return autorest.Response{}, errNotFound
+					},
+				},
 			},
 			args: args{
 				mg: resourceGrp(),
@@ -329,6 +652,25 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDeleteResourceGroup),
 			},
 		},
+		"DeleteLockError": {
+			e: &external{
+				locks: &fakerg.MockLocksClient{
+					MockDeleteAtResourceGroupLevel: func(_ context.Context, _, _ string) (autorest.Response, error) {
+						return autorest.Response{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: resourceGrp(withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete})),
+			},
+			want: want{
+				mg: resourceGrp(
+					withLock(&v1alpha3.ResourceGroupLock{Level: v1alpha3.LockLevelCanNotDelete}),
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteLock),
+			},
+		},
 	}
 
 	for name, tc := range cases {
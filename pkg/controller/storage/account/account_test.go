@@ -2,7 +2,7 @@
 Copyright 2019 The Crossplane Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance With the License.
+you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
 	http://www.apache.org/licenses/LICENSE-2.0
@@ -20,1051 +20,416 @@ import (
 	"context"
 	"net/http"
 	"testing"
-	"time"
-
-	"github.com/crossplane-contrib/provider-azure/apis"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
-	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes/scheme"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
-	v1alpha3test "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3/test"
-	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
-	azurestoragefake "github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
 )
 
-func init() {
-	_ = apis.AddToScheme(scheme.Scheme)
-}
-
-type MockAccountSecretupdater struct {
-	MockUpdateSecret func(context.Context, *storage.Account) error
-}
+const (
+	name          = "coolaccount"
+	resourceGroup = "coolgroup"
+	location      = "westus2"
+	blobEndpoint  = "https://coolaccount.blob.core.windows.net/"
+	accountKey    = "secretkey"
+)
 
-func (m *MockAccountSecretupdater) updatesecret(ctx context.Context, a *storage.Account) error {
-	return m.MockUpdateSecret(ctx, a)
-}
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
 
-var _ secretupdater = &MockAccountSecretupdater{}
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
 
-type MockAccountSyncbacker struct {
-	MockSyncback func(context.Context, *storage.Account) (reconcile.Result, error)
-}
+type accountModifier func(*v1alpha3.Account)
 
-func (m *MockAccountSyncbacker) syncback(ctx context.Context, a *storage.Account) (reconcile.Result, error) {
-	return m.MockSyncback(ctx, a)
+func withConditions(c ...xpv1.Condition) accountModifier {
+	return func(a *v1alpha3.Account) { a.Status.ConditionedStatus.Conditions = c }
 }
 
-var _ syncbacker = &MockAccountSyncbacker{}
-
-type MockAccountCreateUpdater struct {
-	MockCreate func(context.Context) (reconcile.Result, error)
-	MockUpdate func(context.Context, *storage.Account) (reconcile.Result, error)
+func withTags(t map[string]string) accountModifier {
+	return func(a *v1alpha3.Account) { a.Spec.StorageAccountSpec.Tags = t }
 }
 
-func newMockAccountCreateUpdater(poll time.Duration) *MockAccountCreateUpdater {
-	return &MockAccountCreateUpdater{
-		MockUpdate: func(i context.Context, acct *storage.Account) (result reconcile.Result, e error) {
-			return reconcile.Result{RequeueAfter: poll}, nil
-		},
-		MockCreate: func(i context.Context) (result reconcile.Result, e error) {
-			return reconcile.Result{RequeueAfter: poll}, nil
-		},
+func withManagedTags(keys string) accountModifier {
+	return func(r *v1alpha3.Account) {
+		meta.AddAnnotations(r, map[string]string{azure.AnnotationKeyManagedTags: keys})
 	}
 }
 
-func (m *MockAccountCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
-	return m.MockCreate(ctx)
-}
-
-func (m *MockAccountCreateUpdater) update(ctx context.Context, a *storage.Account) (reconcile.Result, error) {
-	return m.MockUpdate(ctx, a)
-}
-
-var _ createupdater = &MockAccountCreateUpdater{}
-
-type MockAccountSyncDeleter struct {
-	MockDelete func(context.Context) (reconcile.Result, error)
-	MockSync   func(context.Context) (reconcile.Result, error)
+func withStatus(s *v1alpha3.StorageAccountStatus) accountModifier {
+	return func(a *v1alpha3.Account) { a.Status.StorageAccountStatus = s }
 }
 
-func newMockAccountSyncDeleter(poll time.Duration) *MockAccountSyncDeleter {
-	return &MockAccountSyncDeleter{
-		MockSync: func(i context.Context) (result reconcile.Result, e error) {
-			return reconcile.Result{RequeueAfter: poll}, nil
-		},
-		MockDelete: func(i context.Context) (result reconcile.Result, e error) {
-			return result, nil
+func account(m ...accountModifier) *v1alpha3.Account {
+	a := &v1alpha3.Account{
+		Spec: v1alpha3.AccountSpec{
+			AccountParameters: v1alpha3.AccountParameters{
+				ResourceGroupName: resourceGroup,
+				StorageAccountSpec: &v1alpha3.StorageAccountSpec{
+					Kind:     storage.Storage,
+					Location: location,
+					Sku:      &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+					StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+						EnableHTTPSTrafficOnly: true,
+					},
+				},
+			},
 		},
 	}
-}
-
-func (m *MockAccountSyncDeleter) delete(ctx context.Context) (reconcile.Result, error) {
-	return m.MockDelete(ctx)
-}
-
-func (m *MockAccountSyncDeleter) sync(ctx context.Context) (reconcile.Result, error) {
-	return m.MockSync(ctx)
-}
-
-var _ syncdeleter = &MockAccountSyncDeleter{}
-
-type MockAccountHandleMaker struct {
-	MockNew func(context.Context, *v1alpha3.Account) (syncdeleter, error)
-}
-
-func newMockAccountHandleMaker(rh syncdeleter, err error, _ time.Duration) *MockAccountHandleMaker {
-	return &MockAccountHandleMaker{
-		MockNew: func(i context.Context, bucket *v1alpha3.Account) (handler syncdeleter, e error) {
-			return rh, err
-		},
+	meta.SetExternalName(a, name)
+	for _, f := range m {
+		f(a)
 	}
+	return a
 }
 
-func (m *MockAccountHandleMaker) newSyncdeleter(ctx context.Context, b *v1alpha3.Account, _ time.Duration) (syncdeleter, error) {
-	return m.MockNew(ctx, b)
-}
-
-func newStorageAccountSpec() *v1alpha3.StorageAccountSpec {
-	return v1alpha3.NewStorageAccountSpec(&storage.Account{})
-}
-
-func newStoragAccountSpecWithProperties() *v1alpha3.StorageAccountSpec {
-	return v1alpha3.NewStorageAccountSpec(&storage.Account{AccountProperties: &storage.AccountProperties{}})
-}
-
-type storageAccount struct {
-	*storage.Account
-}
-
-func newStorageAccount() *storageAccount {
-	return &storageAccount{
-		Account: &storage.Account{},
-	}
-}
-
-func (sa *storageAccount) withAccountProperties(ap *storage.AccountProperties) *storageAccount {
-	sa.Account.AccountProperties = ap
-	return sa
-}
-
-type storageAccountProperties struct {
-	*storage.AccountProperties
-}
-
-func newStorageAccountProperties() *storageAccountProperties {
-	return &storageAccountProperties{
-		AccountProperties: &storage.AccountProperties{},
+func azureAccount(ps storage.ProvisioningState, tags map[string]*string) *storage.Account {
+	return &storage.Account{
+		Name:     to.StringPtr(name),
+		Kind:     storage.Storage,
+		Location: to.StringPtr(location),
+		Sku:      &storage.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+		Tags:     tags,
+		AccountProperties: &storage.AccountProperties{
+			ProvisioningState:      ps,
+			EnableHTTPSTrafficOnly: to.BoolPtr(true),
+			PrimaryEndpoints:       &storage.Endpoints{Blob: to.StringPtr(blobEndpoint)},
+		},
 	}
 }
 
-func (sap *storageAccountProperties) withProvisioningStage(ps storage.ProvisioningState) *storageAccountProperties {
-	sap.ProvisioningState = ps
-	return sap
-}
-
-const (
-	testNamespace   = "default"
-	testAccountName = "testAccount"
-)
-
-func TestReconciler_Reconcile(t *testing.T) {
-	name := testAccountName
-	key := types.NamespacedName{Name: name}
-	req := reconcile.Request{NamespacedName: key}
-	ctx := context.TODO()
-	rsDone := reconcile.Result{}
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		client client.Client
-		maker  syncdeleterMaker
-	}
+func TestObserve(t *testing.T) {
 	type want struct {
-		res  reconcile.Result
-		err  error
-		acct *v1alpha3.Account
+		cr  resource.Managed
+		o   managed.ExternalObservation
+		err error
 	}
-	tests := []struct {
-		name   string
-		fields fields
-		want   want
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name:   "GetErrNotFound",
-			fields: fields{client: fake.NewClientBuilder().Build(), maker: nil},
-			want:   want{res: rsDone},
-		},
-		{
-			name: "GetErrorOther",
-			fields: fields{
-				client: &test.MockClient{
-					MockGet: func(context.Context, client.ObjectKey, client.Object) error {
-						return errBoom
-					},
-				},
-			},
-			want: want{res: rsDone, err: errBoom},
-		},
-		{
-			name: "AccountHandlerError",
-			fields: fields{
-				client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockAccount(name).WithFinalizer("foo.bar").Account).Build(),
-				maker:  newMockAccountHandleMaker(nil, errBoom, time.Minute),
-			},
+		"NotAccount": {
+			e: &external{},
 			want: want{
-				res: resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).
-					WithStatusConditions(
-						xpv1.ReconcileError(errBoom),
-					).
-					WithFinalizer("foo.bar").Account,
+				err: errors.New(errNotAccount),
 			},
 		},
-		{
-			name: "ReconcileDelete",
-			fields: fields{
-				client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockAccount(name).
-					WithDeleteTimestamp(metav1.NewTime(time.Now())).Account).Build(),
-				maker: newMockAccountHandleMaker(newMockAccountSyncDeleter(time.Minute), nil, time.Minute),
+		"NotFound": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) { return nil, errNotFound },
+			}},
+			cr: account(),
+			want: want{
+				cr: account(),
+				o:  managed.ExternalObservation{ResourceExists: false},
 			},
-			want: want{res: rsDone},
 		},
-		{
-			name: "ReconcileSync",
-			fields: fields{
-				client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockAccount(name).Account).Build(),
-				maker:  newMockAccountHandleMaker(newMockAccountSyncDeleter(time.Hour), nil, time.Minute),
+		"GetError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) { return nil, errBoom },
+			}},
+			cr: account(),
+			want: want{
+				cr:  account(),
+				err: errors.Wrap(errBoom, errGetFailed),
 			},
-			want: want{res: reconcile.Result{RequeueAfter: time.Hour}},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			r := &Reconciler{
-				Client:           tt.fields.client,
-				syncdeleterMaker: tt.fields.maker,
-				Initializer:      managed.NewNameAsExternalName(tt.fields.client),
-				log:              logging.NewNopLogger(),
-			}
-			got, err := r.Reconcile(ctx, req)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("Reconciler.Reconcile(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("Reconciler.Reconcile(): -want, +got:\n%s", diff)
-			}
-			if tt.want.acct != nil {
-				b := &v1alpha3.Account{}
-				if err := r.Get(ctx, key, b); err != nil {
-					t.Errorf("Reconciler.Reconcile() account error: %s", err)
-				}
-				// NOTE(muvaf): Get call returns TypeMeta and ResourceVersion
-				// that we are not interested. Since diff shows them, we have to
-				// assign the right values.
-				b.TypeMeta = tt.want.acct.TypeMeta
-				tt.want.acct.ResourceVersion = b.ResourceVersion
-				if diff := cmp.Diff(tt.want.acct, b, test.EquateConditions()); diff != "" {
-					t.Errorf("Reconciler.Reconcile() account: -want, +got\n%s", diff)
-				}
-			}
-		})
-	}
-}
-
-func Test_syncdeleter_delete(t *testing.T) {
-	ctx := context.TODO()
-	bucketName := "test-account"
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		ao   azurestorage.AccountOperations
-		cc   client.Client
-		acct *v1alpha3.Account
-		poll time.Duration
-	}
-	type want struct {
-		err  error
-		res  reconcile.Result
-		acct *v1alpha3.Account
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		want   want
-	}{
-		{
-			name: "RetainPolicy",
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionOrphan).
-					WithFinalizers([]string{finalizer, "test"}).Account,
-				cc: &test.MockClient{
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
-					},
+		"Creating": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Creating, nil), nil
 				},
-			},
+			}},
+			cr: account(),
 			want: want{
-				err: nil,
-				res: reconcile.Result{},
-				acct: v1alpha3test.NewMockAccount(bucketName).
-					WithSpecDeletionPolicy(xpv1.DeletionOrphan).
-					WithFinalizer("test").
-					WithStatusConditions(xpv1.Deleting()).
-					Account,
+				cr: account(
+					withStatus(v1alpha3.NewStorageAccountStatus(azureAccount(storage.Creating, nil))),
+					withConditions(xpv1.Creating()),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
 			},
 		},
-		{
-			name: "DeleteSuccessful",
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).Account,
-				cc: &test.MockClient{
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
-					},
+		"ListKeysError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Succeeded, nil), nil
 				},
-				ao: azurestoragefake.NewMockAccountOperations(),
-			},
+				MockListKeys: func(_ context.Context) ([]storage.AccountKey, error) { return nil, errBoom },
+			}},
+			cr: account(),
 			want: want{
-				err: nil,
-				res: reconcile.Result{},
-				acct: v1alpha3test.NewMockAccount(bucketName).
-					WithFinalizers([]string{}).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithStatusConditions(xpv1.Deleting()).
-					Account,
+				cr:  account(withStatus(v1alpha3.NewStorageAccountStatus(azureAccount(storage.Succeeded, nil)))),
+				err: errors.Wrap(errBoom, errListKeys),
 			},
 		},
-		{
-			name: "DeleteFailed",
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).Account,
-				cc: &test.MockClient{
-					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
-					},
+		"NoKeys": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Succeeded, nil), nil
 				},
-				ao: &azurestoragefake.MockAccountOperations{
-					MockDelete: func(ctx context.Context) error {
-						return errBoom
-					},
-				},
-			},
+				MockListKeys: func(_ context.Context) ([]storage.AccountKey, error) { return nil, nil },
+			}},
+			cr: account(),
 			want: want{
-				err: nil,
-				res: resultRequeue,
-				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Deleting(), xpv1.ReconcileError(errBoom)).
-					Account,
+				cr:  account(withStatus(v1alpha3.NewStorageAccountStatus(azureAccount(storage.Succeeded, nil)))),
+				err: errors.New(errNoKeys),
 			},
 		},
-		{
-			name: "DeleteNonExistent",
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).Account,
-				cc: &test.MockClient{
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error { return nil },
+		"Available": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Succeeded, map[string]*string{"team": to.StringPtr("platform")}), nil
 				},
-				ao: &azurestoragefake.MockAccountOperations{
-					MockDelete: func(ctx context.Context) error {
-						return autorest.DetailedError{
-							StatusCode: http.StatusNotFound,
-						}
-					},
+				MockListKeys: func(_ context.Context) ([]storage.AccountKey, error) {
+					return []storage.AccountKey{{Value: to.StringPtr(accountKey)}}, nil
 				},
-			},
+			}},
+			cr: account(),
 			want: want{
-				err: nil,
-				res: reconcile.Result{},
-				acct: v1alpha3test.NewMockAccount(bucketName).
-					WithFinalizers([]string{}).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithStatusConditions(xpv1.Deleting()).
-					Account,
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			bh := newAccountSyncDeleter(tt.fields.ao, tt.fields.cc, tt.fields.acct, tt.fields.poll)
-			got, err := bh.delete(ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountSyncDeleter.delete(): -want error, +got error: \n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("accountSyncDeleter.delete(): -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.acct, tt.fields.acct, test.EquateConditions()); diff != "" {
-				t.Errorf("accountSyncDeleter.delete() account: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
-func Test_syncdeleter_sync(t *testing.T) {
-	ctx := context.TODO()
-	name := testAccountName
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		ao   azurestorage.AccountOperations
-		kube client.Client
-		acct *v1alpha3.Account
-		poll time.Duration
-	}
-	type want struct {
-		err  error
-		res  reconcile.Result
-		acct *v1alpha3.Account
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		want   want
-	}{
-		{
-			name: "AttrsError",
-			fields: fields{
-				ao: &azurestoragefake.MockAccountOperations{
-					MockGet: func(i context.Context) (attrs *storage.Account, e error) {
-						return nil, errBoom
-					},
-				},
-				kube: &test.MockClient{
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error { return nil },
-					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
+				cr: account(
+					withTags(map[string]string{"team": "platform"}),
+					withManagedTags(`["team"]`),
+					withStatus(v1alpha3.NewStorageAccountStatus(azureAccount(storage.Succeeded, map[string]*string{"team": to.StringPtr("platform")}))),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(name),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(accountKey),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(blobEndpoint),
 					},
 				},
-				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
-			},
-			want: want{
-				res: resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).
-					WithUID("test-uid").
-					WithStatusConditions(xpv1.ReconcileError(errBoom)).
-					Account,
 			},
 		},
-		{
-			name: "AttrsNotFoundCreate",
-			fields: fields{
-				kube: &test.MockClient{
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error { return nil },
-				},
-				ao: &azurestoragefake.MockAccountOperations{
-					MockGet: func(i context.Context) (attrs *storage.Account, e error) {
-						return nil, autorest.DetailedError{
-							StatusCode: http.StatusNotFound,
-						}
+		"NotUpToDate": {
+			e: &external{
+				client: &fake.MockAccountOperations{
+					MockGet: func(_ context.Context) (*storage.Account, error) {
+						return azureAccount(storage.Succeeded, nil), nil
+					},
+					MockListKeys: func(_ context.Context) ([]storage.AccountKey, error) {
+						return []storage.AccountKey{{Value: to.StringPtr(accountKey)}}, nil
 					},
 				},
-				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
-				poll: time.Minute,
+				defaults: azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
 			},
+			cr: account(),
 			want: want{
-				res:  reconcile.Result{RequeueAfter: time.Minute},
-				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
-			},
-		},
-		{
-			name: "Update",
-			fields: fields{
-				kube: &test.MockClient{
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error { return nil },
-				},
-				ao: &azurestoragefake.MockAccountOperations{
-					MockGet: func(i context.Context) (attrs *storage.Account, e error) {
-						return &storage.Account{}, nil
+				cr: account(
+					withStatus(v1alpha3.NewStorageAccountStatus(azureAccount(storage.Succeeded, nil))),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(name),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(accountKey),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(blobEndpoint),
 					},
 				},
-				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
-				poll: time.Minute,
-			},
-			want: want{
-				res:  reconcile.Result{RequeueAfter: time.Minute},
-				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
 			},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			bh := &accountSyncDeleter{
-				createupdater:     newMockAccountCreateUpdater(tt.fields.poll),
-				AccountOperations: tt.fields.ao,
-				kube:              tt.fields.kube,
-				acct:              tt.fields.acct,
-			}
 
-			got, err := bh.sync(ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountSyncDeleter.delete() -want error, +got error:\n%s", diff)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("accountSyncDeleter.delete(): -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.acct, tt.fields.acct, test.EquateConditions()); diff != "" {
-				t.Errorf("accountSyncDeleter.delete() account: -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_createupdater_create(t *testing.T) {
-	ctx := context.TODO()
-	name := testAccountName
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		sb        syncbacker
-		ao        azurestorage.AccountOperations
-		kube      client.Client
-		acct      *v1alpha3.Account
-		projectID string
-	}
+func TestCreate(t *testing.T) {
 	type want struct {
+		cr  resource.Managed
 		err error
-		res reconcile.Result
-		obj *v1alpha3.Account
 	}
 
-	tests := []struct {
-		name   string
-		fields fields
-		want   want
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name: "CreateFailed",
-			fields: fields{
-				ao: &azurestoragefake.MockAccountOperations{
-					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (*storage.Account, error) {
-						return nil, errBoom
-					},
-				},
-				kube: &test.MockClient{
-					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).
-					Account,
+		"NotAccount": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAccount),
 			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockCreate: func(_ context.Context, _ storage.AccountCreateParameters) error { return errBoom },
+			}},
+			cr: account(),
 			want: want{
-				res: resultRequeue,
-				obj: v1alpha3test.NewMockAccount(name).
-					WithStatusConditions(xpv1.Creating(), xpv1.ReconcileError(errBoom)).
-					WithFinalizer(finalizer).
-					Account,
+				cr:  account(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
-		{
-			name: "CreateSuccessful",
-			fields: fields{
-				sb: &MockAccountSyncbacker{
-					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
-						return resultRequeue, errBoom
+		"Success": {
+			e: &external{
+				client: &fake.MockAccountOperations{
+					MockCreate: func(_ context.Context, p storage.AccountCreateParameters) error {
+						want := azurestorage.NewAccountCreateParameters(account(withTags(map[string]string{"owner": "finance"})).Spec.StorageAccountSpec, azure.ResourceDefaults{})
+						if diff := cmp.Diff(want, p); diff != "" {
+							t.Errorf("Create(...): -want, +got:\n%s", diff)
+						}
+						return nil
 					},
 				},
-				ao:   azurestoragefake.NewMockAccountOperations(),
-				kube: test.NewMockClient(),
-				acct: v1alpha3test.NewMockAccount(name).
-					WithUID("test-uid").
-					Account,
+				defaults: azure.ResourceDefaults{Tags: map[string]string{"owner": "finance"}},
 			},
+			cr: account(),
 			want: want{
-				err: errBoom,
-				res: resultRequeue,
-				obj: v1alpha3test.NewMockAccount(name).
-					WithUID("test-uid").
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Creating()).
-					Account,
+				cr: account(withConditions(xpv1.Creating())),
 			},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			bh := &accountCreateUpdater{
-				syncbacker:        tt.fields.sb,
-				AccountOperations: tt.fields.ao,
-				kube:              tt.fields.kube,
-				acct:              tt.fields.acct,
-				projectID:         tt.fields.projectID,
-			}
-			got, err := bh.create(ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountCreateUpdater.create(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("accountCreateUpdater.create(): -want, +got:\n%s", diff)
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.obj, tt.fields.acct, test.EquateConditions()); diff != "" {
-				t.Errorf("accountCreateUpdater.create() account: -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_bucketCreateUpdater_update(t *testing.T) {
-	ctx := context.TODO()
-	name := testAccountName
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		sb   syncbacker
-		ao   azurestorage.AccountOperations
-		kube client.Client
-		acct *v1alpha3.Account
-		poll time.Duration
-	}
-	type want struct {
-		res  reconcile.Result
-		err  error
-		acct *v1alpha3.Account
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		attrs  *storage.Account
-		want   want
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
 	}{
-		{
-			name: "NotReady",
-			attrs: newStorageAccount().
-				withAccountProperties(newStorageAccountProperties().
-					withProvisioningStage(storage.Creating).AccountProperties).Account,
-			fields: fields{
-				sb: &MockAccountSyncbacker{
-					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
-						return reconcile.Result{RequeueAfter: time.Minute}, nil
-					},
-				},
-				poll: time.Minute,
-			},
-			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-			},
-		},
-		{
-			name: "NoChanges",
-			attrs: &storage.Account{
-				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
-			},
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
-					Account,
-				kube: test.NewMockClient(),
-				poll: time.Minute,
-			},
-			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
-					Account,
-			},
-		},
-		{
-			name: "UpdateFailed",
-			attrs: &storage.Account{
-				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
-				Location:          to.StringPtr("test-location"),
-			},
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).Account,
-				ao: &azurestoragefake.MockAccountOperations{
-					MockUpdate: func(ctx context.Context, update storage.AccountUpdateParameters) (attrs *storage.Account, e error) {
-						return nil, errBoom
-					},
-				},
-				kube: &test.MockClient{
-					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error { return nil },
-				},
-			},
-			want: want{
-				res: resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileError(errBoom)).
-					Account,
-			},
-		},
-		{
-			name: "UpdateSuccess",
-			attrs: &storage.Account{
-				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
-				Location:          to.StringPtr("test-location"),
-			},
-			fields: fields{
-				sb: &MockAccountSyncbacker{
-					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
-						return reconcile.Result{RequeueAfter: time.Minute}, nil
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).Account,
-				ao: &azurestoragefake.MockAccountOperations{
-					MockUpdate: func(ctx context.Context, update storage.AccountUpdateParameters) (attrs *storage.Account, e error) {
-						return &storage.Account{Location: to.StringPtr("test-location")}, nil
-					},
-				},
-				kube: test.NewMockClient(),
-				poll: time.Minute,
-			},
-			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
-					WithStatusConditions(xpv1.Available()).
-					Account,
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			bh := &accountCreateUpdater{
-				syncbacker:        tt.fields.sb,
-				AccountOperations: tt.fields.ao,
-				kube:              tt.fields.kube,
-				acct:              tt.fields.acct,
-				poll:              tt.fields.poll,
-			}
-			got, err := bh.update(ctx, tt.attrs)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountCreateUpdater.update() -want error, +got error:\n%s", diff)
-				return
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("accountCreateUpdater.update(): -want, +got:\n%s", diff)
-				return
-			}
-			if diff := cmp.Diff(tt.want.acct, tt.fields.acct, test.EquateConditions()); diff != "" {
-				t.Errorf("accountCreateUpdater.update() account: -want, +got:\n%s", diff)
-				return
+		"NotAccount": {
+			e:    &external{},
+			want: errors.New(errNotAccount),
+		},
+		"GetError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) { return nil, errBoom },
+			}},
+			cr:   account(),
+			want: errors.Wrap(errBoom, errGetFailed),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Succeeded, nil), nil
+				},
+				MockUpdate: func(_ context.Context, _ storage.AccountUpdateParameters) (*storage.Account, error) {
+					return nil, errBoom
+				},
+			}},
+			cr:   account(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockGet: func(_ context.Context) (*storage.Account, error) {
+					return azureAccount(storage.Succeeded, map[string]*string{"policy": to.StringPtr("set")}), nil
+				},
+				MockUpdate: func(_ context.Context, p storage.AccountUpdateParameters) (*storage.Account, error) {
+					want := map[string]*string{"policy": to.StringPtr("set"), "team": to.StringPtr("platform")}
+					if diff := cmp.Diff(want, p.Tags); diff != "" {
+						t.Errorf("Update(...): -want tags, +got tags:\n%s", diff)
+					}
+					return nil, nil
+				},
+			}},
+			cr: account(withTags(map[string]string{"team": "platform"})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_accountSyncBacker_syncback(t *testing.T) {
-	ctx := context.TODO()
-	name := testAccountName
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		secretupdater secretupdater
-		kube          client.Client
-		acct          *v1alpha3.Account
-		poll          time.Duration
-	}
+func TestDelete(t *testing.T) {
 	type want struct {
-		res  reconcile.Result
-		err  error
-		acct *v1alpha3.Account
+		cr  resource.Managed
+		err error
 	}
-	tests := []struct {
-		name   string
-		fields fields
-		acct   *storage.Account
-		want   want
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name: "UpdateDailed",
-			fields: fields{
-				secretupdater: &MockAccountSecretupdater{},
-				acct:          v1alpha3test.NewMockAccount(name).Account,
-				kube: &test.MockClient{
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return errBoom
-					},
-				},
-			},
-			acct: &storage.Account{},
+		"NotAccount": {
+			e: &external{},
 			want: want{
-				err:  errBoom,
-				res:  resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStorageAccountSpec()).Account,
+				err: errors.New(errNotAccount),
 			},
 		},
-		{
-			name: "ProvisionStatusIsNotSucceeded",
-			fields: fields{
-				acct: v1alpha3test.NewMockAccount(name).Account,
-				kube: test.NewMockClient(),
-			},
-			acct: newStorageAccount().
-				withAccountProperties(newStorageAccountProperties().
-					withProvisioningStage(storage.Creating).AccountProperties).Account,
+		"NotFound": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockDelete: func(_ context.Context) error { return errNotFound },
+			}},
+			cr: account(),
 			want: want{
-				res: requeueOnWait,
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStatusFromProperties(&storage.AccountProperties{ProvisioningState: storage.Creating}).
-					WithStatusConditions(xpv1.ReconcileSuccess()).
-					Account,
+				cr: account(withConditions(xpv1.Deleting())),
 			},
 		},
-		{
-			name: "UpdateSecretFailed",
-			fields: fields{
-				secretupdater: &MockAccountSecretupdater{
-					MockUpdateSecret: func(ctx context.Context, a *storage.Account) error {
-						return errBoom
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).Account,
-				kube: test.NewMockClient(),
-			},
-			acct: &storage.Account{AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded}},
+		"DeleteError": {
+			e: &external{client: &fake.MockAccountOperations{
+				MockDelete: func(_ context.Context) error { return errBoom },
+			}},
+			cr: account(),
 			want: want{
-				res: resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStatusFromProperties(&storage.AccountProperties{ProvisioningState: storage.Succeeded}).
-					WithStatusConditions(xpv1.ReconcileError(errBoom)).Account,
+				cr:  account(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
 			},
 		},
-		{
-			name: "Success",
-			fields: fields{
-				secretupdater: &MockAccountSecretupdater{
-					MockUpdateSecret: func(ctx context.Context, a *storage.Account) error { return nil },
-				},
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStorageAccountSpec(v1alpha3.NewStorageAccountSpec(&storage.Account{})).
-					Account,
-				kube: test.NewMockClient(),
-				poll: time.Minute,
-			},
-			acct: &storage.Account{AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded}},
-			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStatusFromProperties(&storage.AccountProperties{ProvisioningState: storage.Succeeded}).
-					WithStatusConditions(xpv1.ReconcileSuccess()).
-					Account,
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			acu := &accountSyncbacker{
-				secretupdater: tt.fields.secretupdater,
-				kube:          tt.fields.kube,
-				acct:          tt.fields.acct,
-				poll:          tt.fields.poll,
-			}
-			got, err := acu.syncback(ctx, tt.acct)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountSyncBackSecretUpdater.syncback() -want error, +got error:\n%s", diff)
-				return
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("accountSyncBackSecretUpdater.syncback(): -want, +got:\n%s", diff)
-				return
-			}
-			if diff := cmp.Diff(tt.want.acct, tt.fields.acct, test.EquateConditions()); diff != "" {
-				t.Errorf("accountSyncBackSecretUpdater.syncback() account: -want, +got:\n%s", diff)
-				return
-			}
-		})
-	}
-}
-
-func Test_accountSecretUpdater_updatesecret(t *testing.T) {
-	ctx := context.TODO()
-	ns := testNamespace
-	name := testAccountName
-	csName := "connectionsecret"
-
-	type fields struct {
-		ops  azurestorage.AccountOperations
-		kube client.Client
-		acct *v1alpha3.Account
 	}
 
-	tests := []struct {
-		name    string
-		fields  fields
-		acct    *storage.Account
-		wantErr error
-	}{
-		{
-			name: "FailedListKeys",
-			fields: fields{
-				ops: &azurestoragefake.MockAccountOperations{
-					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
-						return nil, errors.New("test-list-keys-error")
-					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return kerrors.NewNotFound(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account,
-			},
-			acct: &storage.Account{
-				AccountProperties: &storage.AccountProperties{
-					PrimaryEndpoints: &storage.Endpoints{
-						Blob: to.StringPtr("test-blob-endpoint"),
-					},
-				},
-			},
-			wantErr: errors.Wrapf(errors.New("test-list-keys-error"), "failed to list account keys"),
-		},
-		{
-			name: "AccountKeysListEmpty",
-			fields: fields{
-				ops: &azurestoragefake.MockAccountOperations{
-					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
-						return []storage.AccountKey{}, nil
-					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return kerrors.NewNotFound(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account,
-			},
-			acct: &storage.Account{
-				AccountProperties: &storage.AccountProperties{
-					PrimaryEndpoints: &storage.Endpoints{
-						Blob: to.StringPtr("test-blob-endpoint"),
-					},
-				},
-			},
-			wantErr: errors.New("account keys are empty"),
-		},
-		{
-			name: "CreateNewSecret",
-			fields: fields{
-				ops: &azurestoragefake.MockAccountOperations{
-					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
-						return []storage.AccountKey{
-							{
-								KeyName: to.StringPtr("test-key"),
-								Value:   to.StringPtr("test-value"),
-							},
-						}, nil
-					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return kerrors.NewNotFound(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
-					},
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
-						return nil
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account,
-			},
-			acct: &storage.Account{
-				AccountProperties: &storage.AccountProperties{
-					PrimaryEndpoints: &storage.Endpoints{
-						Blob: to.StringPtr("test-blob-endpoint"),
-					},
-				},
-			},
-		},
-		{
-			name: "CreateNewSecretFailed",
-			fields: fields{
-				ops: &azurestoragefake.MockAccountOperations{
-					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
-						return []storage.AccountKey{
-							{
-								KeyName: to.StringPtr("test-key"),
-								Value:   to.StringPtr("test-value"),
-							},
-						}, nil
-					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return kerrors.NewNotFound(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
-					},
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
-						return errors.New("test-create-secret-error")
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account,
-			},
-			acct: &storage.Account{
-				AccountProperties: &storage.AccountProperties{
-					PrimaryEndpoints: &storage.Endpoints{
-						Blob: to.StringPtr("test-blob-endpoint"),
-					},
-				},
-			},
-			wantErr: errors.Wrapf(errors.New("test-create-secret-error"), "failed to create secret: %s/%s", ns, csName),
-		},
-		{
-			name: "UpdateExistingSecret",
-			fields: fields{
-				ops: &azurestoragefake.MockAccountOperations{
-					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
-						return []storage.AccountKey{
-							{
-								KeyName: to.StringPtr("test-key"),
-								Value:   to.StringPtr("test-value"),
-							},
-						}, nil
-					},
-				},
-				kube: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return nil
-					},
-					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
-						return kerrors.NewAlreadyExists(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
-					},
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return nil
-					},
-				},
-				acct: v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account,
-			},
-			acct: &storage.Account{
-				AccountProperties: &storage.AccountProperties{
-					PrimaryEndpoints: &storage.Endpoints{
-						Blob: to.StringPtr("test-blob-endpoint"),
-					},
-				},
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			asu := &accountSecretUpdater{
-				AccountOperations: tt.fields.ops,
-				acct:              tt.fields.acct,
-				kube:              tt.fields.kube,
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
 			}
-			err := asu.updatesecret(ctx, tt.acct)
-			if diff := cmp.Diff(tt.wantErr, err, test.EquateErrors()); diff != "" {
-				t.Errorf("accountSyncBackSecretUpdater.syncback() -want error, +got error:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
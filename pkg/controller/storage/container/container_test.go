@@ -19,929 +19,304 @@ package container
 import (
 	"context"
 	"net/http"
-	"strings"
 	"testing"
-	"time"
-
-	"github.com/crossplane-contrib/provider-azure/apis"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
-	v1 "k8s.io/api/core/v1"
-	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes/scheme"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
-	v1alpha3test "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3/test"
-	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
-	azurestoragefake "github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
 )
 
-func init() {
-	_ = apis.AddToScheme(scheme.Scheme)
-}
-
-type mockCreateUpdater struct {
-	mockCreate func(context.Context) (reconcile.Result, error)
-	mockUpdate func(context.Context, *azblob.PublicAccessType, azblob.Metadata) (reconcile.Result, error)
-}
-
-func (m *mockCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
-	return m.mockCreate(ctx)
-}
-
-func (m *mockCreateUpdater) update(ctx context.Context, pat *azblob.PublicAccessType, meta azblob.Metadata) (reconcile.Result, error) {
-	return m.mockUpdate(ctx, pat, meta)
-}
-
-func newMockCreateUpdater() *mockCreateUpdater {
-	return &mockCreateUpdater{
-		mockCreate: func(context.Context) (result reconcile.Result, e error) {
-			return reconcile.Result{}, nil
-		},
-		mockUpdate: func(context.Context, *azblob.PublicAccessType, azblob.Metadata) (reconcile.Result, error) {
-			return reconcile.Result{}, nil
-		},
-	}
-}
-
-var _ createupdater = &mockCreateUpdater{}
-
-type mockSyncdeleter struct {
-	mockDelete func(context.Context) (reconcile.Result, error)
-	mockSync   func(context.Context) (reconcile.Result, error)
-}
-
-func (m *mockSyncdeleter) delete(ctx context.Context) (reconcile.Result, error) {
-	return m.mockDelete(ctx)
-}
-
-func (m *mockSyncdeleter) sync(ctx context.Context) (reconcile.Result, error) {
-	return m.mockSync(ctx)
-}
-
-var _ syncdeleter = &mockSyncdeleter{}
+const (
+	name          = "cool-container"
+	resourceGroup = "coolgroup"
+	accountName   = "coolaccount"
+)
 
-type mockSyncdeleteMaker struct {
-	mockNewSyncdeleter func(context.Context, *v1alpha3.Container, time.Duration) (syncdeleter, error)
-}
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
 
-func (m *mockSyncdeleteMaker) newSyncdeleter(ctx context.Context, c *v1alpha3.Container, poll time.Duration) (syncdeleter, error) {
-	return m.mockNewSyncdeleter(ctx, c, poll)
-}
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
 
-var _ syncdeleterMaker = &mockSyncdeleteMaker{}
+type containerModifier func(*v1alpha3.Container)
 
-func newContainerNotFoundError(name string) error {
-	return kerrors.NewNotFound(
-		schema.GroupResource{Group: v1alpha3.Group, Resource: v1alpha3.ContainerKind}, name)
+func withConditions(c ...xpv1.Condition) containerModifier {
+	return func(cr *v1alpha3.Container) { cr.Status.ConditionedStatus.Conditions = c }
 }
 
-func newAccountNotFoundError(name string) error {
-	return kerrors.NewNotFound(
-		schema.GroupResource{Group: v1alpha3.Group, Resource: strings.ToLower(v1alpha3.AccountKind) + "s"}, name)
+func withMetadata(m azblob.Metadata) containerModifier {
+	return func(cr *v1alpha3.Container) { cr.Spec.Metadata = m }
 }
 
-func newSecret(ns, name string, data map[string][]byte) *v1.Secret {
-	return &v1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: ns,
-			Name:      name,
+func container(m ...containerModifier) *v1alpha3.Container {
+	cr := &v1alpha3.Container{
+		Spec: v1alpha3.ContainerSpec{
+			ContainerParameters: v1alpha3.ContainerParameters{
+				ResourceGroupName: resourceGroup,
+				AccountName:       accountName,
+				PublicAccessType:  azblob.PublicAccessBlob,
+			},
 		},
-		Data: data,
 	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
 }
 
-func newSecretNotFoundError(name string) error {
-	return kerrors.NewNotFound(
-		schema.GroupResource{Group: v1.GroupName, Resource: "secrets"}, name)
-}
-
-func newStorageNotFoundError() error {
-	return azblob.NewResponseError(nil, &http.Response{StatusCode: http.StatusNotFound}, "")
+func getFn(c storage.BlobContainer, err error) func(context.Context, string, string, string) (storage.BlobContainer, error) {
+	return func(_ context.Context, rg, account, n string) (storage.BlobContainer, error) {
+		if rg != resourceGroup || account != accountName || n != name {
+			return storage.BlobContainer{}, errors.Errorf("unexpected container %s/%s/%s", rg, account, n)
+		}
+		return c, err
+	}
 }
 
-const (
-	testNamespace     = "default"
-	testContainerName = "testContainer"
-	testAccountName   = "testAccount"
-)
-
-func TestReconciler_Reconcile(t *testing.T) {
-	key := types.NamespacedName{Name: testContainerName}
-	req := reconcile.Request{NamespacedName: key}
-	ctx := context.TODO()
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		Client           client.Client
-		syncdeleterMaker syncdeleterMaker
-	}
+func TestObserve(t *testing.T) {
 	type want struct {
+		cr  resource.Managed
+		o   managed.ExternalObservation
 		err error
-		res reconcile.Result
-		con *v1alpha3.Container
 	}
-	tests := []struct {
-		name   string
-		fields fields
-		want   want
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name: "GetErrNotFound",
-			fields: fields{
-				Client: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return newContainerNotFoundError(testContainerName)
-					},
-				},
-				syncdeleterMaker: nil,
-			},
+		"NotContainer": {
+			e: &external{},
 			want: want{
-				res: reconcile.Result{},
+				err: errors.New(errNotContainer),
 			},
 		},
-		{
-			name: "GetErrOther",
-			fields: fields{
-				Client: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return errors.New("test-get-error")
-					},
-				},
-				syncdeleterMaker: nil,
-			},
+		"NotFound": {
+			e:  &external{client: &fake.MockBlobContainersClient{MockGet: getFn(storage.BlobContainer{}, errNotFound)}},
+			cr: container(),
 			want: want{
-				res: reconcile.Result{},
-				err: errors.New("test-get-error"),
+				cr: container(),
+				o:  managed.ExternalObservation{ResourceExists: false},
 			},
 		},
-		{
-			name: "SyncdeleteMakerError",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockContainer(testContainerName).
-					WithFinalizer("foo.bar").Container).Build(),
-				syncdeleterMaker: &mockSyncdeleteMaker{
-					mockNewSyncdeleter: func(ctx context.Context, c *v1alpha3.Container, _ time.Duration) (syncdeleter, error) {
-						return nil, errBoom
-					},
-				},
-			},
+		"GetError": {
+			e:  &external{client: &fake.MockBlobContainersClient{MockGet: getFn(storage.BlobContainer{}, errBoom)}},
+			cr: container(),
 			want: want{
-				res: resultRequeue,
-				con: v1alpha3test.NewMockContainer(testContainerName).
-					WithFinalizer("foo.bar").
-					WithStatusConditions(
-						xpv1.ReconcileError(errBoom),
-					).
-					Container,
+				cr:  container(),
+				err: errors.Wrap(errBoom, errGetFailed),
 			},
 		},
-		{
-			name: "Delete",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockContainer(testContainerName).
-					WithDeleteTimestamp(time.Now()).Container).Build(),
-				syncdeleterMaker: &mockSyncdeleteMaker{
-					mockNewSyncdeleter: func(ctx context.Context, c *v1alpha3.Container, _ time.Duration) (syncdeleter, error) {
-						return &mockSyncdeleter{
-							mockDelete: func(ctx context.Context) (reconcile.Result, error) {
-								return reconcile.Result{}, nil
-							},
-						}, nil
-					},
+		"LateInitialized": {
+			e: &external{client: &fake.MockBlobContainersClient{MockGet: getFn(storage.BlobContainer{
+				ContainerProperties: &storage.ContainerProperties{
+					PublicAccess: storage.PublicAccessBlob,
+					Metadata:     map[string]*string{"owner": to.StringPtr("finance")},
 				},
-			},
+			}, nil)}},
+			cr: container(),
 			want: want{
-				res: reconcile.Result{},
-			},
-		},
-		{
-			name: "Sync",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockContainer(testContainerName).Container).Build(),
-				syncdeleterMaker: &mockSyncdeleteMaker{
-					mockNewSyncdeleter: func(ctx context.Context, c *v1alpha3.Container, _ time.Duration) (syncdeleter, error) {
-						return &mockSyncdeleter{
-							mockSync: func(ctx context.Context) (reconcile.Result, error) {
-								return reconcile.Result{}, nil
-							},
-						}, nil
-					},
+				cr: container(withMetadata(azblob.Metadata{"owner": "finance"}), withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
 				},
 			},
-			want: want{
-				res: reconcile.Result{},
-			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			r := &Reconciler{
-				Client:           tt.fields.Client,
-				syncdeleterMaker: tt.fields.syncdeleterMaker,
-				Initializer:      managed.NewNameAsExternalName(tt.fields.Client),
-				log:              logging.NewNopLogger(),
-			}
-			got, err := r.Reconcile(ctx, req)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("Reconciler.Reconcile(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("Reconciler.Reconcile(): -want, +got\n%s", diff)
-			}
-			if tt.want.con != nil {
-				c := &v1alpha3.Container{}
-				if err := tt.fields.Client.Get(ctx, key, c); err != nil {
-					t.Errorf("Reconciler.Reconcile() container error: %s", err)
-				}
-				// NOTE(muvaf): Get call returns TypeMeta and ResourceVersion
-				// that we are not interested. Since diff shows them, we have to
-				// assign the right values.
-				c.TypeMeta = tt.want.con.TypeMeta
-				tt.want.con.ResourceVersion = c.ResourceVersion
-				if diff := cmp.Diff(tt.want.con, c, test.EquateConditions()); diff != "" {
-					t.Errorf("Reconciler.Reconcile() container: -want, +got\n%s", diff)
-				}
-			}
-		})
-	}
-}
-
-func Test_containerSyncdeleterMaker_newSyncdeleter(t *testing.T) {
-	key := types.NamespacedName{Name: testContainerName}
-	newCont := func() *v1alpha3test.MockContainer {
-		return v1alpha3test.NewMockContainer(testContainerName)
-	}
-	ctx := context.TODO()
-	testAccountKey := "dGVzdC1rZXkK"
-
-	ch, err := storage.NewContainerHandle(testAccountName, testAccountKey, testContainerName)
-	if err != nil {
-		t.Errorf("containerSyncdeleterMaker.newSyncdeleter() unexpected error %v", err)
-	}
-
-	type fields struct {
-		Client client.Client
-	}
-	type args struct {
-		ctx context.Context
-		c   *v1alpha3.Container
-	}
-	type want struct {
-		err    error
-		syndel syncdeleter
-		cont   *v1alpha3.Container
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   want
-	}{
-		{
-			name: "FailedToGetAccountNotFoundNoDeleteProviderRef",
-			fields: fields{
-				Client: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return newAccountNotFoundError(testAccountName)
-					},
-				},
-			},
-			args: args{
-				ctx: ctx,
-				c:   newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).Container,
-			},
+		"NotUpToDate": {
+			e: &external{client: &fake.MockBlobContainersClient{MockGet: getFn(storage.BlobContainer{
+				ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessNone},
+			}, nil)}},
+			cr: container(),
 			want: want{
-				err: errors.Wrapf(newAccountNotFoundError(testAccountName),
-					"failed to retrieve storage account: %s", testAccountName),
-			},
-		},
-		{
-			name: "FailedToGetAccountNotFoundNoDeleteProviderConfigRef",
-			fields: fields{
-				Client: &test.MockClient{
-					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return newAccountNotFoundError(testAccountName)
-					},
+				cr: container(withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
 				},
 			},
-			args: args{
-				ctx: ctx,
-				c:   newCont().WithSpecProviderConfigRef(testAccountName).WithFinalizer(finalizer).Container,
-			},
-			want: want{
-				err: errors.Wrapf(newAccountNotFoundError(testAccountName),
-					"failed to retrieve storage account: %s", testAccountName),
-			},
-		},
-		{
-			name: "FailedToGetAccountNotFoundYesDelete",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(newCont().WithSpecProviderRef(testAccountName).
-					WithFinalizer(finalizer).
-					WithResourceVersion("1").Container).Build(),
-			},
-			args: args{
-				ctx: ctx,
-				c: newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).
-					WithResourceVersion("1").
-					WithDeleteTimestamp(time.Now()).
-					Container,
-			},
-			want: want{
-				err: errors.Wrapf(newAccountNotFoundError(testAccountName),
-					"failed to retrieve storage account: %s", testAccountName),
-			},
-		},
-		{
-			name: "AccountReferenceSecretNotFound",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(
-					v1alpha3test.NewMockAccount(testAccountName).
-						WithSpecWriteConnectionSecretToReference(testNamespace, testAccountName).
-						Account,
-					newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).Container).Build(),
-			},
-			args: args{
-				ctx: ctx,
-				c: newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).
-					Container,
-			},
-			want: want{
-				err: errors.Wrapf(newSecretNotFoundError(testAccountName),
-					"failed to retrieve storage account secret: %s/%s", testNamespace, testAccountName),
-			},
-		},
-		{
-			name: "AccountReferenceSecretNil",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(
-					v1alpha3test.NewMockAccount(testAccountName).Account,
-					newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).Container).Build(),
-			},
-			args: args{
-				ctx: ctx,
-				c: newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).
-					Container,
-			},
-			want: want{
-				err: errors.New(errAcctSecretNil),
-			},
 		},
-		{
-			name: "FailedToCreateContainerHandle",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(
-					newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).Container,
-					newSecret(testNamespace, testAccountName, map[string][]byte{
-						xpv1.ResourceCredentialsSecretUserKey:     []byte(testAccountName),
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte("test-key"),
-					}),
-					v1alpha3test.NewMockAccount(testAccountName).
-						WithSpecWriteConnectionSecretToReference(testNamespace, testAccountName).
-						Account).Build(),
-			},
-			args: args{
-				ctx: ctx,
-				c: newCont().WithSpecProviderRef(testAccountName).
-					WithFinalizer(finalizer).
-					Container,
-			},
-			want: want{
-				err: errors.Wrapf(errors.New("illegal base64 data at input byte 4"),
-					"failed to create client handle: %s, storage account: %s", testContainerName, testAccountName),
-			},
-		},
-		{
-			name: "Success",
-			fields: fields{
-				Client: fake.NewClientBuilder().WithObjects(
-					newCont().WithSpecProviderRef(testAccountName).WithFinalizer(finalizer).Container,
-					newSecret(testNamespace, testAccountName, map[string][]byte{
-						xpv1.ResourceCredentialsSecretUserKey:     []byte(testAccountName),
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte("dGVzdC1rZXkK"),
-					}),
-					v1alpha3test.NewMockAccount(testAccountName).
-						WithSpecWriteConnectionSecretToReference(testNamespace, testAccountName).
-						Account).Build(),
-			},
-			args: args{
-				ctx: ctx,
-				c: newCont().WithSpecProviderRef(testAccountName).
-					WithFinalizer(finalizer).
-					Container,
-			},
-			want: want{
-				syndel: &containerSyncdeleter{},
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			m := &containerSyncdeleterMaker{
-				Client: tt.fields.Client,
-			}
-			got, err := m.newSyncdeleter(tt.args.ctx, tt.args.c, time.Minute)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("containerSyncdeleterMaker.newSyncdeleter(): -got error, +want error: \n%s", diff)
-			}
-			if tt.want.syndel != nil {
-				tt.want.syndel = &containerSyncdeleter{
-					createupdater: &containerCreateUpdater{
-						ContainerOperations: ch,
-						kube:                tt.fields.Client,
-						container:           tt.args.c,
-					},
-					ContainerOperations: ch,
-					kube:                tt.fields.Client,
-					container:           tt.args.c,
-				}
-				// BUG(negz): This test is broken. It appears to intend to compare
-				// unexported fields, but does not. This behaviour was maintained
-				// when porting the test from https://github.com/go-test/deep to cmp.
-				if diff := cmp.Diff(tt.want.syndel, got,
-					cmpopts.IgnoreUnexported(containerSyncdeleter{}),
-					cmpopts.IgnoreUnexported(azblob.ContainerURL{}),
-				); diff != "" {
-					t.Errorf("containerSyncdeleterMaker.newSyncdeleter(): -want, +got:\n%s", diff)
-				}
-			}
-			if tt.want.cont != nil {
-				cont := &v1alpha3.Container{}
-				if err := tt.fields.Client.Get(tt.args.ctx, key, cont); err != nil {
-					t.Errorf("containerSyncdeleterMaker.newSyncdeleter() error validating continer: %v, expected nil", err)
-				}
-				if diff := cmp.Diff(tt.want.cont, got); diff != "" {
-					t.Errorf("containerSyncdeleterMaker.newSyncdeleter() container: -want, +got:\n%s", diff)
-				}
-			}
-		})
 	}
-}
 
-func Test_containerSyncdeleter_delete(t *testing.T) {
-	ctx := context.TODO()
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		createupdater       createupdater
-		ContainerOperations storage.ContainerOperations
-		kube                client.Client
-		container           *v1alpha3.Container
-	}
-	type args struct {
-		ctx context.Context
-	}
-	type want struct {
-		res  reconcile.Result
-		err  error
-		cont *v1alpha3.Container
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   want
-	}{
-		{
-			name: "DeletionOrphan",
-			fields: fields{
-				kube: test.NewMockClient(),
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionOrphan).
-					WithFinalizer(finalizer).Container,
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: reconcile.Result{},
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionOrphan).
-					WithFinalizers([]string{}).
-					WithStatusConditions(xpv1.Deleting()).
-					Container,
-			},
-		},
-		{
-			name: "DeleteErrorNotFound",
-			fields: fields{
-				kube: test.NewMockClient(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockDelete: func(ctx context.Context) error {
-						return autorest.DetailedError{StatusCode: http.StatusNotFound}
-					},
-				},
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).
-					Container,
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: reconcile.Result{},
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizers([]string{}).
-					WithStatusConditions(xpv1.Deleting()).
-					Container,
-			},
-		},
-		{
-			name: "DeleteErrorOther",
-			fields: fields{
-				kube: test.NewMockClient(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockDelete: func(ctx context.Context) error {
-						return errBoom
-					},
-				},
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).Container,
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: resultRequeue,
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecDeletionPolicy(xpv1.DeletionDelete).
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Deleting(), xpv1.ReconcileError(errBoom)).
-					Container,
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			csd := &containerSyncdeleter{
-				createupdater:       tt.fields.createupdater,
-				ContainerOperations: tt.fields.ContainerOperations,
-				kube:                tt.fields.kube,
-				container:           tt.fields.container,
-			}
-			got, err := csd.delete(tt.args.ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("containerSyncdeleter.delete(): -want error, +got error:\n%s", diff)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("containerSyncdeleter.delete(): -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.cont, tt.fields.container, test.EquateConditions()); diff != "" {
-				t.Errorf("containerSyncdeleter.delete() container: -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_containerSyncdeleter_sync(t *testing.T) {
-	ctx := context.TODO()
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		createupdater       createupdater
-		ContainerOperations storage.ContainerOperations
-		kube                client.Client
-		container           *v1alpha3.Container
-	}
-	type args struct {
-		ctx context.Context
-	}
+func TestCreate(t *testing.T) {
 	type want struct {
-		res  reconcile.Result
-		err  error
-		cont *v1alpha3.Container
+		cr  resource.Managed
+		err error
 	}
 
-	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   want
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name: "GetErrorNotFound",
-			fields: fields{
-				createupdater: newMockCreateUpdater(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, newStorageNotFoundError()
-					},
-				},
-			},
-			args: args{ctx: ctx},
-			want: want{},
-		},
-		{
-			name: "GetErrorOther",
-			fields: fields{
-				createupdater: newMockCreateUpdater(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, errBoom
-					},
-				},
-				container: v1alpha3test.NewMockContainer(testContainerName).Container,
-				kube:      test.NewMockClient(),
-			},
-			args: args{ctx: ctx},
+		"NotContainer": {
+			e: &external{},
 			want: want{
-				res: resultRequeue,
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithStatusConditions(xpv1.ReconcileError(errBoom)).
-					Container,
+				err: errors.New(errNotContainer),
 			},
 		},
-		{
-			name: "Create",
-			fields: fields{
-				createupdater: newMockCreateUpdater(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, nil
-					},
+		"CreateError": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockCreate: func(_ context.Context, _, _, _ string, _ storage.BlobContainer) (storage.BlobContainer, error) {
+					return storage.BlobContainer{}, errBoom
 				},
-				container: v1alpha3test.NewMockContainer(testContainerName).Container,
-				kube:      test.NewMockClient(),
-			},
-			args: args{ctx: ctx},
+			}},
+			cr: container(),
 			want: want{
-				res:  reconcile.Result{},
-				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+				cr:  container(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
-		{
-			name: "Update",
-			fields: fields{
-				createupdater: newMockCreateUpdater(),
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer), nil, nil
-					},
+		"Success": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockCreate: func(_ context.Context, _, _, _ string, c storage.BlobContainer) (storage.BlobContainer, error) {
+					if c.PublicAccess != storage.PublicAccessBlob {
+						t.Errorf("Create(...): unexpected public access %s", c.PublicAccess)
+					}
+					return c, nil
 				},
-				container: v1alpha3test.NewMockContainer(testContainerName).Container,
-				kube:      test.NewMockClient(),
-			},
-			args: args{ctx: ctx},
+			}},
+			cr: container(),
 			want: want{
-				res:  reconcile.Result{},
-				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+				cr: container(withConditions(xpv1.Creating())),
 			},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			csd := &containerSyncdeleter{
-				createupdater:       tt.fields.createupdater,
-				ContainerOperations: tt.fields.ContainerOperations,
-				kube:                tt.fields.kube,
-				container:           tt.fields.container,
-			}
-			got, err := csd.sync(tt.args.ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("containerSyncdeleter.sync(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("containerSyncdeleter.sync(): -want, +got:\n%s", diff)
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.cont, tt.fields.container, test.EquateConditions()); diff != "" {
-				t.Errorf("containerSyncdeleter.sync() container: -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_containerCreateUpdater_create(t *testing.T) {
-	ctx := context.TODO()
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		ContainerOperations storage.ContainerOperations
-		kube                client.Client
-		container           *v1alpha3.Container
-	}
-	type args struct {
-		ctx context.Context
-	}
-	type want struct {
-		res  reconcile.Result
-		err  error
-		cont *v1alpha3.Container
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   want
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want error
 	}{
-		{
-			name: "UpdateFinalizerFailed",
-			fields: fields{
-				container: v1alpha3test.NewMockContainer(testContainerName).Container,
-				kube: &test.MockClient{
-					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
-						return errors.New("test-update-error")
-					},
-				},
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: resultRequeue,
-				err: errors.Wrapf(errors.New("test-update-error"), "failed to update container spec"),
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Creating()).
-					Container,
-			},
+		"NotContainer": {
+			e:    &external{},
+			want: errors.New(errNotContainer),
 		},
-		{
-			name: "CreateFailed",
-			fields: fields{
-				container: v1alpha3test.NewMockContainer(testContainerName).Container,
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockCreate: func(ctx context.Context, pub azblob.PublicAccessType, meta azblob.Metadata) error {
-						return errBoom
-					},
+		"UpdateError": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, _ storage.BlobContainer) (storage.BlobContainer, error) {
+					return storage.BlobContainer{}, errBoom
 				},
-				kube: test.NewMockClient(),
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: resultRequeue,
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Creating(), xpv1.ReconcileError(errBoom)).
-					Container,
-			},
+			}},
+			cr:   container(),
+			want: errors.Wrap(errBoom, errUpdateFailed),
 		},
-		{
-			name: "CreateSuccessful",
-			fields: fields{
-				container:           v1alpha3test.NewMockContainer(testContainerName).Container,
-				ContainerOperations: azurestoragefake.NewMockContainerOperations(),
-				kube:                test.NewMockClient(),
-			},
-			args: args{ctx: ctx},
-			want: want{
-				res: reconcile.Result{},
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
-					Container,
-			},
+		"Success": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, c storage.BlobContainer) (storage.BlobContainer, error) {
+					return c, nil
+				},
+			}},
+			cr: container(),
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ccu := &containerCreateUpdater{
-				ContainerOperations: tt.fields.ContainerOperations,
-				kube:                tt.fields.kube,
-				container:           tt.fields.container,
-			}
-			got, err := ccu.create(tt.args.ctx)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("containerCreateUpdater.create(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("containerCreateUpdater.create(): -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.cont, tt.fields.container); diff != "" {
-				t.Errorf("containerCreateUpdater.create() container: -want, +got:\n%s", diff)
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_containerCreateUpdater_update(t *testing.T) {
-	ctx := context.TODO()
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		ContainerOperations storage.ContainerOperations
-		kube                client.Client
-		container           *v1alpha3.Container
-		poll                time.Duration
-	}
-	type args struct {
-		ctx        context.Context
-		accessType *azblob.PublicAccessType
-		meta       azblob.Metadata
-	}
+func TestDelete(t *testing.T) {
 	type want struct {
-		res  reconcile.Result
-		err  error
-		cont *v1alpha3.Container
+		cr  resource.Managed
+		err error
 	}
-	tests := []struct {
-		name   string
-		fields fields
-		args   args
-		want   want
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		cr   resource.Managed
+		want want
 	}{
-		{
-			name: "NoChange",
-			fields: fields{
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).Container,
-				kube: test.NewMockClient(),
-				poll: time.Minute,
-			},
-			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
-			},
+		"NotContainer": {
+			e: &external{},
 			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
-					Container,
+				err: errors.New(errNotContainer),
 			},
 		},
-		{
-			name: "ContainerUpdateFailed",
-			fields: fields{
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
-					WithStatusConditions().
-					Container,
-				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata) error {
-						return errBoom
-					},
+		"NotFound": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errNotFound
 				},
-				kube: test.NewMockClient(),
-			},
-			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
-				meta: azblob.Metadata{
-					"foo": "bar",
-				},
-			},
+			}},
+			cr: container(),
 			want: want{
-				res: resultRequeue,
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
-					WithStatusConditions(xpv1.ReconcileError(errBoom)).
-					Container,
+				cr: container(withConditions(xpv1.Deleting())),
 			},
 		},
-		{
-			name: "ContainerUpdateSuccessful",
-			fields: fields{
-				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
-					Container,
-				ContainerOperations: azurestoragefake.NewMockContainerOperations(),
-				kube:                test.NewMockClient(),
-				poll:                time.Minute,
-			},
-			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
-				meta: azblob.Metadata{
-					"foo": "bar",
+		"DeleteError": {
+			e: &external{client: &fake.MockBlobContainersClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
 				},
-			},
+			}},
+			cr: container(),
 			want: want{
-				res: reconcile.Result{RequeueAfter: time.Minute},
-				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
-					Container,
+				cr:  container(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
 			},
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ccu := &containerCreateUpdater{
-				ContainerOperations: tt.fields.ContainerOperations,
-				kube:                tt.fields.kube,
-				container:           tt.fields.container,
-				poll:                tt.fields.poll,
-			}
-			got, err := ccu.update(tt.args.ctx, tt.args.accessType, tt.args.meta)
-			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("containerCreateUpdater.update(): -want error, +got error:\n%s", diff)
-			}
-			if diff := cmp.Diff(tt.want.res, got); diff != "" {
-				t.Errorf("containerCreateUpdater.update(): -want, +got:\n%s", diff)
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tt.want.cont, tt.fields.container, test.EquateConditions()); diff != "" {
-				t.Errorf("containerCreateUpdater.update() container: -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
 			}
 		})
 	}